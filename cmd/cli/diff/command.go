@@ -0,0 +1,109 @@
+package diff
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/grafana/cog/cmd/cli/loaders"
+	"github.com/grafana/cog/internal/diff"
+	"github.com/spf13/cobra"
+)
+
+var errBreakingChanges = errors.New("breaking changes detected")
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+type diffOptions struct {
+	OldLoaderOptions loaders.Options
+	NewLoaderOptions loaders.Options
+	Format           string
+}
+
+func Command() *cobra.Command {
+	opts := diffOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Reports changes between two versions of a set of schemas.",
+		Long: `Reports changes between two versions of a set of schemas.
+
+Changes are classified as breaking or non-breaking.
+The command exits with a non-zero status code if breaking changes are detected.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doDiff(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Format, "format", formatText, "Output format: text or json.")
+
+	registerLoaderFlags(cmd, "old", &opts.OldLoaderOptions)
+	registerLoaderFlags(cmd, "new", &opts.NewLoaderOptions)
+
+	return cmd
+}
+
+func registerLoaderFlags(cmd *cobra.Command, prefix string, opts *loaders.Options) {
+	flag := func(name string) string {
+		return prefix + "-" + name
+	}
+
+	cmd.Flags().StringArrayVar(&opts.CueEntrypoints, flag("cue"), nil, fmt.Sprintf("CUE input schema (%s version).", prefix))
+	cmd.Flags().StringArrayVar(&opts.KindsysCoreEntrypoints, flag("kindsys-core"), nil, fmt.Sprintf("Kindys core kinds input schema (%s version).", prefix))
+	cmd.Flags().StringArrayVar(&opts.KindsysComposableEntrypoints, flag("kindsys-composable"), nil, fmt.Sprintf("Kindys composable kinds input schema (%s version).", prefix))
+	cmd.Flags().StringArrayVar(&opts.KindsysCustomEntrypoints, flag("kindsys-custom"), nil, fmt.Sprintf("Kindys custom kinds input schema (%s version).", prefix))
+	cmd.Flags().StringArrayVar(&opts.JSONSchemaEntrypoints, flag("jsonschema"), nil, fmt.Sprintf("Jsonschema input schema (%s version).", prefix))
+	cmd.Flags().StringArrayVar(&opts.OpenAPIEntrypoints, flag("openapi"), nil, fmt.Sprintf("Openapi input schema (%s version).", prefix))
+	cmd.Flags().StringVar(&opts.KindRegistryPath, flag("kind-registry"), "", fmt.Sprintf("Kind registry input (%s version).", prefix))
+	cmd.Flags().StringVar(&opts.JSONSchemaRegistryPath, flag("jsonschema-registry"), "", fmt.Sprintf("JSONschema registry input (%s version).", prefix))
+
+	cmd.Flags().StringToStringVar(&opts.JSONSchemaPackageMapping, flag("jsonschema-package"), nil, fmt.Sprintf("Package in which a JSON schema will be generated (%s version). Format: [file path or URI]=[package].", prefix))
+	cmd.Flags().StringArrayVar(&opts.CueImports, flag("include-cue-import"), nil, fmt.Sprintf("Specify an additional library import directory (%s version). Format: [path]:[import].", prefix))
+	cmd.Flags().StringVar(&opts.KindRegistryVersion, flag("kind-registry-version"), "next", fmt.Sprintf("Schemas version (%s version).", prefix))
+
+	_ = cmd.MarkFlagDirname(flag("cue"))
+	_ = cmd.MarkFlagDirname(flag("kindsys-core"))
+	_ = cmd.MarkFlagDirname(flag("kindsys-custom"))
+	_ = cmd.MarkFlagDirname(flag("kind-registry"))
+	_ = cmd.MarkFlagDirname(flag("jsonschema-registry"))
+	_ = cmd.MarkFlagFilename(flag("jsonschema"))
+	_ = cmd.MarkFlagDirname(flag("openapi"))
+}
+
+func doDiff(opts diffOptions) error {
+	if opts.Format != formatText && opts.Format != formatJSON {
+		return fmt.Errorf("unknown output format '%s'", opts.Format)
+	}
+
+	oldSchemas, err := loaders.LoadAll(opts.OldLoaderOptions)
+	if err != nil {
+		return fmt.Errorf("could not load old schemas: %w", err)
+	}
+
+	newSchemas, err := loaders.LoadAll(opts.NewLoaderOptions)
+	if err != nil {
+		return fmt.Errorf("could not load new schemas: %w", err)
+	}
+
+	report := diff.Schemas(oldSchemas, newSchemas)
+
+	if opts.Format == formatJSON {
+		marshaled, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(string(marshaled))
+	} else {
+		fmt.Print(report.Text())
+	}
+
+	if report.HasBreakingChanges() {
+		return errBreakingChanges
+	}
+
+	return nil
+}
@@ -28,6 +28,15 @@ type Options struct {
 	CompilerConfigFiles     []string
 	OutputDir               string
 
+	// Plugins associates languages to the executables implementing them.
+	// See the plugin package for a description of the protocol used to
+	// communicate with these executables.
+	Plugins map[string]string
+
+	// Watch enables the watch mode: inputs and configuration files are
+	// watched, and code is re-generated whenever they change.
+	Watch bool
+
 	// PackageTemplates is the path to a directory containing "package templates".
 	// These templates are used to add arbitrary files to the generated code, with
 	// the goal of turning it into a fully-fledged package.
@@ -72,25 +81,8 @@ func (opts Options) languageOutputDir(language string) string {
 	return strings.ReplaceAll(opts.OutputDir, "%l", language)
 }
 
-func (opts Options) veneerFiles() ([]string, error) {
-	veneers := make([]string, 0, len(opts.VeneerConfigFiles))
-	veneers = append(veneers, opts.VeneerConfigFiles...)
-
-	for _, dir := range opts.VeneerConfigDirectories {
-		globPattern := filepath.Join(filepath.Clean(dir), "*.yaml")
-		matches, err := filepath.Glob(globPattern)
-		if err != nil {
-			return nil, err
-		}
-
-		veneers = append(veneers, matches...)
-	}
-
-	return veneers, nil
-}
-
 func (opts Options) veneers() (*rewrite.Rewriter, error) {
-	veneerFiles, err := opts.veneerFiles()
+	veneerFiles, err := yaml.VeneerFiles(opts.VeneerConfigFiles, opts.VeneerConfigDirectories)
 	if err != nil {
 		return nil, err
 	}
@@ -104,6 +96,7 @@ func (opts Options) commonCompilerPasses() (compiler.Passes, error) {
 }
 
 func Command() *cobra.Command {
+	var configFile string
 	opts := Options{}
 	languageJennies := jennies.All()
 
@@ -112,10 +105,26 @@ func Command() *cobra.Command {
 		Short: "Generates code from schemas.", // TODO: better descriptions
 		Long:  `Generates code from schemas.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return doGenerate(languageJennies, opts)
+			if configFile != "" {
+				if err := applyConfigFile(cmd, configFile); err != nil {
+					return err
+				}
+			}
+
+			targets, err := languageJennies.WithPlugins(opts.Plugins)
+			if err != nil {
+				return err
+			}
+
+			if opts.Watch {
+				return doWatch(targets, opts)
+			}
+
+			return doGenerate(targets, opts)
 		},
 	}
 
+	cmd.Flags().StringVar(&configFile, "config", "", "Configuration file (YAML or JSON) describing the generation. Flags set on the command line override its values.")
 	cmd.Flags().BoolVar(&opts.JenniesConfig.Debug, "debug", false, "Debugging mode.") // TODO: better usage text
 
 	cmd.Flags().BoolVar(&opts.JenniesConfig.Types, "generate-types", true, "Generate types.")          // TODO: better usage text
@@ -124,11 +133,14 @@ func Command() *cobra.Command {
 	cmd.Flags().StringVar(&opts.RepositoryTemplates, "repository-templates", "", "Directory used as a template used to generate additional content at the repository root.")
 	cmd.Flags().StringToStringVar(&opts.TemplatesData, "templates-data", nil, "Data to hand over to package and repository templates.")
 
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "w", false, "Watch inputs, veneers, compiler configuration and templates, and re-generate code when they change. Files generated during the session that are no longer generated are removed.")
+
 	cmd.Flags().StringVarP(&opts.OutputDir, "output", "o", "generated", "Output directory.") // TODO: better usage text
 	cmd.Flags().StringArrayVarP(&opts.Languages, "language", "l", nil, "Language to generate. If left empty, all supported languages will be generated.")
 	cmd.Flags().StringArrayVarP(&opts.VeneerConfigFiles, "veneer", "c", nil, "Veneer configuration file.")
 	cmd.Flags().StringArrayVar(&opts.VeneerConfigDirectories, "veneers", nil, "Veneer configuration directories.")
 	cmd.Flags().StringArrayVar(&opts.CompilerConfigFiles, "compiler-config", nil, "Compiler configuration file.")
+	cmd.Flags().StringToStringVar(&opts.Plugins, "plugin", nil, "Language implemented by a plugin executable. Format: [language]=[executable].")
 
 	cmd.Flags().StringArrayVar(&opts.CueEntrypoints, "cue", nil, "CUE input schema.")                                                                                                           // TODO: better usage text
 	cmd.Flags().StringArrayVar(&opts.KindsysCoreEntrypoints, "kindsys-core", nil, "Kindys core kinds input schema.")                                                                            // TODO: better usage text
@@ -139,6 +151,7 @@ func Command() *cobra.Command {
 	cmd.Flags().StringVar(&opts.KindRegistryPath, "kind-registry", "", "Kind registry input.")                                                                                                  // TODO: better usage text
 	cmd.Flags().StringVar(&opts.JSONSchemaRegistryPath, "jsonschema-registry", "", "JSONschema registry input. This flag is totally experimental and it could be deleted in forward versions.") // TODO: better usage text
 
+	cmd.Flags().StringToStringVar(&opts.JSONSchemaPackageMapping, "jsonschema-package", nil, "Package in which a JSON schema will be generated. Format: [file path or URI]=[package]. Applies to referenced schemas as well as to entrypoints.")
 	cmd.Flags().StringArrayVarP(&opts.CueImports, "include-cue-import", "I", nil, "Specify an additional library import directory. Format: [path]:[import]. Example: '../grafana/common-library:github.com/grafana/grafana/packages/grafana-schema/src/common")
 	cmd.Flags().StringVar(&opts.KindRegistryVersion, "kind-registry-version", "next", "Schemas version")
 
@@ -146,6 +159,7 @@ func Command() *cobra.Command {
 		jenny.RegisterCliFlags(cmd)
 	}
 
+	_ = cmd.MarkFlagFilename("config")
 	_ = cmd.MarkFlagDirname("package-templates")
 	_ = cmd.MarkFlagDirname("cue")
 	_ = cmd.MarkFlagDirname("kindsys-core")
@@ -162,46 +176,113 @@ func Command() *cobra.Command {
 }
 
 func doGenerate(allTargets jennies.LanguageJennies, opts Options) error {
-	veneers, err := opts.veneers()
+	pipeline, err := newPipeline(allTargets, opts)
 	if err != nil {
 		return err
 	}
 
-	commonCompilerPasses, err := opts.commonCompilerPasses()
+	rootCodeJenFS, err := pipeline.run(stageInputs)
 	if err != nil {
 		return err
 	}
 
-	// Here begins the code generation setup
+	return rootCodeJenFS.Write(context.Background(), "")
+}
+
+// stage identifies a step of the generation pipeline. Running the pipeline
+// from a given stage re-runs that stage and every one that follows it,
+// while reusing the results of the previous ones.
+type stage int
+
+const (
+	// stageInputs parses input schemas.
+	stageInputs stage = iota
+	// stageCompilerPasses loads compiler configuration files and runs the compiler passes.
+	stageCompilerPasses
+	// stageVeneers loads veneers configuration files.
+	stageVeneers
+	// stageJennies generates builders and runs the jennies.
+	stageJennies
+)
+
+type pipeline struct {
+	opts    Options
+	targets jennies.LanguageJennies
+
+	veneers              *rewrite.Rewriter
+	commonCompilerPasses compiler.Passes
+	schemas              ast.Schemas
+	processedSchemas     map[string]ast.Schemas
+}
+
+func newPipeline(allTargets jennies.LanguageJennies, opts Options) (*pipeline, error) {
 	targetsByLanguage, err := allTargets.ForLanguages(opts.Languages)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	fmt.Printf("Parsing inputs...\n")
-	schemas, err := loaders.LoadAll(opts.Options)
-	if err != nil {
-		return err
+	return &pipeline{
+		opts:             opts,
+		targets:          targetsByLanguage,
+		processedSchemas: make(map[string]ast.Schemas, len(targetsByLanguage)),
+	}, nil
+}
+
+func (p *pipeline) run(from stage) (*codejen.FS, error) {
+	var err error
+
+	if from <= stageCompilerPasses {
+		p.commonCompilerPasses, err = p.opts.commonCompilerPasses()
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	rootCodeJenFS := codejen.NewFS()
-	for language, target := range targetsByLanguage {
-		fmt.Printf("Running '%s' jennies...\n", language)
+	if from <= stageVeneers {
+		p.veneers, err = p.opts.veneers()
+		if err != nil {
+			return nil, err
+		}
+	}
 
-		compilerPasses := commonCompilerPasses.Concat(target.CompilerPasses())
-		processedSchemas, err := compilerPasses.Process(schemas)
+	if from <= stageInputs {
+		fmt.Printf("Parsing inputs...\n")
+		p.schemas, err = loaders.LoadAll(p.opts.Options)
 		if err != nil {
-			return err
+			return nil, err
+		}
+	}
+
+	if from <= stageCompilerPasses {
+		for language, target := range p.targets {
+			compilerPasses := p.commonCompilerPasses.Concat(target.CompilerPasses())
+			p.processedSchemas[language], err = compilerPasses.Process(p.schemas)
+			if err != nil {
+				return nil, err
+			}
 		}
+	}
+
+	return p.runJennies()
+}
+
+func (p *pipeline) runJennies() (*codejen.FS, error) {
+	opts := p.opts
+	rootCodeJenFS := codejen.NewFS()
+
+	for language, target := range p.targets {
+		fmt.Printf("Running '%s' jennies...\n", language)
+
+		processedSchemas := p.processedSchemas[language]
 
 		// from these types, create builders
 		builderGenerator := &ast.BuilderGenerator{}
 		builders := builderGenerator.FromAST(processedSchemas)
 
 		// apply the builder veneers
-		builders, err = veneers.ApplyTo(builders, language)
+		builders, err := p.veneers.ApplyTo(processedSchemas, builders, language)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// prepare the jennies
@@ -215,14 +296,14 @@ func doGenerate(allTargets jennies.LanguageJennies, opts Options) error {
 
 		// then delegate the codegen to the jennies
 		if err := runJenny(languageJennies, jenniesInput, rootCodeJenFS); err != nil {
-			return err
+			return nil, err
 		}
 
 		if opts.PackageTemplates != "" {
 			packageJennies := packageTemplatesJenny(language, opts)
 
 			if err := runJenny(packageJennies, jenniesInput, rootCodeJenFS); err != nil {
-				return err
+				return nil, err
 			}
 		}
 	}
@@ -230,20 +311,15 @@ func doGenerate(allTargets jennies.LanguageJennies, opts Options) error {
 	if opts.RepositoryTemplates != "" {
 		repoTemplatesJenny := repositoryTemplatesJenny(opts)
 		jennyInput := common.BuildOptions{
-			Languages: targetsByLanguage.AsLanguageRefs(),
+			Languages: p.targets.AsLanguageRefs(),
 		}
 
 		if err := runJenny(repoTemplatesJenny, jennyInput, rootCodeJenFS); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	err = rootCodeJenFS.Write(context.Background(), "")
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return rootCodeJenFS, nil
 }
 
 func repositoryTemplatesJenny(opts Options) *codejen.JennyList[common.BuildOptions] {
@@ -0,0 +1,297 @@
+package generate
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/cog/cmd/cli/loaders"
+	"github.com/grafana/cog/internal/tools"
+	schemaparser "github.com/santhosh-tekuri/jsonschema"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed config.schema.json
+var configSchema []byte
+
+// ProjectConfig describes the content of a configuration file, as given to
+// the `--config` flag.
+// Every setting in this file maps to a CLI flag. Flags explicitly set on
+// the command line take precedence over the values defined in the file.
+// Relative paths are resolved from the directory containing the file.
+type ProjectConfig struct {
+	Debug *bool `yaml:"debug"`
+
+	Inputs InputsConfig `yaml:"inputs"`
+
+	Veneers            []string `yaml:"veneers"`
+	VeneersDirectories []string `yaml:"veneers_directories"`
+	CompilerConfigs    []string `yaml:"compiler_configs"`
+
+	Output OutputConfig `yaml:"output"`
+
+	// Languages to generate, with their language-specific settings.
+	// Settings are named after the language's CLI flags, without the
+	// language prefix: `package_root` for the `go` language refers to
+	// the `--go-package-root` flag.
+	Languages map[string]map[string]any `yaml:"languages"`
+
+	// Plugins associates languages to the executables implementing them.
+	Plugins map[string]string `yaml:"plugins"`
+
+	// directory in which the config file lives.
+	dir string
+}
+
+// InputsConfig declares inputs schemas, indexed by loader (see loaders.LoaderRef),
+// and the loaders' settings.
+type InputsConfig struct {
+	Cue                 []string          `yaml:"cue"`
+	KindsysCore         []string          `yaml:"kindsys_core"`
+	KindsysComposable   []string          `yaml:"kindsys_composable"`
+	KindsysCustom       []string          `yaml:"kindsys_custom"`
+	JSONSchema          []string          `yaml:"jsonschema"`
+	OpenAPI             []string          `yaml:"openapi"`
+	KindRegistry        string            `yaml:"kind_registry"`
+	JSONSchemaRegistry  string            `yaml:"jsonschema_registry"`
+	CueImports          []string          `yaml:"cue_imports"`
+	JSONSchemaPackages  map[string]string `yaml:"jsonschema_packages"`
+	KindRegistryVersion string            `yaml:"kind_registry_version"`
+}
+
+type OutputConfig struct {
+	Directory           string            `yaml:"directory"`
+	Types               *bool             `yaml:"types"`
+	Builders            *bool             `yaml:"builders"`
+	PackageTemplates    string            `yaml:"package_templates"`
+	RepositoryTemplates string            `yaml:"repository_templates"`
+	TemplatesData       map[string]string `yaml:"templates_data"`
+}
+
+type flagValue struct {
+	name   string
+	values []string
+}
+
+// applyConfigFile loads the given configuration file and uses its content to
+// set the command's flags. Flags already set on the command line are left
+// untouched.
+func applyConfigFile(cmd *cobra.Command, configFile string) error {
+	config, err := loadProjectConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	setOnCommandLine := make(map[string]bool)
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		setOnCommandLine[flag.Name] = true
+	})
+
+	for _, flag := range config.flagValues() {
+		if setOnCommandLine[flag.name] {
+			continue
+		}
+
+		if cmd.Flags().Lookup(flag.name) == nil {
+			return fmt.Errorf("%s: setting '%s' does not match any known flag", configFile, flag.name)
+		}
+
+		for _, value := range flag.values {
+			if err := cmd.Flags().Set(flag.name, value); err != nil {
+				return fmt.Errorf("%s: invalid value for '%s': %w", configFile, flag.name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func loadProjectConfig(configFile string) (ProjectConfig, error) {
+	config := ProjectConfig{}
+
+	contents, err := os.ReadFile(configFile)
+	if err != nil {
+		return config, err
+	}
+
+	// JSON being a subset of YAML, both formats are handled by the YAML parser.
+	if err := validateProjectConfig(contents); err != nil {
+		return config, fmt.Errorf("%s: invalid configuration: %w", configFile, err)
+	}
+
+	if err := yaml.Unmarshal(contents, &config); err != nil {
+		return config, fmt.Errorf("%s: %w", configFile, err)
+	}
+
+	config.dir, err = relativeDir(configFile)
+	if err != nil {
+		return config, err
+	}
+
+	return config, nil
+}
+
+func validateProjectConfig(contents []byte) error {
+	var document any
+	if err := yaml.Unmarshal(contents, &document); err != nil {
+		return err
+	}
+	if document == nil {
+		document = map[string]any{}
+	}
+
+	// the schema validator only accepts values as decoded by encoding/json
+	jsonDocument, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	compiler := schemaparser.NewCompiler()
+	if err := compiler.AddResource("config.schema.json", bytes.NewReader(configSchema)); err != nil {
+		return err
+	}
+
+	schema, err := compiler.Compile("config.schema.json")
+	if err != nil {
+		return err
+	}
+
+	return schema.Validate(bytes.NewReader(jsonDocument))
+}
+
+// relativeDir returns the directory containing the given file, relative
+// to the current working directory.
+func relativeDir(file string) (string, error) {
+	absoluteDir, err := filepath.Abs(filepath.Dir(file))
+	if err != nil {
+		return "", err
+	}
+
+	workingDir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Rel(workingDir, absoluteDir)
+}
+
+func (config ProjectConfig) flagValues() []flagValue {
+	var flags []flagValue
+
+	addBool := func(name string, value *bool) {
+		if value != nil {
+			flags = append(flags, flagValue{name: name, values: []string{strconv.FormatBool(*value)}})
+		}
+	}
+	addStrings := func(name string, values []string) {
+		if len(values) != 0 {
+			flags = append(flags, flagValue{name: name, values: values})
+		}
+	}
+	addString := func(name string, value string) {
+		if value != "" {
+			addStrings(name, []string{value})
+		}
+	}
+	addMap := func(name string, values map[string]string) {
+		pairs := make([]string, 0, len(values))
+		for key, value := range values {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", key, value))
+		}
+		sort.Strings(pairs)
+
+		addStrings(name, pairs)
+	}
+
+	addBool("debug", config.Debug)
+
+	inputs := config.Inputs
+	addStrings(string(loaders.CUE), config.paths(inputs.Cue))
+	addStrings(string(loaders.KindsysCore), config.paths(inputs.KindsysCore))
+	addStrings(string(loaders.KindsysComposable), config.paths(inputs.KindsysComposable))
+	addStrings(string(loaders.KindsysCustom), config.paths(inputs.KindsysCustom))
+	addStrings(string(loaders.JSONSchema), config.paths(inputs.JSONSchema))
+	addStrings(string(loaders.OpenAPI), config.paths(inputs.OpenAPI))
+	addString(string(loaders.KindRegistry), config.path(inputs.KindRegistry))
+	addString(string(loaders.JSONSchemaRegistry), config.path(inputs.JSONSchemaRegistry))
+	addStrings("include-cue-import", tools.Map(inputs.CueImports, func(cueImport string) string {
+		parts := strings.SplitN(cueImport, ":", 2)
+		return config.path(parts[0]) + ":" + parts[1]
+	}))
+	jsonschemaPackages := make(map[string]string, len(inputs.JSONSchemaPackages))
+	for location, pkg := range inputs.JSONSchemaPackages {
+		jsonschemaPackages[config.path(location)] = pkg
+	}
+	addMap("jsonschema-package", jsonschemaPackages)
+	addString("kind-registry-version", inputs.KindRegistryVersion)
+
+	addStrings("veneer", config.paths(config.Veneers))
+	addStrings("veneers", config.paths(config.VeneersDirectories))
+	addStrings("compiler-config", config.paths(config.CompilerConfigs))
+
+	plugins := make(map[string]string, len(config.Plugins))
+	for language, executable := range config.Plugins {
+		// executables without path separator are looked up in the PATH
+		if strings.ContainsRune(executable, '/') {
+			executable = config.path(executable)
+
+			// keep the resolved path from being looked up in the PATH
+			if !strings.ContainsRune(executable, '/') {
+				executable = "./" + executable
+			}
+		}
+
+		plugins[language] = executable
+	}
+	addMap("plugin", plugins)
+
+	output := config.Output
+	addString("output", config.path(output.Directory))
+	addBool("generate-types", output.Types)
+	addBool("generate-builders", output.Builders)
+	addString("package-templates", config.path(output.PackageTemplates))
+	addString("repository-templates", config.path(output.RepositoryTemplates))
+	addMap("templates-data", output.TemplatesData)
+
+	languages := make([]string, 0, len(config.Languages))
+	for language := range config.Languages {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	addStrings("language", languages)
+
+	for _, language := range languages {
+		settings := make([]string, 0, len(config.Languages[language]))
+		for setting := range config.Languages[language] {
+			settings = append(settings, setting)
+		}
+		sort.Strings(settings)
+
+		for _, setting := range settings {
+			flagName := language + "-" + strings.ReplaceAll(setting, "_", "-")
+			addString(flagName, fmt.Sprintf("%v", config.Languages[language][setting]))
+		}
+	}
+
+	return flags
+}
+
+func (config ProjectConfig) path(path string) string {
+	if path == "" || filepath.IsAbs(path) || strings.Contains(path, "://") {
+		return path
+	}
+
+	return filepath.Join(config.dir, path)
+}
+
+func (config ProjectConfig) paths(paths []string) []string {
+	return tools.Map(paths, config.path)
+}
@@ -0,0 +1,174 @@
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, contents string) string {
+	t.Helper()
+
+	configFile := filepath.Join(t.TempDir(), "project", "cog.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configFile), 0755))
+	require.NoError(t, os.WriteFile(configFile, []byte(contents), 0600))
+
+	return configFile
+}
+
+func findFlagValues(t *testing.T, flags []flagValue, name string) []string {
+	t.Helper()
+
+	for _, flag := range flags {
+		if flag.name == name {
+			return flag.values
+		}
+	}
+
+	t.Fatalf("flag '%s' not found", name)
+
+	return nil
+}
+
+func TestLoadProjectConfig_resolvesRelativePaths(t *testing.T) {
+	req := require.New(t)
+
+	configFile := writeConfigFile(t, `
+inputs:
+  cue: [./schemas/dashboard]
+  jsonschema: [/abs/schema.json, https://example.com/schema.json]
+  cue_imports: [../cue/common:github.com/grafana/common]
+  jsonschema_packages:
+    ./schemas/common.json: common
+output:
+  directory: ./generated
+plugins:
+  php: ./bin/cog-php
+  rust: cog-rust
+`)
+	configDir := filepath.Dir(configFile)
+
+	config, err := loadProjectConfig(configFile)
+	req.NoError(err)
+
+	flags := config.flagValues()
+
+	// resolved paths are relative to the working directory
+	absolute := func(path string) string {
+		abs, err := filepath.Abs(path)
+		req.NoError(err)
+		return abs
+	}
+
+	cueInputs := findFlagValues(t, flags, "cue")
+	req.Len(cueInputs, 1)
+	req.Equal(filepath.Join(configDir, "schemas", "dashboard"), absolute(cueInputs[0]))
+
+	output := findFlagValues(t, flags, "output")
+	req.Equal(filepath.Join(configDir, "generated"), absolute(output[0]))
+
+	// absolute paths and URLs are left untouched
+	req.Equal([]string{"/abs/schema.json", "https://example.com/schema.json"}, findFlagValues(t, flags, "jsonschema"))
+
+	cueImports := findFlagValues(t, flags, "include-cue-import")
+	req.Len(cueImports, 1)
+	req.Equal(config.path("../cue/common")+":github.com/grafana/common", cueImports[0])
+	req.Equal(filepath.Join(filepath.Dir(configDir), "cue", "common"), absolute(config.path("../cue/common")))
+
+	req.Equal([]string{config.path("./schemas/common.json") + "=common"}, findFlagValues(t, flags, "jsonschema-package"))
+
+	// plugins without path separator are looked up in the PATH
+	plugins := findFlagValues(t, flags, "plugin")
+	req.Len(plugins, 2)
+	req.Equal("rust=cog-rust", plugins[1])
+	req.Equal(filepath.Join(configDir, "bin", "cog-php"), absolute(plugins[0][len("php="):]))
+}
+
+func TestProjectConfig_flagValues_languageSettings(t *testing.T) {
+	req := require.New(t)
+
+	configFile := writeConfigFile(t, `
+languages:
+  go:
+    package_root: github.com/example/sdk
+    converters: true
+  typescript:
+`)
+
+	config, err := loadProjectConfig(configFile)
+	req.NoError(err)
+
+	flags := config.flagValues()
+
+	req.Equal([]string{"go", "typescript"}, findFlagValues(t, flags, "language"))
+	req.Equal([]string{"github.com/example/sdk"}, findFlagValues(t, flags, "go-package-root"))
+	req.Equal([]string{"true"}, findFlagValues(t, flags, "go-converters"))
+}
+
+func TestApplyConfigFile_commandLineTakesPrecedence(t *testing.T) {
+	req := require.New(t)
+
+	configFile := writeConfigFile(t, `
+debug: true
+output:
+  directory: /from/config
+languages:
+  go:
+    package_root: github.com/example/sdk
+`)
+
+	cmd := Command()
+	req.NoError(cmd.ParseFlags([]string{"--output", "/from/cli"}))
+
+	req.NoError(applyConfigFile(cmd, configFile))
+
+	req.Equal("/from/cli", cmd.Flags().Lookup("output").Value.String())
+	req.Equal("true", cmd.Flags().Lookup("debug").Value.String())
+	req.Equal("github.com/example/sdk", cmd.Flags().Lookup("go-package-root").Value.String())
+}
+
+func TestApplyConfigFile_rejectsUnknownLanguageSetting(t *testing.T) {
+	req := require.New(t)
+
+	configFile := writeConfigFile(t, `
+languages:
+  go:
+    not_a_setting: true
+`)
+
+	err := applyConfigFile(Command(), configFile)
+	req.ErrorContains(err, "setting 'go-not-a-setting' does not match any known flag")
+}
+
+func TestLoadProjectConfig_rejectsUnknownKeys(t *testing.T) {
+	testCases := []struct {
+		description string
+		contents    string
+	}{
+		{
+			description: "top-level key",
+			contents:    "not_a_key: true\n",
+		},
+		{
+			description: "inputs key",
+			contents:    "inputs:\n  kindsys-core: [./schemas]\n",
+		},
+		{
+			description: "output key",
+			contents:    "output:\n  dir: ./generated\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := require.New(t)
+
+			_, err := loadProjectConfig(writeConfigFile(t, tc.contents))
+			req.ErrorContains(err, "invalid configuration")
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package generate
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/jennies"
+)
+
+const watchInterval = 500 * time.Millisecond
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// snapshot describes the state of watched files, indexed by path.
+type snapshot map[string]fileState
+
+func doWatch(allTargets jennies.LanguageJennies, opts Options) error {
+	pipeline, err := newPipeline(allTargets, opts)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	watched := opts.watchedPaths()
+	snapshots := make(map[stage]snapshot, len(watched))
+	for watchedStage, paths := range watched {
+		snapshots[watchedStage] = takeSnapshot(paths)
+	}
+
+	var previousFiles map[string][]byte
+
+	// stage from which the next cycle must start, regardless of the
+	// changes detected: a failed stage must be re-run entirely.
+	retryFrom := stageJennies
+	cycle := func(from stage) {
+		generatedFiles, err := runWatchCycle(ctx, pipeline, from)
+		if err != nil {
+			fmt.Printf("Error: %s\n", err)
+			retryFrom = from
+			return
+		}
+
+		changes := diffGeneratedFiles(previousFiles, generatedFiles)
+		if err := removeFiles(changes.removed); err != nil {
+			fmt.Printf("Error: %s\n", err)
+		}
+
+		printSummary(previousFiles == nil, generatedFiles, changes)
+		previousFiles = generatedFiles
+		retryFrom = stageJennies
+	}
+
+	cycle(stageInputs)
+	fmt.Printf("Watching for changes...\n")
+
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		current := make(map[stage]snapshot, len(watched))
+		for watchedStage, paths := range watched {
+			current[watchedStage] = takeSnapshot(paths)
+		}
+
+		from, changed := changedStage(snapshots, current, retryFrom)
+		if !changed {
+			continue
+		}
+
+		snapshots = current
+
+		fmt.Printf("\nChange detected, re-running from stage '%s'...\n", from)
+		cycle(from)
+	}
+}
+
+func runWatchCycle(ctx context.Context, pipeline *pipeline, from stage) (map[string][]byte, error) {
+	rootCodeJenFS, err := pipeline.run(from)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rootCodeJenFS.Write(ctx, ""); err != nil {
+		return nil, err
+	}
+
+	return filesContent(rootCodeJenFS), nil
+}
+
+// watchedPaths lists the files and directories to watch, grouped by the
+// pipeline stage from which the generation must be re-run when they change.
+func (opts Options) watchedPaths() map[stage][]string {
+	var inputs []string
+	inputs = append(inputs, opts.CueEntrypoints...)
+	inputs = append(inputs, opts.KindsysCoreEntrypoints...)
+	inputs = append(inputs, opts.KindsysComposableEntrypoints...)
+	inputs = append(inputs, opts.KindsysCustomEntrypoints...)
+	inputs = append(inputs, opts.JSONSchemaEntrypoints...)
+	inputs = append(inputs, opts.OpenAPIEntrypoints...)
+	for _, cueImport := range opts.CueImports {
+		inputs = append(inputs, strings.Split(cueImport, ":")[0])
+	}
+	if opts.KindRegistryPath != "" {
+		inputs = append(inputs, opts.KindRegistryPath)
+	}
+	if opts.JSONSchemaRegistryPath != "" {
+		inputs = append(inputs, opts.JSONSchemaRegistryPath)
+	}
+
+	var veneers []string
+	veneers = append(veneers, opts.VeneerConfigFiles...)
+	veneers = append(veneers, opts.VeneerConfigDirectories...)
+
+	var templates []string
+	if opts.PackageTemplates != "" {
+		templates = append(templates, opts.PackageTemplates)
+	}
+	if opts.RepositoryTemplates != "" {
+		templates = append(templates, opts.RepositoryTemplates)
+	}
+
+	return map[stage][]string{
+		stageInputs:         inputs,
+		stageCompilerPasses: opts.CompilerConfigFiles,
+		stageVeneers:        veneers,
+		stageJennies:        templates,
+	}
+}
+
+// takeSnapshot records the state of the given files. Directories are walked
+// recursively. Missing files are ignored: they will appear in the next
+// snapshot taken after their creation.
+func takeSnapshot(paths []string) snapshot {
+	state := make(snapshot)
+
+	for _, root := range paths {
+		_ = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return nil //nolint:nilerr
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				return nil //nolint:nilerr
+			}
+
+			state[path] = fileState{modTime: info.ModTime(), size: info.Size()}
+
+			return nil
+		})
+	}
+
+	return state
+}
+
+// changedStage compares the snapshots of the watched files, grouped by
+// pipeline stage, and returns the earliest stage whose files changed.
+// The returned stage is never later than `retryFrom`.
+func changedStage(previous map[stage]snapshot, current map[stage]snapshot, retryFrom stage) (stage, bool) {
+	changed := false
+	from := retryFrom
+
+	for watchedStage, currentSnapshot := range current {
+		if currentSnapshot.equals(previous[watchedStage]) {
+			continue
+		}
+
+		changed = true
+		if watchedStage < from {
+			from = watchedStage
+		}
+	}
+
+	return from, changed
+}
+
+func (s snapshot) equals(other snapshot) bool {
+	if len(s) != len(other) {
+		return false
+	}
+
+	for path, state := range s {
+		otherState, found := other[path]
+		if !found || !state.modTime.Equal(otherState.modTime) || state.size != otherState.size {
+			return false
+		}
+	}
+
+	return true
+}
+
+func filesContent(generatedFS *codejen.FS) map[string][]byte {
+	files := generatedFS.AsFiles()
+	content := make(map[string][]byte, len(files))
+
+	for _, file := range files {
+		content[file.RelativePath] = file.Data
+	}
+
+	return content
+}
+
+// fileChanges lists the paths of generated files that changed between two
+// watch cycles.
+type fileChanges struct {
+	added    []string
+	modified []string
+	removed  []string
+}
+
+func (changes fileChanges) empty() bool {
+	return len(changes.added) == 0 && len(changes.modified) == 0 && len(changes.removed) == 0
+}
+
+// diffGeneratedFiles compares the files generated by two watch cycles.
+// Paths are sorted.
+func diffGeneratedFiles(previous map[string][]byte, current map[string][]byte) fileChanges {
+	var changes fileChanges
+
+	for path, data := range current {
+		previousData, found := previous[path]
+		if !found {
+			changes.added = append(changes.added, path)
+		} else if !bytes.Equal(previousData, data) {
+			changes.modified = append(changes.modified, path)
+		}
+	}
+	for path := range previous {
+		if _, found := current[path]; !found {
+			changes.removed = append(changes.removed, path)
+		}
+	}
+
+	sort.Strings(changes.added)
+	sort.Strings(changes.modified)
+	sort.Strings(changes.removed)
+
+	return changes
+}
+
+// removeFiles deletes files written by a previous watch cycle that are no
+// longer generated, so that the output matches what a single `generate`
+// run would produce.
+func removeFiles(paths []string) error {
+	var errs []error
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func printSummary(firstCycle bool, current map[string][]byte, changes fileChanges) {
+	if firstCycle {
+		fmt.Printf("Generated %d files.\n", len(current))
+		return
+	}
+
+	if changes.empty() {
+		fmt.Printf("No changes in generated files.\n")
+		return
+	}
+
+	lines := make([]string, 0, len(changes.added)+len(changes.modified)+len(changes.removed))
+	for _, path := range changes.added {
+		lines = append(lines, "+ "+path)
+	}
+	for _, path := range changes.modified {
+		lines = append(lines, "~ "+path)
+	}
+	for _, path := range changes.removed {
+		lines = append(lines, "- "+path+" (removed)")
+	}
+
+	// sort by path rather than by change marker
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i][2:] < lines[j][2:]
+	})
+
+	fmt.Printf("%d generated files changed:\n", len(lines))
+	for _, line := range lines {
+		fmt.Printf("  %s\n", line)
+	}
+}
+
+func (s stage) String() string {
+	switch s {
+	case stageInputs:
+		return "inputs"
+	case stageCompilerPasses:
+		return "compiler passes"
+	case stageVeneers:
+		return "veneers"
+	default:
+		return "jennies"
+	}
+}
@@ -0,0 +1,152 @@
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangedStage(t *testing.T) {
+	unchanged := snapshot{"schema.cue": {modTime: time.Unix(1, 0), size: 10}}
+	modified := snapshot{"schema.cue": {modTime: time.Unix(2, 0), size: 10}}
+
+	testCases := []struct {
+		description   string
+		previous      map[stage]snapshot
+		current       map[stage]snapshot
+		retryFrom     stage
+		expectedStage stage
+		expectChange  bool
+	}{
+		{
+			description:   "no change",
+			previous:      map[stage]snapshot{stageInputs: unchanged, stageVeneers: {}},
+			current:       map[stage]snapshot{stageInputs: unchanged, stageVeneers: {}},
+			retryFrom:     stageJennies,
+			expectedStage: stageJennies,
+			expectChange:  false,
+		},
+		{
+			description:   "modified file",
+			previous:      map[stage]snapshot{stageVeneers: unchanged},
+			current:       map[stage]snapshot{stageVeneers: modified},
+			retryFrom:     stageJennies,
+			expectedStage: stageVeneers,
+			expectChange:  true,
+		},
+		{
+			description:   "new file",
+			previous:      map[stage]snapshot{stageCompilerPasses: {}},
+			current:       map[stage]snapshot{stageCompilerPasses: unchanged},
+			retryFrom:     stageJennies,
+			expectedStage: stageCompilerPasses,
+			expectChange:  true,
+		},
+		{
+			description:   "deleted file",
+			previous:      map[stage]snapshot{stageJennies: unchanged},
+			current:       map[stage]snapshot{stageJennies: {}},
+			retryFrom:     stageJennies,
+			expectedStage: stageJennies,
+			expectChange:  true,
+		},
+		{
+			description:   "earliest changed stage wins",
+			previous:      map[stage]snapshot{stageInputs: unchanged, stageJennies: unchanged},
+			current:       map[stage]snapshot{stageInputs: modified, stageJennies: modified},
+			retryFrom:     stageJennies,
+			expectedStage: stageInputs,
+			expectChange:  true,
+		},
+		{
+			description:   "failed stage is re-run",
+			previous:      map[stage]snapshot{stageJennies: unchanged},
+			current:       map[stage]snapshot{stageJennies: modified},
+			retryFrom:     stageCompilerPasses,
+			expectedStage: stageCompilerPasses,
+			expectChange:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := require.New(t)
+
+			from, changed := changedStage(tc.previous, tc.current, tc.retryFrom)
+
+			req.Equal(tc.expectChange, changed)
+			req.Equal(tc.expectedStage, from)
+		})
+	}
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	schemaPath := filepath.Join(dir, "schemas", "schema.cue")
+	req.NoError(os.MkdirAll(filepath.Dir(schemaPath), 0755))
+	req.NoError(os.WriteFile(schemaPath, []byte("package schema"), 0600))
+
+	paths := []string{filepath.Join(dir, "schemas"), filepath.Join(dir, "missing.yaml")}
+
+	first := takeSnapshot(paths)
+	req.Len(first, 1)
+	req.Contains(first, schemaPath)
+	req.True(first.equals(takeSnapshot(paths)))
+
+	req.NoError(os.WriteFile(schemaPath, []byte("package schema\n\nfoo: string"), 0600))
+	second := takeSnapshot(paths)
+	req.False(second.equals(first))
+
+	req.NoError(os.WriteFile(filepath.Join(dir, "missing.yaml"), []byte("builders: []"), 0600))
+	third := takeSnapshot(paths)
+	req.Len(third, 2)
+	req.False(third.equals(second))
+}
+
+func TestDiffGeneratedFiles(t *testing.T) {
+	req := require.New(t)
+
+	previous := map[string][]byte{
+		"go/dashboard/types_gen.go":   []byte("package dashboard"),
+		"go/dashboard/builder_gen.go": []byte("package dashboard"),
+		"go/loki/types_gen.go":        []byte("package loki"),
+	}
+	current := map[string][]byte{
+		"go/dashboard/types_gen.go":   []byte("package dashboard\n\ntype Dashboard struct{}"),
+		"go/dashboard/builder_gen.go": []byte("package dashboard"),
+		"go/prometheus/types_gen.go":  []byte("package prometheus"),
+		"go/elastic/types_gen.go":     []byte("package elastic"),
+	}
+
+	changes := diffGeneratedFiles(previous, current)
+
+	req.Equal([]string{"go/elastic/types_gen.go", "go/prometheus/types_gen.go"}, changes.added)
+	req.Equal([]string{"go/dashboard/types_gen.go"}, changes.modified)
+	req.Equal([]string{"go/loki/types_gen.go"}, changes.removed)
+	req.False(changes.empty())
+
+	req.True(diffGeneratedFiles(current, current).empty())
+}
+
+func TestRemoveFiles(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	stale := filepath.Join(dir, "stale_gen.go")
+	kept := filepath.Join(dir, "kept_gen.go")
+	req.NoError(os.WriteFile(stale, []byte("package stale"), 0600))
+	req.NoError(os.WriteFile(kept, []byte("package kept"), 0600))
+
+	// files already deleted by the user are not an error
+	req.NoError(removeFiles([]string{stale, filepath.Join(dir, "already_deleted_gen.go")}))
+
+	req.NoFileExists(stale)
+	req.FileExists(kept)
+}
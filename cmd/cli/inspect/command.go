@@ -2,26 +2,67 @@ package inspect
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/grafana/cog/cmd/cli/loaders"
 	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/jennies"
 	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/veneers/rewrite"
+	"github.com/grafana/cog/internal/yaml"
 	"github.com/spf13/cobra"
 )
 
 type inspectOptions struct {
 	LoaderOptions loaders.Options
 	BuilderIR     bool
+	Debug         bool
+
+	// Language for which the IR should be transformed: when set,
+	// the language's compiler passes and veneers are applied.
+	Language                string
+	CompilerConfigFiles     []string
+	VeneerConfigFiles       []string
+	VeneerConfigDirectories []string
+
+	// Filters
+	Package string
+	Object  string
+	Builder string
+}
+
+func (opts inspectOptions) veneers() (*rewrite.Rewriter, error) {
+	veneerFiles, err := yaml.VeneerFiles(opts.VeneerConfigFiles, opts.VeneerConfigDirectories)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.NewVeneersLoader().RewriterFrom(veneerFiles, rewrite.Config{Debug: opts.Debug})
+}
+
+func (opts inspectOptions) compilerPasses() (compiler.Passes, error) {
+	passes, err := yaml.NewCompilerLoader().PassesFrom(opts.CompilerConfigFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Language == "" {
+		return passes, nil
+	}
+
+	targets, err := jennies.All().ForLanguages([]string{opts.Language})
+	if err != nil {
+		return nil, err
+	}
+
+	return passes.Concat(targets[opts.Language].CompilerPasses()), nil
 }
 
 func Command() *cobra.Command {
 	opts := inspectOptions{}
 
-	// TODO:
-	// 	- support inspecting our different IRs: types, builders
-	//  - support inspecting "transformed" IRs: language-specific compiler passes applied
-	// 	  on types IR, veneers applied on the builders IR
 	cmd := &cobra.Command{
 		Use:   "inspect",
 		Short: "Inspects the intermediate representation.", // TODO: better descriptions
@@ -33,6 +74,17 @@ func Command() *cobra.Command {
 
 	cmd.Flags().BoolVar(&opts.BuilderIR, "builder-ir", false, "Inspect the \"builder IR\" instead of the \"types\" one.") // TODO: better usage text
 
+	cmd.Flags().BoolVar(&opts.Debug, "debug", false, "Debugging mode.") // TODO: better usage text
+
+	cmd.Flags().StringVarP(&opts.Language, "language", "l", "", "Language for which the IR is inspected. If set, the compiler passes and veneers for this language are applied.")
+	cmd.Flags().StringArrayVar(&opts.CompilerConfigFiles, "compiler-config", nil, "Compiler configuration file.")
+	cmd.Flags().StringArrayVarP(&opts.VeneerConfigFiles, "veneer", "c", nil, "Veneer configuration file. Requires a language to be set.")
+	cmd.Flags().StringArrayVar(&opts.VeneerConfigDirectories, "veneers", nil, "Veneer configuration directories. Requires a language to be set.")
+
+	cmd.Flags().StringVar(&opts.Package, "package", "", "Only inspect objects and builders from this package.")
+	cmd.Flags().StringVar(&opts.Object, "object", "", "Only inspect the object with this name.")
+	cmd.Flags().StringVar(&opts.Builder, "builder", "", "Only inspect the builder with this name. Implies --builder-ir.")
+
 	cmd.Flags().StringArrayVar(&opts.LoaderOptions.CueEntrypoints, "cue", nil, "CUE input schema.")                                                  // TODO: better usage text
 	cmd.Flags().StringArrayVar(&opts.LoaderOptions.KindsysCoreEntrypoints, "kindsys-core", nil, "Kindys core kinds input schema.")                   // TODO: better usage text
 	cmd.Flags().StringArrayVar(&opts.LoaderOptions.KindsysComposableEntrypoints, "kindsys-composable", nil, "Kindys composable kinds input schema.") // TODO: better usage text
@@ -42,6 +94,7 @@ func Command() *cobra.Command {
 	cmd.Flags().StringVar(&opts.LoaderOptions.KindRegistryPath, "kind-registry", "", "Kind registry input.")                                         // TODO: better usage text
 	cmd.Flags().StringVar(&opts.LoaderOptions.JSONSchemaRegistryPath, "jsonschema-registry", "", "JSONschema registry input.")                       // TODO: better usage text
 
+	cmd.Flags().StringToStringVar(&opts.LoaderOptions.JSONSchemaPackageMapping, "jsonschema-package", nil, "Package in which a JSON schema will be generated. Format: [file path or URI]=[package]. Applies to referenced schemas as well as to entrypoints.")
 	cmd.Flags().StringArrayVarP(&opts.LoaderOptions.CueImports, "include-cue-import", "I", nil, "Specify an additional library import directory. Format: [path]:[import]. Example: '../grafana/common-library:github.com/grafana/grafana/packages/grafana-schema/src/common")
 	cmd.Flags().StringVar(&opts.LoaderOptions.KindRegistryVersion, "kind-registry-version", "next", "Schemas version")
 
@@ -52,32 +105,115 @@ func Command() *cobra.Command {
 	_ = cmd.MarkFlagDirname("jsonschema-registry")
 	_ = cmd.MarkFlagFilename("jsonschema")
 	_ = cmd.MarkFlagDirname("openapi")
+	_ = cmd.MarkFlagFilename("veneer")
+	_ = cmd.MarkFlagDirname("veneers")
+	_ = cmd.MarkFlagFilename("compiler-config")
 
 	return cmd
 }
 
 func doInspect(opts inspectOptions) error {
+	if opts.Language == "" && (len(opts.VeneerConfigFiles) != 0 || len(opts.VeneerConfigDirectories) != 0) {
+		return errors.New("veneers can only be applied if a language is set")
+	}
+
+	compilerPasses, err := opts.compilerPasses()
+	if err != nil {
+		return err
+	}
+
 	schemas, err := loaders.LoadAll(opts.LoaderOptions)
 	if err != nil {
 		return err
 	}
 
-	if opts.BuilderIR {
-		return inspectBuilderIR(schemas)
+	schemas, err = compilerPasses.Process(schemas)
+	if err != nil {
+		return err
+	}
+
+	if opts.BuilderIR || opts.Builder != "" {
+		return inspectBuilderIR(opts, schemas)
 	}
 
-	return prettyPrintJSON(schemas)
+	return prettyPrintJSON(filterSchemas(opts, schemas))
 }
 
-func inspectBuilderIR(schemas []*ast.Schema) error {
+func inspectBuilderIR(opts inspectOptions, schemas ast.Schemas) error {
 	generator := &ast.BuilderGenerator{}
+	builders := generator.FromAST(schemas)
+
+	if opts.Language != "" {
+		veneers, err := opts.veneers()
+		if err != nil {
+			return err
+		}
+
+		builders, err = veneers.ApplyTo(schemas, builders, opts.Language)
+		if err != nil {
+			return err
+		}
+	}
 
 	return prettyPrintJSON(common.Context{
-		Schemas:  schemas,
-		Builders: generator.FromAST(schemas),
+		Schemas:  filterSchemas(opts, schemas),
+		Builders: filterBuilders(opts, builders),
 	})
 }
 
+func filterSchemas(opts inspectOptions, schemas ast.Schemas) ast.Schemas {
+	if opts.Package == "" && opts.Object == "" {
+		return schemas
+	}
+
+	filtered := make(ast.Schemas, 0, len(schemas))
+	for _, schema := range schemas {
+		if opts.Package != "" && schema.Package != opts.Package {
+			continue
+		}
+
+		if opts.Object == "" {
+			filtered = append(filtered, schema)
+			continue
+		}
+
+		if !schema.Objects.Has(opts.Object) {
+			continue
+		}
+
+		filteredSchema := ast.NewSchema(schema.Package, schema.Metadata)
+		filteredSchema.EntryPoint = schema.EntryPoint
+		filteredSchema.AddObject(schema.Objects.Get(opts.Object))
+
+		filtered = append(filtered, filteredSchema)
+	}
+
+	return filtered
+}
+
+func filterBuilders(opts inspectOptions, builders ast.Builders) ast.Builders {
+	if opts.Package == "" && opts.Object == "" && opts.Builder == "" {
+		return builders
+	}
+
+	filtered := make(ast.Builders, 0, len(builders))
+	for _, builder := range builders {
+		if opts.Package != "" && builder.Package != opts.Package {
+			continue
+		}
+		if opts.Object != "" && builder.For.Name != opts.Object {
+			continue
+		}
+		if opts.Builder != "" && builder.Name != opts.Builder {
+			continue
+		}
+
+		filtered = append(filtered, builder)
+	}
+
+	return filtered
+}
+
 func prettyPrintJSON(input any) error {
 	marshaled, err := json.MarshalIndent(input, "", "  ")
 	if err != nil {
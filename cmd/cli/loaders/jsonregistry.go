@@ -33,14 +33,14 @@ func jsonschemaRegistryLoader(opts Options) (ast.Schemas, error) {
 		return nil, fmt.Errorf("could not locate dataqueries entrypoints: %w", err)
 	}
 
-	coreSchemas, err := loadJSONEntryPoints(coreEntrypoints, ast.SchemaMeta{
+	coreSchemas, err := loadJSONEntryPoints(opts, coreEntrypoints, ast.SchemaMeta{
 		Kind: ast.SchemaKindCore,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	panelSchemas, err := loadJSONEntryPoints(panelsEntrypoints, ast.SchemaMeta{
+	panelSchemas, err := loadJSONEntryPoints(opts, panelsEntrypoints, ast.SchemaMeta{
 		Kind:    ast.SchemaKindComposable,
 		Variant: ast.SchemaVariantPanel,
 	})
@@ -48,7 +48,7 @@ func jsonschemaRegistryLoader(opts Options) (ast.Schemas, error) {
 		return nil, err
 	}
 
-	dataqueriesSchemas, err := loadJSONEntryPoints(dataqueriesEntrypoints, ast.SchemaMeta{
+	dataqueriesSchemas, err := loadJSONEntryPoints(opts, dataqueriesEntrypoints, ast.SchemaMeta{
 		Kind:    ast.SchemaKindComposable,
 		Variant: ast.SchemaVariantDataQuery,
 	})
@@ -63,7 +63,7 @@ func jsonschemaRegistryLoader(opts Options) (ast.Schemas, error) {
 	return allSchemas, nil
 }
 
-func loadJSONEntryPoints(entrypoints []string, schemaMeta ast.SchemaMeta) ([]*ast.Schema, error) {
+func loadJSONEntryPoints(opts Options, entrypoints []string, schemaMeta ast.SchemaMeta) ([]*ast.Schema, error) {
 	allSchemas := make([]*ast.Schema, 0, len(entrypoints))
 	for _, entrypoint := range entrypoints {
 		reader, err := os.Open(entrypoint)
@@ -71,20 +71,22 @@ func loadJSONEntryPoints(entrypoints []string, schemaMeta ast.SchemaMeta) ([]*as
 			return nil, err
 		}
 
-		pkg := guessPackageFromFilename(entrypoint)
+		pkg := opts.jsonschemaPackage(entrypoint)
 
 		meta := schemaMeta
 		meta.Identifier = pkg
 
-		schemaAst, err := jsonschema.GenerateAST(reader, jsonschema.Config{
+		schemas, err := jsonschema.GenerateAST(reader, jsonschema.Config{
 			Package:        pkg,
+			URL:            entrypoint,
+			PackageMapping: opts.JSONSchemaPackageMapping,
 			SchemaMetadata: meta,
 		})
 		if err != nil {
 			return nil, err
 		}
 
-		allSchemas = append(allSchemas, schemaAst)
+		allSchemas = append(allSchemas, schemas...)
 	}
 
 	return allSchemas, nil
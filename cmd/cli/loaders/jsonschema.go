@@ -15,8 +15,10 @@ func jsonschemaLoader(opts Options) (ast.Schemas, error) {
 			return nil, err
 		}
 
-		schemaAst, err := jsonschema.GenerateAST(reader, jsonschema.Config{
-			Package:        guessPackageFromFilename(entrypoint),
+		schemas, err := jsonschema.GenerateAST(reader, jsonschema.Config{
+			Package:        opts.jsonschemaPackage(entrypoint),
+			URL:            entrypoint,
+			PackageMapping: opts.JSONSchemaPackageMapping,
 			SchemaMetadata: ast.SchemaMeta{
 				// TODO: extract these from somewhere
 			},
@@ -25,7 +27,7 @@ func jsonschemaLoader(opts Options) (ast.Schemas, error) {
 			return nil, err
 		}
 
-		allSchemas = append(allSchemas, schemaAst)
+		allSchemas = append(allSchemas, schemas...)
 	}
 
 	return allSchemas, nil
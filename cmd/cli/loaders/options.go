@@ -50,6 +50,13 @@ type Options struct {
 	// Cue-specific options
 	CueImports []string
 
+	// JSONSchema-specific options
+
+	// JSONSchemaPackageMapping associates JSON schemas, identified by
+	// their file path or URI, to the package they will be generated into.
+	// It applies to entrypoints as well as to referenced schemas.
+	JSONSchemaPackageMapping map[string]string
+
 	// Kind registry-specific options
 	KindRegistryVersion string
 }
@@ -75,6 +82,14 @@ func (opts Options) cueIncludeImports() ([]simplecue.LibraryInclude, error) {
 	return imports, nil
 }
 
+func (opts Options) jsonschemaPackage(entrypoint string) string {
+	if pkg, found := opts.JSONSchemaPackageMapping[entrypoint]; found {
+		return pkg
+	}
+
+	return guessPackageFromFilename(entrypoint)
+}
+
 func ForSchemaType(schemaType LoaderRef) (Loader, error) {
 	all := loadersMap()
 
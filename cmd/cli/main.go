@@ -3,6 +3,7 @@ package main
 import (
 	"os"
 
+	"github.com/grafana/cog/cmd/cli/diff"
 	"github.com/grafana/cog/cmd/cli/generate"
 	"github.com/grafana/cog/cmd/cli/inspect"
 	"github.com/spf13/cobra"
@@ -17,6 +18,7 @@ func main() {
 
 	rootCmd.AddCommand(generate.Command())
 	rootCmd.AddCommand(inspect.Command())
+	rootCmd.AddCommand(diff.Command())
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
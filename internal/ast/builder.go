@@ -56,6 +56,14 @@ func (builder *Builder) AddToVeneerTrail(veneerName string) {
 }
 
 func (builder *Builder) MakePath(builders Builders, pathAsString string) (Path, error) {
+	return builders.MakePathFromType(builder.For.Type, pathAsString)
+}
+
+type Builders []Builder
+
+// MakePathFromType resolves a path described as a dot-separated list of field names,
+// starting from the given type.
+func (builders Builders) MakePathFromType(rootType Type, pathAsString string) (Path, error) {
 	if pathAsString == "" {
 		return nil, fmt.Errorf("can not make path from empty input")
 	}
@@ -69,7 +77,7 @@ func (builder *Builder) MakePath(builders Builders, pathAsString string) (Path,
 		return referredObjBuilder, nil
 	}
 
-	currentType := builder.For.Type
+	currentType := rootType
 
 	var path Path
 
@@ -104,8 +112,6 @@ func (builder *Builder) MakePath(builders Builders, pathAsString string) (Path,
 	return path, nil
 }
 
-type Builders []Builder
-
 func (builders Builders) LocateByObject(pkg string, name string) (Builder, bool) {
 	for _, builder := range builders {
 		if builder.For.SelfRef.ReferredPkg == pkg && builder.For.SelfRef.ReferredType == name {
@@ -365,13 +371,8 @@ func ArgumentAssignment(path Path, argument Argument, opts ...AssignmentOpt) Ass
 }
 
 func FieldAssignment(field StructField, opts ...AssignmentOpt) Assignment {
-	var constraints []TypeConstraint
-	if field.Type.IsScalar() {
-		constraints = field.Type.AsScalar().Constraints
-	}
-
 	argument := Argument{Name: field.Name, Type: field.Type}
-	allOpts := []AssignmentOpt{Constraints(constraints)}
+	allOpts := []AssignmentOpt{Constraints(field.Type.Constraints())}
 	allOpts = append(allOpts, opts...)
 
 	return ArgumentAssignment(PathFromStructField(field), argument, allOpts...)
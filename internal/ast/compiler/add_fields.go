@@ -23,7 +23,7 @@ func (pass *AddFields) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
 
 func (pass *AddFields) processSchema(schema *ast.Schema) *ast.Schema {
 	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
-		if !pass.Object.Matches(object) {
+		if !pass.Object.Matches(schema, object) {
 			return object
 		}
 
@@ -102,6 +102,11 @@ func (pass *AnonymousEnumToExplicitType) processStruct(pkg string, parentName st
 		def.Struct.Fields[i] = newField
 	}
 
+	if def.Struct.AdditionalProperties != nil {
+		additionalProperties := pass.processType(pkg, parentName, tools.UpperCamelCase(parentName)+"AdditionalProperties", *def.Struct.AdditionalProperties)
+		def.Struct.AdditionalProperties = &additionalProperties
+	}
+
 	return def
 }
 
@@ -68,6 +68,8 @@ func (pass *AnonymousStructsToNamed) processObject(object ast.Object) ast.Object
 			name := parentName + tools.UpperCamelCase(field.Name)
 			object.Type.Struct.Fields[i].Type = pass.processType(pkg, name, field.Type)
 		}
+
+		object.Type.Struct.AdditionalProperties = pass.processAdditionalProperties(pkg, parentName, object.Type.Struct.AdditionalProperties)
 	}
 
 	return newObject
@@ -123,6 +125,8 @@ func (pass *AnonymousStructsToNamed) processStruct(pkg string, parentName string
 		objectDef.Struct.Fields[i].Type = pass.processType(pkg, name, field.Type)
 	}
 
+	objectDef.Struct.AdditionalProperties = pass.processAdditionalProperties(pkg, parentName, objectDef.Struct.AdditionalProperties)
+
 	newObject := ast.NewObject(pkg, parentName, objectDef)
 	newObject.AddToPassesTrail("AnonymousStructsToNamed")
 
@@ -134,3 +138,13 @@ func (pass *AnonymousStructsToNamed) processStruct(pkg string, parentName string
 
 	return ref
 }
+
+func (pass *AnonymousStructsToNamed) processAdditionalProperties(pkg string, parentName string, def *ast.Type) *ast.Type {
+	if def == nil {
+		return nil
+	}
+
+	additionalProperties := pass.processType(pkg, parentName+"AdditionalProperties", *def)
+
+	return &additionalProperties
+}
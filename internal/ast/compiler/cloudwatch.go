@@ -10,17 +10,7 @@ var _ Pass = (*Cloudwatch)(nil)
 
 // Cloudwatch rewrites a part of the cloudwatch schema.
 //
-// In that schema, the `QueryEditorExpression` type is defined as a disjunction
-// for which the discriminator and mapping can not be inferred.
-// This compiler pass is here to define that mapping.
-//
-// The `QueryEditorArrayExpression` struct type is also modified to simplify the
-// definition of its `expression` field from `[...#QueryEditorExpression] | [...#QueryEditorArrayExpression]` to
-// `[...#QueryEditorExpression]`.
-// This should be semantically equivalent since `#QueryEditorExpression` is a
-// union type that includes `#QueryEditorArrayExpression`.
-//
-// The Cloudwatch pass also alerts the definition of the `#CloudWatchMetricsQuery`, `#CloudWatchLogsQuery` and
+// It alters the definition of the `#CloudWatchMetricsQuery`, `#CloudWatchLogsQuery` and
 // `#CloudWatchAnnotationQuery` types.
 // It removes the "dataquery variant" hint they carry, and defines a `CloudWatchQuery` type instead as a disjunction.
 // That disjunction serves as "dataquery entrypoint" for cloudwatch.
@@ -49,13 +39,6 @@ func (pass *Cloudwatch) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
 
 func (pass *Cloudwatch) processSchema(schema *ast.Schema) (*ast.Schema, error) {
 	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
-		if object.Name == "QueryEditorExpression" {
-			return pass.processQueryEditorExpression(object)
-		}
-		if object.Name == "QueryEditorArrayExpression" {
-			return pass.processQueryEditorArrayExpression(object)
-		}
-
 		// types hinted as a dataquery are replaced by a "CloudWatchQuery" disjunction,
 		// serving as a "main entrypoint" for cloudwatch queries.
 		if object.Type.ImplementsVariant() && object.Type.ImplementedVariant() == string(ast.SchemaVariantDataQuery) {
@@ -119,49 +102,3 @@ func (pass *Cloudwatch) defineQueryDisjunction(schema *ast.Schema) ast.Object {
 
 	return newObject
 }
-
-func (pass *Cloudwatch) processQueryEditorExpression(object ast.Object) ast.Object {
-	if !object.Type.IsDisjunction() {
-		return object
-	}
-
-	object.Type.Disjunction.Discriminator = "type"
-	object.Type.Disjunction.DiscriminatorMapping = map[string]string{
-		"and":               "QueryEditorArrayExpression",
-		"or":                "QueryEditorArrayExpression",
-		"property":          "QueryEditorPropertyExpression",
-		"groupBy":           "QueryEditorGroupByExpression",
-		"function":          "QueryEditorFunctionExpression",
-		"functionParameter": "QueryEditorFunctionParameterExpression",
-		"operator":          "QueryEditorOperatorExpression",
-	}
-	object.AddToPassesTrail("Cloudwatch[set discriminator field + mapping]")
-
-	return object
-}
-
-func (pass *Cloudwatch) processQueryEditorArrayExpression(object ast.Object) ast.Object {
-	if !object.Type.IsStruct() {
-		return object
-	}
-
-	structDef := object.Type.AsStruct()
-	fields := make([]ast.StructField, 0, len(structDef.Fields)-1)
-
-	for _, field := range structDef.Fields {
-		if field.Name != "expressions" {
-			fields = append(fields, field)
-			continue
-		}
-
-		newField := field.DeepCopy()
-		newField.Type = newField.Type.Disjunction.Branches[0]
-		newField.AddToPassesTrail("Cloudwatch[removed disjunction]")
-
-		fields = append(fields, newField)
-	}
-
-	object.Type.Struct.Fields = fields
-
-	return object
-}
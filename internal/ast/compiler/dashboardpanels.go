@@ -18,7 +18,8 @@ var _ Pass = (*DashboardPanelsRewrite)(nil)
 //	panels: [...#Panel | #GraphPanel | #HeatmapPanel]
 //	```
 //
-// These definitions become:
+// These definitions become (and the Panel object is identified as the host
+// of panelcfg variants):
 //
 //	```
 //	# In the Dashboard object
@@ -67,6 +68,16 @@ func (pass *DashboardPanelsRewrite) processSchema(schema *ast.Schema) (*ast.Sche
 
 			return pass.overwritePanelsFieldType(object, newPanelsFieldType)
 		}
+		if object.Name == dashboardPanelObject && object.Type.IsStruct() {
+			if object.Type.Hints == nil {
+				object.Type.Hints = make(ast.JenniesHints)
+			}
+
+			object.Type.Hints[ast.HintPanelcfgVariantHost] = true
+			object.AddToPassesTrail("DashboardPanelsRewrite[hint.PanelcfgVariantHost]")
+
+			return object
+		}
 		if object.Name == dashboardRowPanelObject {
 			newPanelsFieldType := ast.NewArray(ast.NewRef(schema.Package, "Panel"))
 
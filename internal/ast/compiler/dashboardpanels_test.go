@@ -51,6 +51,13 @@ func TestDashboardPanelsRewrite(t *testing.T) {
 	}
 
 	// Prepare expected output
+	expectedPanel := ast.NewObject("dashboard", "Panel", ast.NewStruct(
+		ast.NewStructField("Title", ast.String()),
+		ast.NewStructField("Type", ast.String()),
+	))
+	expectedPanel.Type.Hints[ast.HintPanelcfgVariantHost] = true
+	expectedPanel.AddToPassesTrail("DashboardPanelsRewrite[hint.PanelcfgVariantHost]")
+
 	expected := ast.Schemas{
 		// Unchanged
 		schemas[0],
@@ -59,10 +66,7 @@ func TestDashboardPanelsRewrite(t *testing.T) {
 		&ast.Schema{
 			Package: "dashboard",
 			Objects: testutils.ObjectsMap(
-				ast.NewObject("dashboard", "Panel", ast.NewStruct(
-					ast.NewStructField("Title", ast.String()),
-					ast.NewStructField("Type", ast.String()),
-				)),
+				expectedPanel,
 				ast.NewObject("dashboard", "RowPanel", ast.NewStruct(
 					ast.NewStructField("Title", ast.String()),
 					ast.NewStructField("Type", ast.String(ast.Value("row"))),
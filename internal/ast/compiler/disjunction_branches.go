@@ -0,0 +1,228 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
+)
+
+var _ Pass = (*DisjunctionBranches)(nil)
+
+// DisjunctionBranches rewrites the branches of a disjunction defined by a
+// struct field. The disjunction can be wrapped in arrays or maps.
+//
+// Branches listed in Remove are identified by their type name (see ast.TypeName).
+// The entries of the discriminator mapping referring to them are removed as well.
+//
+// Branches listed in Add are appended to the disjunction, unless a branch with
+// the same type name already exists. Mapping lists the discriminator values
+// identifying added branches: they are merged into the discriminator mapping.
+// Adding a reference to a disjunction that has a discriminator mapping
+// without a matching entry in Mapping is an error.
+//
+// A disjunction left with a single branch is replaced by that branch. Removing
+// every branch of a disjunction is an error.
+//
+// Example:
+//
+//	```
+//	panels: [...#Panel | #GraphPanel | #HeatmapPanel]
+//	```
+//
+// With Remove set to ["GraphPanel", "HeatmapPanel"], becomes:
+//
+//	```
+//	panels: [...#Panel]
+//	```
+type DisjunctionBranches struct {
+	Field   FieldReference
+	Remove  []string
+	Add     []ast.Type
+	Mapping map[string]string // discriminator value → type name of an added branch
+}
+
+func (pass *DisjunctionBranches) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
+	for i, schema := range schemas {
+		newSchema, err := pass.processSchema(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		schemas[i] = newSchema
+	}
+
+	return schemas, nil
+}
+
+func (pass *DisjunctionBranches) processSchema(schema *ast.Schema) (*ast.Schema, error) {
+	var err error
+
+	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
+		if err != nil {
+			return object
+		}
+
+		object, err = pass.processObject(schema, object)
+
+		return object
+	})
+
+	return schema, err
+}
+
+func (pass *DisjunctionBranches) processObject(schema *ast.Schema, object ast.Object) (ast.Object, error) {
+	if !object.Type.IsStruct() {
+		return object, nil
+	}
+
+	for i, field := range object.Type.Struct.Fields {
+		if !pass.Field.Matches(schema, object, field) {
+			continue
+		}
+
+		newType, changed, err := pass.processType(field.Type)
+		if err != nil {
+			return object, fmt.Errorf("%s.%s: %w", object.SelfRef.String(), field.Name, err)
+		}
+		if !changed {
+			continue
+		}
+
+		object.Type.Struct.Fields[i].Type = newType
+		object.Type.Struct.Fields[i].AddToPassesTrail(fmt.Sprintf("DisjunctionBranches[%s]", pass.trail()))
+	}
+
+	return object, nil
+}
+
+func (pass *DisjunctionBranches) processType(def ast.Type) (ast.Type, bool, error) {
+	var err error
+	changed := false
+
+	if def.IsArray() {
+		def.Array.ValueType, changed, err = pass.processType(def.Array.ValueType)
+		return def, changed, err
+	}
+
+	if def.IsMap() {
+		def.Map.ValueType, changed, err = pass.processType(def.Map.ValueType)
+		return def, changed, err
+	}
+
+	if !def.IsDisjunction() {
+		return def, false, nil
+	}
+
+	disjunction := def.AsDisjunction().DeepCopy()
+	branches := make(ast.Types, 0, len(disjunction.Branches)+len(pass.Add))
+	removedRefs := make([]string, 0, len(pass.Remove))
+
+	for _, branch := range disjunction.Branches {
+		if !tools.ItemInList(ast.TypeName(branch), pass.Remove) {
+			branches = append(branches, branch)
+			continue
+		}
+
+		changed = true
+		if branch.IsRef() {
+			removedRefs = append(removedRefs, branch.AsRef().ReferredType)
+		}
+	}
+
+	addedRefs := make([]string, 0, len(pass.Add))
+	for _, newBranch := range pass.Add {
+		if pass.hasBranch(branches, newBranch) {
+			continue
+		}
+
+		changed = true
+		branches = append(branches, newBranch)
+		if newBranch.IsRef() {
+			addedRefs = append(addedRefs, newBranch.AsRef().ReferredType)
+		}
+	}
+
+	if !changed {
+		return def, false, nil
+	}
+
+	if len(branches) == 0 {
+		return def, false, fmt.Errorf("can not remove every branch of the disjunction")
+	}
+
+	if len(branches) == 1 {
+		return branches[0], true, nil
+	}
+
+	for value, typeName := range disjunction.DiscriminatorMapping {
+		if tools.ItemInList(typeName, removedRefs) {
+			delete(disjunction.DiscriminatorMapping, value)
+		}
+	}
+
+	if err = pass.mapAddedRefs(&disjunction, addedRefs); err != nil {
+		return def, false, err
+	}
+
+	disjunction.Branches = branches
+	def.Disjunction = &disjunction
+
+	return def, true, nil
+}
+
+func (pass *DisjunctionBranches) mapAddedRefs(disjunction *ast.DisjunctionType, addedRefs []string) error {
+	hadMapping := len(disjunction.DiscriminatorMapping) != 0
+
+	for _, typeName := range addedRefs {
+		mapped := false
+		for value, mappedType := range pass.Mapping {
+			if mappedType != typeName {
+				continue
+			}
+
+			if disjunction.DiscriminatorMapping == nil {
+				disjunction.DiscriminatorMapping = make(map[string]string)
+			}
+
+			disjunction.DiscriminatorMapping[value] = typeName
+			mapped = true
+		}
+
+		if !mapped && hadMapping {
+			return fmt.Errorf("no discriminator value given for added branch '%s'", typeName)
+		}
+	}
+
+	return nil
+}
+
+func (pass *DisjunctionBranches) hasBranch(branches ast.Types, branch ast.Type) bool {
+	for _, candidate := range branches {
+		if ast.TypeName(candidate) == ast.TypeName(branch) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (pass *DisjunctionBranches) trail() string {
+	parts := make([]string, 0, 2)
+
+	if len(pass.Remove) != 0 {
+		parts = append(parts, "removed "+strings.Join(pass.Remove, ", "))
+	}
+
+	if len(pass.Add) != 0 {
+		added := make([]string, 0, len(pass.Add))
+		for _, branch := range pass.Add {
+			added = append(added, ast.TypeName(branch))
+		}
+
+		parts = append(parts, "added "+strings.Join(added, ", "))
+	}
+
+	return strings.Join(parts, "; ")
+}
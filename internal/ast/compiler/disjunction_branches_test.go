@@ -0,0 +1,208 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisjunctionBranches_removeBranches(t *testing.T) {
+	// Prepare test input
+	disjunction := ast.NewDisjunction(ast.Types{
+		ast.NewRef("test", "Panel"),
+		ast.NewRef("test", "RowPanel"),
+		ast.NewRef("test", "GraphPanel"),
+	})
+	disjunction.Disjunction.Discriminator = "type"
+	disjunction.Disjunction.DiscriminatorMapping = map[string]string{
+		"row":   "RowPanel",
+		"graph": "GraphPanel",
+		"*":     "Panel",
+	}
+
+	objects := []ast.Object{
+		ast.NewObject("test", "Dashboard", ast.NewStruct(
+			ast.NewStructField("panels", ast.NewArray(disjunction)),
+		)),
+	}
+
+	expectedDisjunction := ast.NewDisjunction(ast.Types{
+		ast.NewRef("test", "Panel"),
+		ast.NewRef("test", "RowPanel"),
+	})
+	expectedDisjunction.Disjunction.Discriminator = "type"
+	expectedDisjunction.Disjunction.DiscriminatorMapping = map[string]string{
+		"row": "RowPanel",
+		"*":   "Panel",
+	}
+
+	expected := []ast.Object{
+		ast.NewObject("test", "Dashboard", ast.NewStruct(
+			ast.NewStructField("panels", ast.NewArray(expectedDisjunction), ast.PassesTrail("DisjunctionBranches[removed GraphPanel]")),
+		)),
+	}
+
+	pass := &DisjunctionBranches{
+		Field:  FieldReference{Package: "test", Object: "Dashboard", Field: "panels"},
+		Remove: []string{"GraphPanel"},
+	}
+
+	// Run the compiler pass
+	runPassOnObjects(t, pass, objects, expected)
+}
+
+func TestDisjunctionBranches_singleBranchLeft(t *testing.T) {
+	// Prepare test input
+	objects := []ast.Object{
+		ast.NewObject("test", "RowPanel", ast.NewStruct(
+			ast.NewStructField("panels", ast.NewArray(ast.NewDisjunction(ast.Types{
+				ast.NewRef("test", "Panel"),
+				ast.NewRef("test", "GraphPanel"),
+				ast.NewRef("test", "HeatmapPanel"),
+			}))),
+		)),
+	}
+
+	expected := []ast.Object{
+		ast.NewObject("test", "RowPanel", ast.NewStruct(
+			ast.NewStructField("panels", ast.NewArray(ast.NewRef("test", "Panel")), ast.PassesTrail("DisjunctionBranches[removed GraphPanel, HeatmapPanel]")),
+		)),
+	}
+
+	pass := &DisjunctionBranches{
+		Field:  FieldReference{Package: "test", Object: "RowPanel", Field: "panels"},
+		Remove: []string{"GraphPanel", "HeatmapPanel"},
+	}
+
+	// Run the compiler pass
+	runPassOnObjects(t, pass, objects, expected)
+}
+
+func TestDisjunctionBranches_addBranches(t *testing.T) {
+	// Prepare test input
+	objects := []ast.Object{
+		ast.NewObject("test", "SomeObject", ast.NewStruct(
+			ast.NewStructField("value", ast.NewDisjunction(ast.Types{
+				ast.String(),
+				ast.Bool(),
+			})),
+		)),
+	}
+
+	expected := []ast.Object{
+		ast.NewObject("test", "SomeObject", ast.NewStruct(
+			ast.NewStructField("value", ast.NewDisjunction(ast.Types{
+				ast.String(),
+				ast.Bool(),
+				ast.NewScalar(ast.KindInt64),
+			}), ast.PassesTrail("DisjunctionBranches[added Int64, Bool]")),
+		)),
+	}
+
+	pass := &DisjunctionBranches{
+		Field: FieldReference{Package: "test", Object: "SomeObject", Field: "value"},
+		// bool is already a branch: it won't be added twice
+		Add: ast.Types{ast.NewScalar(ast.KindInt64), ast.Bool()},
+	}
+
+	// Run the compiler pass
+	runPassOnObjects(t, pass, objects, expected)
+}
+
+func TestDisjunctionBranches_addBranchesWithMapping(t *testing.T) {
+	// Prepare test input
+	disjunction := ast.NewDisjunction(ast.Types{
+		ast.NewRef("test", "Panel"),
+		ast.NewRef("test", "RowPanel"),
+	})
+	disjunction.Disjunction.Discriminator = "type"
+	disjunction.Disjunction.DiscriminatorMapping = map[string]string{
+		"row": "RowPanel",
+		"*":   "Panel",
+	}
+
+	objects := []ast.Object{
+		ast.NewObject("test", "Dashboard", ast.NewStruct(
+			ast.NewStructField("panels", ast.NewArray(disjunction)),
+		)),
+	}
+
+	expectedDisjunction := ast.NewDisjunction(ast.Types{
+		ast.NewRef("test", "Panel"),
+		ast.NewRef("test", "RowPanel"),
+		ast.NewRef("test", "LibraryPanel"),
+	})
+	expectedDisjunction.Disjunction.Discriminator = "type"
+	expectedDisjunction.Disjunction.DiscriminatorMapping = map[string]string{
+		"row":     "RowPanel",
+		"library": "LibraryPanel",
+		"*":       "Panel",
+	}
+
+	expected := []ast.Object{
+		ast.NewObject("test", "Dashboard", ast.NewStruct(
+			ast.NewStructField("panels", ast.NewArray(expectedDisjunction), ast.PassesTrail("DisjunctionBranches[added LibraryPanel]")),
+		)),
+	}
+
+	pass := &DisjunctionBranches{
+		Field:   FieldReference{Package: "test", Object: "Dashboard", Field: "panels"},
+		Add:     ast.Types{ast.NewRef("test", "LibraryPanel")},
+		Mapping: map[string]string{"library": "LibraryPanel"},
+	}
+
+	// Run the compiler pass
+	runPassOnObjects(t, pass, objects, expected)
+}
+
+func TestDisjunctionBranches_addBranchesWithoutMapping(t *testing.T) {
+	req := require.New(t)
+
+	// Prepare test input
+	disjunction := ast.NewDisjunction(ast.Types{
+		ast.NewRef("test", "Panel"),
+		ast.NewRef("test", "RowPanel"),
+	})
+	disjunction.Disjunction.Discriminator = "type"
+	disjunction.Disjunction.DiscriminatorMapping = map[string]string{
+		"row": "RowPanel",
+		"*":   "Panel",
+	}
+
+	schema := ast.NewSchema("test", ast.SchemaMeta{})
+	schema.AddObject(ast.NewObject("test", "Dashboard", ast.NewStruct(
+		ast.NewStructField("panels", ast.NewArray(disjunction)),
+	)))
+
+	pass := &DisjunctionBranches{
+		Field: FieldReference{Package: "test", Object: "Dashboard", Field: "panels"},
+		Add:   ast.Types{ast.NewRef("test", "LibraryPanel")},
+	}
+
+	// Run the compiler pass
+	_, err := pass.Process(ast.Schemas{schema})
+	req.ErrorContains(err, "no discriminator value given for added branch 'LibraryPanel'")
+}
+
+func TestDisjunctionBranches_removeAllBranches(t *testing.T) {
+	req := require.New(t)
+
+	// Prepare test input
+	schema := ast.NewSchema("test", ast.SchemaMeta{})
+	schema.AddObject(ast.NewObject("test", "SomeObject", ast.NewStruct(
+		ast.NewStructField("value", ast.NewDisjunction(ast.Types{
+			ast.String(),
+			ast.Bool(),
+		})),
+	)))
+
+	pass := &DisjunctionBranches{
+		Field:  FieldReference{Package: "test", Object: "SomeObject", Field: "value"},
+		Remove: []string{"String", "Bool"},
+	}
+
+	// Run the compiler pass
+	_, err := pass.Process(ast.Schemas{schema})
+	req.ErrorContains(err, "can not remove every branch of the disjunction")
+}
@@ -177,6 +177,18 @@ func (pass *DisjunctionToType) processStruct(schema *ast.Schema, def ast.Type) (
 	newStruct := def
 	newStruct.Struct.Fields = processedFields
 
+	if def.Struct.AdditionalProperties != nil {
+		additionalProperties, err := pass.processType(schema, *def.Struct.AdditionalProperties)
+		if err != nil {
+			return ast.Type{}, errors.Join(
+				fmt.Errorf("could not process additional properties"),
+				err,
+			)
+		}
+
+		newStruct.Struct.AdditionalProperties = &additionalProperties
+	}
+
 	return newStruct, nil
 }
 
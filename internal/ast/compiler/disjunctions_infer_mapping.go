@@ -119,6 +119,18 @@ func (pass *DisjunctionInferMapping) processStruct(schema *ast.Schema, def ast.T
 		}
 	}
 
+	if def.Struct.AdditionalProperties != nil {
+		additionalProperties, err := pass.processType(schema, *def.Struct.AdditionalProperties)
+		if err != nil {
+			return ast.Type{}, errors.Join(
+				fmt.Errorf("could not process additional properties"),
+				err,
+			)
+		}
+
+		def.Struct.AdditionalProperties = &additionalProperties
+	}
+
 	return def, nil
 }
 
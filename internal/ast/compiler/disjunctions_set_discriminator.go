@@ -0,0 +1,123 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
+)
+
+var _ Pass = (*DisjunctionSetDiscriminator)(nil)
+
+// DisjunctionSetDiscriminator explicitly defines the discriminator field and
+// mapping of a disjunction, for cases where the DisjunctionInferMapping pass
+// can not infer them.
+//
+// The disjunction is either the type of an object, or the type of one of its
+// fields if Field is set.
+//
+// Alternatively, KeepBranch can be used to simplify the disjunction by
+// replacing it with one of its branches. This is useful when a branch is a
+// superset of the others, which makes the disjunction ambiguous.
+//
+// Types that are not disjunctions are left untouched.
+type DisjunctionSetDiscriminator struct {
+	Object ObjectReference
+	Field  string // Optional.
+
+	Discriminator        string
+	DiscriminatorMapping map[string]string
+
+	// KeepBranch is the index of the branch that will replace the disjunction.
+	KeepBranch *int
+}
+
+func (pass *DisjunctionSetDiscriminator) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
+	var err error
+
+	for i, schema := range schemas {
+		schemas[i], err = pass.processSchema(schema)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schemas, nil
+}
+
+func (pass *DisjunctionSetDiscriminator) processSchema(schema *ast.Schema) (*ast.Schema, error) {
+	var err error
+
+	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
+		if !pass.Object.Matches(schema, object) {
+			return object
+		}
+
+		processedObject, innerErr := pass.processObject(object)
+		if innerErr != nil {
+			err = innerErr
+			return object
+		}
+
+		return processedObject
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+func (pass *DisjunctionSetDiscriminator) processObject(object ast.Object) (ast.Object, error) {
+	var err error
+
+	if pass.Field == "" {
+		object.Type, err = pass.processType(object.Type)
+		if err != nil {
+			return object, fmt.Errorf("could not process object '%s': %w", object.Name, err)
+		}
+
+		return object, nil
+	}
+
+	if !object.Type.IsStruct() {
+		return object, nil
+	}
+
+	for i, field := range object.Type.Struct.Fields {
+		if !tools.GlobMatchEqualFold(pass.Field, field.Name) {
+			continue
+		}
+
+		object.Type.Struct.Fields[i].Type, err = pass.processType(field.Type)
+		if err != nil {
+			return object, fmt.Errorf("could not process field '%s.%s': %w", object.Name, field.Name, err)
+		}
+	}
+
+	return object, nil
+}
+
+func (pass *DisjunctionSetDiscriminator) processType(def ast.Type) (ast.Type, error) {
+	if !def.IsDisjunction() {
+		return def, nil
+	}
+
+	if pass.KeepBranch != nil {
+		branches := def.AsDisjunction().Branches
+		if *pass.KeepBranch < 0 || *pass.KeepBranch >= len(branches) {
+			return def, fmt.Errorf("branch %d does not exist in disjunction with %d branches", *pass.KeepBranch, len(branches))
+		}
+
+		newType := branches[*pass.KeepBranch].DeepCopy()
+		newType.AddToPassesTrail(fmt.Sprintf("DisjunctionSetDiscriminator[kept branch %d]", *pass.KeepBranch))
+
+		return newType, nil
+	}
+
+	def.Disjunction.Discriminator = pass.Discriminator
+	def.Disjunction.DiscriminatorMapping = pass.DiscriminatorMapping
+	def.AddToPassesTrail(fmt.Sprintf("DisjunctionSetDiscriminator[discriminator=%s]", pass.Discriminator))
+
+	return def, nil
+}
@@ -0,0 +1,99 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisjunctionSetDiscriminator_onObject(t *testing.T) {
+	// Prepare test input
+	objects := []ast.Object{
+		ast.NewObject("test", "Expression", ast.NewDisjunction(ast.Types{
+			ast.NewRef("test", "ArrayExpression"),
+			ast.NewRef("test", "PropertyExpression"),
+		})),
+	}
+
+	disjunction := ast.NewDisjunction(ast.Types{
+		ast.NewRef("test", "ArrayExpression"),
+		ast.NewRef("test", "PropertyExpression"),
+	})
+	disjunction.AddToPassesTrail("DisjunctionSetDiscriminator[discriminator=type]")
+	disjunction.Disjunction.Discriminator = "type"
+	disjunction.Disjunction.DiscriminatorMapping = map[string]string{
+		"and":      "ArrayExpression",
+		"or":       "ArrayExpression",
+		"property": "PropertyExpression",
+	}
+
+	expected := []ast.Object{
+		ast.NewObject("test", "Expression", disjunction),
+	}
+
+	pass := &DisjunctionSetDiscriminator{
+		Object:        ObjectReference{Package: "test", Object: "Expression"},
+		Discriminator: "type",
+		DiscriminatorMapping: map[string]string{
+			"and":      "ArrayExpression",
+			"or":       "ArrayExpression",
+			"property": "PropertyExpression",
+		},
+	}
+
+	// Run the compiler pass
+	runPassOnObjects(t, pass, objects, expected)
+}
+
+func TestDisjunctionSetDiscriminator_keepBranchOnField(t *testing.T) {
+	// Prepare test input
+	objects := []ast.Object{
+		ast.NewObject("test", "ArrayExpression", ast.NewStruct(
+			ast.NewStructField("type", ast.String()),
+			ast.NewStructField("expressions", ast.NewDisjunction(ast.Types{
+				ast.NewArray(ast.NewRef("test", "Expression")),
+				ast.NewArray(ast.NewRef("test", "ArrayExpression")),
+			})),
+		)),
+	}
+
+	expressions := ast.NewArray(ast.NewRef("test", "Expression"))
+	expressions.AddToPassesTrail("DisjunctionSetDiscriminator[kept branch 0]")
+
+	expected := []ast.Object{
+		ast.NewObject("test", "ArrayExpression", ast.NewStruct(
+			ast.NewStructField("type", ast.String()),
+			ast.NewStructField("expressions", expressions),
+		)),
+	}
+
+	branch := 0
+	pass := &DisjunctionSetDiscriminator{
+		Object:     ObjectReference{Package: "test", Object: "ArrayExpression"},
+		Field:      "expressions",
+		KeepBranch: &branch,
+	}
+
+	// Run the compiler pass
+	runPassOnObjects(t, pass, objects, expected)
+}
+
+func TestDisjunctionSetDiscriminator_keepUnknownBranch(t *testing.T) {
+	req := require.New(t)
+
+	schema := ast.NewSchema("test", ast.SchemaMeta{})
+	schema.AddObject(ast.NewObject("test", "Expression", ast.NewDisjunction(ast.Types{
+		ast.String(),
+		ast.Bool(),
+	})))
+
+	branch := 2
+	pass := &DisjunctionSetDiscriminator{
+		Object:     ObjectReference{Package: "test", Object: "Expression"},
+		KeepBranch: &branch,
+	}
+
+	_, err := pass.Process(ast.Schemas{schema})
+	req.ErrorContains(err, "branch 2 does not exist in disjunction with 2 branches")
+}
@@ -91,6 +91,11 @@ func (pass *DisjunctionWithNullToOptional) processStruct(def ast.Type) ast.Type
 		def.Struct.Fields[i].Type = pass.processType(field.Type)
 	}
 
+	if def.Struct.AdditionalProperties != nil {
+		additionalProperties := pass.processType(*def.Struct.AdditionalProperties)
+		def.Struct.AdditionalProperties = &additionalProperties
+	}
+
 	return def
 }
 
@@ -22,19 +22,21 @@ func (pass *FieldsSetDefault) Process(schemas []*ast.Schema) ([]*ast.Schema, err
 }
 
 func (pass *FieldsSetDefault) processSchema(schema *ast.Schema) *ast.Schema {
-	schema.Objects = schema.Objects.Map(pass.processObject)
+	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
+		return pass.processObject(schema, object)
+	})
 
 	return schema
 }
 
-func (pass *FieldsSetDefault) processObject(_ string, object ast.Object) ast.Object {
+func (pass *FieldsSetDefault) processObject(schema *ast.Schema, object ast.Object) ast.Object {
 	if !object.Type.IsStruct() {
 		return object
 	}
 
 	for i, field := range object.Type.AsStruct().Fields {
 		for fieldRef, value := range pass.DefaultValues {
-			if !fieldRef.Matches(object, field) {
+			if !fieldRef.Matches(schema, object, field) {
 				continue
 			}
 
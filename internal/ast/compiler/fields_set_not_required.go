@@ -20,19 +20,21 @@ func (pass *FieldsSetNotRequired) Process(schemas []*ast.Schema) ([]*ast.Schema,
 }
 
 func (pass *FieldsSetNotRequired) processSchema(schema *ast.Schema) *ast.Schema {
-	schema.Objects = schema.Objects.Map(pass.processObject)
+	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
+		return pass.processObject(schema, object)
+	})
 
 	return schema
 }
 
-func (pass *FieldsSetNotRequired) processObject(_ string, object ast.Object) ast.Object {
+func (pass *FieldsSetNotRequired) processObject(schema *ast.Schema, object ast.Object) ast.Object {
 	if !object.Type.IsStruct() {
 		return object
 	}
 
 	for i, field := range object.Type.AsStruct().Fields {
 		for _, fieldRef := range pass.Fields {
-			if !fieldRef.Matches(object, field) {
+			if !fieldRef.Matches(schema, object, field) {
 				continue
 			}
 
@@ -20,19 +20,21 @@ func (pass *FieldsSetRequired) Process(schemas []*ast.Schema) ([]*ast.Schema, er
 }
 
 func (pass *FieldsSetRequired) processSchema(schema *ast.Schema) *ast.Schema {
-	schema.Objects = schema.Objects.Map(pass.processObject)
+	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
+		return pass.processObject(schema, object)
+	})
 
 	return schema
 }
 
-func (pass *FieldsSetRequired) processObject(_ string, object ast.Object) ast.Object {
+func (pass *FieldsSetRequired) processObject(schema *ast.Schema, object ast.Object) ast.Object {
 	if !object.Type.IsStruct() {
 		return object
 	}
 
 	for i, field := range object.Type.AsStruct().Fields {
 		for _, fieldRef := range pass.Fields {
-			if !fieldRef.Matches(object, field) {
+			if !fieldRef.Matches(schema, object, field) {
 				continue
 			}
 
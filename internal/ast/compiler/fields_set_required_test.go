@@ -45,3 +45,56 @@ func TestFieldsSetRequired(t *testing.T) {
 	// Run the compiler pass
 	runPassOnSchema(t, pass, schema, expected)
 }
+
+func TestFieldsSetRequired_withGlobsAndPredicates(t *testing.T) {
+	// Prepare test input
+	panelSchema := &ast.Schema{
+		Package:  "timeseries",
+		Metadata: ast.SchemaMeta{Kind: ast.SchemaKindComposable, Variant: ast.SchemaVariantPanel},
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("timeseries", "Options", ast.NewStruct(
+				ast.NewStructField("legendShow", ast.Bool(ast.Nullable())),
+				ast.NewStructField("legendMode", ast.String(ast.Nullable())),
+			)),
+		),
+	}
+	dataquerySchema := &ast.Schema{
+		Package:  "prometheus",
+		Metadata: ast.SchemaMeta{Kind: ast.SchemaKindComposable, Variant: ast.SchemaVariantDataQuery},
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("prometheus", "Options", ast.NewStruct(
+				ast.NewStructField("legendShow", ast.Bool(ast.Nullable())),
+			)),
+		),
+	}
+
+	expectedPanelSchema := &ast.Schema{
+		Package:  "timeseries",
+		Metadata: ast.SchemaMeta{Kind: ast.SchemaKindComposable, Variant: ast.SchemaVariantPanel},
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("timeseries", "Options", ast.NewStruct(
+				ast.NewStructField("legendShow", ast.Bool(), ast.Required(), ast.PassesTrail("FieldsSetRequired[nullable=false, required=true]")),
+				// not a bool: left untouched
+				ast.NewStructField("legendMode", ast.String(ast.Nullable())),
+			)),
+		),
+	}
+
+	pass := &FieldsSetRequired{
+		Fields: []FieldReference{
+			{
+				Package: "*",
+				Object:  "options",
+				Field:   "legend*",
+				Predicates: ast.Predicates{
+					Variant:    ast.SchemaVariantPanel,
+					ScalarKind: ast.KindBool,
+				},
+			},
+		},
+	}
+
+	// Run the compiler pass
+	// the dataquery schema is left untouched
+	runPassOnSchemas(t, pass, ast.Schemas{panelSchema, dataquerySchema}, ast.Schemas{expectedPanelSchema, dataquerySchema})
+}
@@ -102,6 +102,11 @@ func (pass *FlattenDisjunctions) processStruct(schema *ast.Schema, def ast.Type)
 		def.Struct.Fields[i].Type = pass.processType(schema, field.Type)
 	}
 
+	if def.Struct.AdditionalProperties != nil {
+		additionalProperties := pass.processType(schema, *def.Struct.AdditionalProperties)
+		def.Struct.AdditionalProperties = &additionalProperties
+	}
+
 	return def
 }
 
@@ -0,0 +1,132 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/grafana/cog/internal/ast"
+)
+
+var _ Pass = (*MoveObject)(nil)
+
+// MoveObject moves the objects matching the given reference from their
+// package to another one.
+// References to moved objects are updated accordingly, in every schema.
+//
+// If no schema exists for the destination package, it is created.
+type MoveObject struct {
+	Object ObjectReference
+	To     string
+}
+
+func (pass *MoveObject) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
+	var matches []ast.RefType
+	for _, schema := range schemas {
+		if schema.Package == pass.To {
+			continue
+		}
+
+		schema.Objects.Iterate(func(_ string, object ast.Object) {
+			if pass.Object.Matches(schema, object) {
+				matches = append(matches, object.SelfRef)
+			}
+		})
+	}
+
+	var err error
+	for _, ref := range matches {
+		// previous moves might have updated the object: fetch a fresh copy
+		object, _ := ast.Schemas(schemas).LocateObject(ref.ReferredPkg, ref.ReferredType)
+
+		schemas, err = pass.moveObject(schemas, object)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schemas, nil
+}
+
+func (pass *MoveObject) moveObject(schemas []*ast.Schema, object ast.Object) ([]*ast.Schema, error) {
+	if _, exists := ast.Schemas(schemas).LocateObject(pass.To, object.Name); exists {
+		return nil, fmt.Errorf("can not move '%s': object already exists in package '%s'", object.SelfRef.String(), pass.To)
+	}
+
+	from := object.SelfRef
+	to := ast.RefType{ReferredPkg: pass.To, ReferredType: object.Name}
+
+	var destination *ast.Schema
+
+	for _, schema := range schemas {
+		if schema.Package == from.ReferredPkg {
+			schema.Objects.Remove(object.Name)
+		}
+
+		schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
+			object.Type = pass.processType(from, to, object.Type)
+			return object
+		})
+
+		for i, operation := range schema.Operations {
+			schema.Operations[i] = processOperationTypes(operation, func(def ast.Type) ast.Type {
+				return pass.processType(from, to, def)
+			})
+		}
+
+		if schema.Package == pass.To && destination == nil {
+			destination = schema
+		}
+	}
+
+	if destination == nil {
+		destination = ast.NewSchema(pass.To, ast.SchemaMeta{})
+		schemas = append(schemas, destination)
+	}
+
+	object.Type = pass.processType(from, to, object.Type)
+	object.SelfRef = to
+	object.AddToPassesTrail(fmt.Sprintf("MoveObject[%s → %s]", from.ReferredPkg, pass.To))
+
+	destination.AddObject(object)
+
+	return schemas, nil
+}
+
+func (pass *MoveObject) processType(from ast.RefType, to ast.RefType, def ast.Type) ast.Type {
+	if def.IsArray() {
+		def.Array.ValueType = pass.processType(from, to, def.Array.ValueType)
+	}
+
+	if def.IsMap() {
+		def.Map.IndexType = pass.processType(from, to, def.Map.IndexType)
+		def.Map.ValueType = pass.processType(from, to, def.Map.ValueType)
+	}
+
+	if def.IsStruct() {
+		for i, field := range def.Struct.Fields {
+			def.Struct.Fields[i].Type = pass.processType(from, to, field.Type)
+		}
+
+		if def.Struct.AdditionalProperties != nil {
+			additionalProperties := pass.processType(from, to, *def.Struct.AdditionalProperties)
+			def.Struct.AdditionalProperties = &additionalProperties
+		}
+	}
+
+	if def.IsDisjunction() {
+		for i, branch := range def.Disjunction.Branches {
+			def.Disjunction.Branches[i] = pass.processType(from, to, branch)
+		}
+	}
+
+	if def.IsIntersection() {
+		for i, branch := range def.Intersection.Branches {
+			def.Intersection.Branches[i] = pass.processType(from, to, branch)
+		}
+	}
+
+	if def.IsRef() && def.Ref.ReferredPkg == from.ReferredPkg && def.Ref.ReferredType == from.ReferredType {
+		def.Ref.ReferredPkg = to.ReferredPkg
+	}
+
+	return def
+}
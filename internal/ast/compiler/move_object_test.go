@@ -0,0 +1,161 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveObject(t *testing.T) {
+	// Prepare test input
+	input := ast.Schemas{
+		{
+			Package: "dashboard",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("dashboard", "Dashboard", ast.NewStruct(
+					ast.NewStructField("links", ast.NewArray(ast.NewRef("dashboard", "Link"))),
+				)),
+				ast.NewObject("dashboard", "Link", ast.NewStruct(
+					ast.NewStructField("url", ast.String()),
+				)),
+			),
+		},
+		{
+			Package: "common",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("common", "Unit", ast.String()),
+			),
+		},
+	}
+
+	movedLink := ast.NewObject("common", "Link", ast.NewStruct(
+		ast.NewStructField("url", ast.String()),
+	))
+	movedLink.AddToPassesTrail("MoveObject[dashboard → common]")
+
+	expected := ast.Schemas{
+		{
+			Package: "dashboard",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("dashboard", "Dashboard", ast.NewStruct(
+					ast.NewStructField("links", ast.NewArray(ast.NewRef("common", "Link"))),
+				)),
+			),
+		},
+		{
+			Package: "common",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("common", "Unit", ast.String()),
+				movedLink,
+			),
+		},
+	}
+
+	pass := &MoveObject{
+		Object: ObjectReference{Package: "dashboard", Object: "Link"},
+		To:     "common",
+	}
+
+	// Run the compiler pass
+	runPassOnSchemas(t, pass, input, expected)
+}
+
+func TestMoveObject_createsDestinationSchema(t *testing.T) {
+	req := require.New(t)
+
+	// Prepare test input
+	input := ast.Schemas{
+		{
+			Package: "dashboard",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("dashboard", "Link", ast.String()),
+			),
+		},
+	}
+
+	pass := &MoveObject{
+		Object: ObjectReference{Package: "dashboard", Object: "Link"},
+		To:     "common",
+	}
+
+	// Run the compiler pass
+	schemas, err := pass.Process(input)
+	req.NoError(err)
+	req.Len(schemas, 2)
+	req.Equal(0, schemas[0].Objects.Len())
+
+	_, found := ast.Schemas(schemas).LocateObject("common", "Link")
+	req.True(found)
+}
+
+func TestMoveObject_conflict(t *testing.T) {
+	req := require.New(t)
+
+	// Prepare test input
+	input := ast.Schemas{
+		{
+			Package: "dashboard",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("dashboard", "Link", ast.String()),
+			),
+		},
+		{
+			Package: "common",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("common", "Link", ast.String()),
+			),
+		},
+	}
+
+	pass := &MoveObject{
+		Object: ObjectReference{Package: "dashboard", Object: "Link"},
+		To:     "common",
+	}
+
+	// Run the compiler pass
+	_, err := pass.Process(input)
+	req.ErrorContains(err, "object already exists in package 'common'")
+}
+
+func TestMoveObject_withGlob(t *testing.T) {
+	req := require.New(t)
+
+	// Prepare test input
+	input := ast.Schemas{
+		{
+			Package: "dashboard",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("dashboard", "Dashboard", ast.NewStruct(
+					ast.NewStructField("links", ast.NewArray(ast.NewRef("dashboard", "DashboardLink"))),
+					ast.NewStructField("externalLink", ast.NewRef("dashboard", "ExternalLink")),
+				)),
+				ast.NewObject("dashboard", "DashboardLink", ast.String()),
+				ast.NewObject("dashboard", "ExternalLink", ast.String()),
+			),
+		},
+	}
+
+	pass := &MoveObject{
+		Object: ObjectReference{Package: "dash*", Object: "*link"},
+		To:     "common",
+	}
+
+	// Run the compiler pass
+	schemas, err := pass.Process(input)
+	req.NoError(err)
+	req.Len(schemas, 2)
+
+	dashboard, found := ast.Schemas(schemas).LocateObject("dashboard", "Dashboard")
+	req.True(found)
+	req.Equal(ast.NewArray(ast.NewRef("common", "DashboardLink")), dashboard.Type.Struct.Fields[0].Type)
+	req.Equal(ast.NewRef("common", "ExternalLink"), dashboard.Type.Struct.Fields[1].Type)
+
+	_, found = ast.Schemas(schemas).LocateObject("common", "DashboardLink")
+	req.True(found)
+	_, found = ast.Schemas(schemas).LocateObject("common", "ExternalLink")
+	req.True(found)
+	_, found = ast.Schemas(schemas).LocateObject("dashboard", "ExternalLink")
+	req.False(found)
+}
@@ -25,7 +25,7 @@ func (pass *NameAnonymousStruct) processSchema(schema *ast.Schema) *ast.Schema {
 	var newObject ast.Object
 
 	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
-		currentObject, newObjectCandidate := pass.processObject(object)
+		currentObject, newObjectCandidate := pass.processObject(schema, object)
 		if newObjectCandidate.Name != "" {
 			newObject = newObjectCandidate
 		}
@@ -41,7 +41,7 @@ func (pass *NameAnonymousStruct) processSchema(schema *ast.Schema) *ast.Schema {
 	return schema
 }
 
-func (pass *NameAnonymousStruct) processObject(object ast.Object) (ast.Object, ast.Object) {
+func (pass *NameAnonymousStruct) processObject(schema *ast.Schema, object ast.Object) (ast.Object, ast.Object) {
 	var newObject ast.Object
 
 	if !object.Type.IsStruct() {
@@ -51,7 +51,7 @@ func (pass *NameAnonymousStruct) processObject(object ast.Object) (ast.Object, a
 	pkg := object.SelfRef.ReferredPkg
 
 	for i, field := range object.Type.AsStruct().Fields {
-		if !pass.Field.Matches(object, field) {
+		if !pass.Field.Matches(schema, object, field) {
 			continue
 		}
 
@@ -87,5 +87,10 @@ func (pass *NotRequiredFieldAsNullableType) processStruct(def ast.Type) ast.Type
 		}
 	}
 
+	if def.Struct.AdditionalProperties != nil {
+		additionalProperties := pass.processType(*def.Struct.AdditionalProperties)
+		def.Struct.AdditionalProperties = &additionalProperties
+	}
+
 	return def
 }
@@ -23,7 +23,7 @@ func (pass *Omit) processSchema(schema *ast.Schema) *ast.Schema {
 	schema.Objects = schema.Objects.Filter(func(_ string, object ast.Object) bool {
 		// if any reference matches the current object, we filter it out
 		for _, objectRef := range pass.Objects {
-			if objectRef.Matches(object) {
+			if objectRef.Matches(schema, object) {
 				return false
 			}
 		}
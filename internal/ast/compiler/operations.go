@@ -0,0 +1,28 @@
+package compiler
+
+import (
+	"github.com/grafana/cog/internal/ast"
+)
+
+// processOperationTypes applies the given function to every type referenced
+// by an operation: its parameters object, its request body and its responses bodies.
+func processOperationTypes(operation ast.Operation, processType func(def ast.Type) ast.Type) ast.Operation {
+	if operation.Params != nil {
+		params := processType(ast.Type{Kind: ast.KindRef, Ref: operation.Params})
+		if params.IsRef() {
+			operation.Params = params.Ref
+		}
+	}
+
+	if operation.RequestBody != nil {
+		operation.RequestBody.Type = processType(operation.RequestBody.Type)
+	}
+
+	for i, response := range operation.Responses {
+		if response.Body != nil {
+			operation.Responses[i].Body.Type = processType(response.Body.Type)
+		}
+	}
+
+	return operation
+}
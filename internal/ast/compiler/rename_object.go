@@ -8,12 +8,42 @@ import (
 
 var _ Pass = (*RenameObject)(nil)
 
+// RenameObject renames the objects matching the given reference.
+// References to renamed objects are updated accordingly, in every schema.
+//
+// Object names being unique within a package, a reference matching several
+// objects of the same package is rejected.
 type RenameObject struct {
 	From ObjectReference
 	To   string
+
+	renamed map[ast.RefType]bool
 }
 
 func (pass *RenameObject) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
+	pass.renamed = make(map[ast.RefType]bool)
+
+	for _, schema := range schemas {
+		var matches []ast.Object
+		schema.Objects.Iterate(func(_ string, object ast.Object) {
+			if pass.From.Matches(schema, object) {
+				matches = append(matches, object)
+			}
+		})
+
+		if len(matches) == 0 {
+			continue
+		}
+		if len(matches) > 1 {
+			return nil, fmt.Errorf("can not rename '%s.%s' to '%s': %d objects match in package '%s'", pass.From.Package, pass.From.Object, pass.To, len(matches), schema.Package)
+		}
+		if _, exists := schema.LocateObject(pass.To); exists && matches[0].Name != pass.To {
+			return nil, fmt.Errorf("can not rename '%s' to '%s': object already exists in package '%s'", matches[0].SelfRef.String(), pass.To, schema.Package)
+		}
+
+		pass.renamed[matches[0].SelfRef] = true
+	}
+
 	for i, schema := range schemas {
 		schemas[i] = pass.processSchema(schema)
 	}
@@ -22,12 +52,14 @@ func (pass *RenameObject) Process(schemas []*ast.Schema) ([]*ast.Schema, error)
 }
 
 func (pass *RenameObject) processSchema(schema *ast.Schema) *ast.Schema {
+	var originalName string
 	var renamedObject ast.Object
 
 	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
-		if pass.From.Matches(object) {
+		if pass.renamed[object.SelfRef] {
 			object.AddToPassesTrail(fmt.Sprintf("RenameObject[%s → %s]", object.Name, pass.To))
 
+			originalName = object.Name
 			object.Name = pass.To
 			object.SelfRef.ReferredType = pass.To
 
@@ -39,11 +71,15 @@ func (pass *RenameObject) processSchema(schema *ast.Schema) *ast.Schema {
 		return object
 	})
 
-	if renamedObject.Name != "" {
-		schema.Objects.Remove(pass.From.Object)
+	if originalName != "" {
+		schema.Objects.Remove(originalName)
 		schema.AddObject(renamedObject)
 	}
 
+	for i, operation := range schema.Operations {
+		schema.Operations[i] = processOperationTypes(operation, pass.processType)
+	}
+
 	return schema
 }
 
@@ -105,7 +141,7 @@ func (pass *RenameObject) processIntersection(def ast.Type) ast.Type {
 }
 
 func (pass *RenameObject) processRef(def ast.Type) ast.Type {
-	if def.Ref.ReferredType == pass.From.Object {
+	if pass.renamed[*def.Ref] {
 		def.Ref.ReferredType = pass.To
 	}
 
@@ -117,5 +153,10 @@ func (pass *RenameObject) processStruct(def ast.Type) ast.Type {
 		def.Struct.Fields[i].Type = pass.processType(field.Type)
 	}
 
+	if def.Struct.AdditionalProperties != nil {
+		additionalProperties := pass.processType(*def.Struct.AdditionalProperties)
+		def.Struct.AdditionalProperties = &additionalProperties
+	}
+
 	return def
 }
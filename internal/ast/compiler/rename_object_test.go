@@ -5,6 +5,7 @@ import (
 
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRenameObject(t *testing.T) {
@@ -42,3 +43,202 @@ func TestRenameObject(t *testing.T) {
 	// Run the compiler pass
 	runPassOnSchema(t, pass, schema, expected)
 }
+
+func TestRenameObject_withAdditionalProperties(t *testing.T) {
+	withAdditionalProperties := func(def ast.Type, valueType ast.Type) ast.Type {
+		additionalProperties := ast.NewMap(ast.String(), valueType)
+		def.Struct.AdditionalProperties = &additionalProperties
+
+		return def
+	}
+
+	// Prepare test input
+	schema := &ast.Schema{
+		Package: "rename_object",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("rename_object", "SomeObject", withAdditionalProperties(ast.NewStruct(
+				ast.NewStructField("foo", ast.String()),
+			), ast.NewRef("rename_object", "NotANiceName"))),
+			ast.NewObject("rename_object", "NotANiceName", ast.NewStruct(
+				ast.NewStructField("AString", ast.String()),
+			)),
+		),
+	}
+	expected := &ast.Schema{
+		Package: "rename_object",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("rename_object", "SomeObject", withAdditionalProperties(ast.NewStruct(
+				ast.NewStructField("foo", ast.String()),
+			), ast.NewRef("rename_object", "ReallyNiceName"))),
+			ast.NewObject("rename_object", "ReallyNiceName", ast.NewStruct(
+				ast.NewStructField("AString", ast.String()),
+			), "RenameObject[NotANiceName → ReallyNiceName]"),
+		),
+	}
+
+	pass := &RenameObject{
+		From: ObjectReference{Package: schema.Package, Object: "NotANiceName"},
+		To:   "ReallyNiceName",
+	}
+
+	// Run the compiler pass
+	runPassOnSchema(t, pass, schema, expected)
+}
+
+func TestRenameObject_withOperations(t *testing.T) {
+	// Prepare test input
+	schema := &ast.Schema{
+		Package: "rename_object",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("rename_object", "GetDashboardParams", ast.NewStruct(
+				ast.NewStructField("uid", ast.String()),
+			)),
+			ast.NewObject("rename_object", "GetDashboardResponse", ast.NewStruct(
+				ast.NewStructField("title", ast.String()),
+			)),
+		),
+		Operations: []ast.Operation{
+			{
+				Name:       "getDashboard",
+				Method:     "GET",
+				Path:       "/dashboards/{uid}",
+				Params:     &ast.RefType{ReferredPkg: "rename_object", ReferredType: "GetDashboardParams"},
+				Parameters: []ast.OperationParameter{{Name: "uid", In: ast.ParameterInPath}},
+				RequestBody: &ast.OperationBody{
+					ContentType: "application/json",
+					Type:        ast.NewRef("rename_object", "GetDashboardResponse"),
+				},
+				Responses: []ast.OperationResponse{
+					{
+						Status:  "200",
+						Success: true,
+						Body: &ast.OperationBody{
+							ContentType: "application/json",
+							Type:        ast.NewArray(ast.NewRef("rename_object", "GetDashboardResponse")),
+						},
+					},
+				},
+			},
+		},
+	}
+	expected := &ast.Schema{
+		Package: "rename_object",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("rename_object", "GetDashboardParams", ast.NewStruct(
+				ast.NewStructField("uid", ast.String()),
+			)),
+			ast.NewObject("rename_object", "DashboardResponse", ast.NewStruct(
+				ast.NewStructField("title", ast.String()),
+			), "RenameObject[GetDashboardResponse → DashboardResponse]"),
+		),
+		Operations: []ast.Operation{
+			{
+				Name:       "getDashboard",
+				Method:     "GET",
+				Path:       "/dashboards/{uid}",
+				Params:     &ast.RefType{ReferredPkg: "rename_object", ReferredType: "GetDashboardParams"},
+				Parameters: []ast.OperationParameter{{Name: "uid", In: ast.ParameterInPath}},
+				RequestBody: &ast.OperationBody{
+					ContentType: "application/json",
+					Type:        ast.NewRef("rename_object", "DashboardResponse"),
+				},
+				Responses: []ast.OperationResponse{
+					{
+						Status:  "200",
+						Success: true,
+						Body: &ast.OperationBody{
+							ContentType: "application/json",
+							Type:        ast.NewArray(ast.NewRef("rename_object", "DashboardResponse")),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pass := &RenameObject{
+		From: ObjectReference{Package: schema.Package, Object: "GetDashboardResponse"},
+		To:   "DashboardResponse",
+	}
+
+	// Run the compiler pass
+	runPassOnSchema(t, pass, schema, expected)
+}
+
+func TestRenameObject_withGlob(t *testing.T) {
+	// Prepare test input
+	input := ast.Schemas{
+		{
+			Package: "rename_object",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("rename_object", "SomeObject", ast.NewStruct(
+					ast.NewStructField("ref_to_nice_object", ast.NewRef("rename_object", "NotANiceName")),
+				)),
+				ast.NewObject("rename_object", "NotANiceName", ast.String()),
+			),
+		},
+		{
+			Package: "other",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("other", "OtherObject", ast.NewStruct(
+					ast.NewStructField("ref_to_nice_object", ast.NewRef("rename_object", "NotANiceName")),
+					ast.NewStructField("homonym", ast.NewRef("other", "NotANiceName")),
+				)),
+				ast.NewObject("other", "NotANiceName", ast.String()),
+			),
+		},
+	}
+	expected := ast.Schemas{
+		{
+			Package: "rename_object",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("rename_object", "SomeObject", ast.NewStruct(
+					ast.NewStructField("ref_to_nice_object", ast.NewRef("rename_object", "ReallyNiceName")),
+				)),
+				ast.NewObject("rename_object", "ReallyNiceName", ast.String(), "RenameObject[NotANiceName → ReallyNiceName]"),
+			),
+		},
+		{
+			Package: "other",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("other", "OtherObject", ast.NewStruct(
+					ast.NewStructField("ref_to_nice_object", ast.NewRef("rename_object", "ReallyNiceName")),
+					ast.NewStructField("homonym", ast.NewRef("other", "NotANiceName")),
+				)),
+				ast.NewObject("other", "NotANiceName", ast.String()),
+			),
+		},
+	}
+
+	pass := &RenameObject{
+		From: ObjectReference{Package: "rename_*", Object: "notanice*"},
+		To:   "ReallyNiceName",
+	}
+
+	// Run the compiler pass
+	runPassOnSchemas(t, pass, input, expected)
+}
+
+func TestRenameObject_rejectsSeveralMatches(t *testing.T) {
+	req := require.New(t)
+
+	// Prepare test input
+	input := ast.Schemas{
+		{
+			Package: "rename_object",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("rename_object", "FirstObject", ast.String()),
+				ast.NewObject("rename_object", "SecondObject", ast.String()),
+			),
+		},
+	}
+
+	pass := &RenameObject{
+		From: ObjectReference{Package: "rename_object", Object: "*Object"},
+		To:   "Renamed",
+	}
+
+	// Run the compiler pass
+	_, err := pass.Process(input)
+	req.ErrorContains(err, "2 objects match in package 'rename_object'")
+}
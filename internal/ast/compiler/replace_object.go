@@ -0,0 +1,39 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/grafana/cog/internal/ast"
+)
+
+var _ Pass = (*ReplaceObject)(nil)
+
+// ReplaceObject replaces the definition of an object with the given type.
+// The object keeps its name, comments and package: only its type is changed.
+type ReplaceObject struct {
+	Object ObjectReference
+	With   ast.Type
+}
+
+func (pass *ReplaceObject) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
+	for i, schema := range schemas {
+		schemas[i] = pass.processSchema(schema)
+	}
+
+	return schemas, nil
+}
+
+func (pass *ReplaceObject) processSchema(schema *ast.Schema) *ast.Schema {
+	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
+		if !pass.Object.Matches(schema, object) {
+			return object
+		}
+
+		object.AddToPassesTrail(fmt.Sprintf("ReplaceObject[%s → %s]", ast.TypeName(object.Type), ast.TypeName(pass.With)))
+		object.Type = pass.With.DeepCopy()
+
+		return object
+	})
+
+	return schema
+}
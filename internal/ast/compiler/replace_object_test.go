@@ -0,0 +1,35 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+)
+
+func TestReplaceObject(t *testing.T) {
+	// Prepare test input
+	objects := []ast.Object{
+		ast.NewObject("test", "Model", ast.NewMap(ast.String(), ast.Any())),
+		ast.NewObject("test", "Other", ast.String()),
+	}
+
+	replaced := ast.NewObject("test", "Model", ast.NewStruct(
+		ast.NewStructField("title", ast.String()),
+	))
+	replaced.AddToPassesTrail("ReplaceObject[Map → Struct]")
+
+	expected := []ast.Object{
+		replaced,
+		ast.NewObject("test", "Other", ast.String()),
+	}
+
+	pass := &ReplaceObject{
+		Object: ObjectReference{Package: "test", Object: "Model"},
+		With: ast.NewStruct(
+			ast.NewStructField("title", ast.String()),
+		),
+	}
+
+	// Run the compiler pass
+	runPassOnObjects(t, pass, objects, expected)
+}
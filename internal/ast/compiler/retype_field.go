@@ -23,19 +23,19 @@ func (pass *RetypeField) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
 
 func (pass *RetypeField) processSchema(schema *ast.Schema) *ast.Schema {
 	schema.Objects = schema.Objects.Map(func(_ string, object ast.Object) ast.Object {
-		return pass.processObject(object)
+		return pass.processObject(schema, object)
 	})
 
 	return schema
 }
 
-func (pass *RetypeField) processObject(object ast.Object) ast.Object {
+func (pass *RetypeField) processObject(schema *ast.Schema, object ast.Object) ast.Object {
 	if !object.Type.IsStruct() {
 		return object
 	}
 
 	for i, field := range object.Type.Struct.Fields {
-		if !pass.Field.Matches(object, field) {
+		if !pass.Field.Matches(schema, object, field) {
 			continue
 		}
 
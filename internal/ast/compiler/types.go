@@ -5,15 +5,25 @@ import (
 	"strings"
 
 	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
 )
 
+// ObjectReference selects objects by package and name.
+// Both support globs. The comparison on object name is case-insensitive.
 type ObjectReference struct {
 	Package string
 	Object  string
+
+	// Predicates further narrow down the selection, based on the schema
+	// and the type of the object.
+	Predicates ast.Predicates
 }
 
-func (ref ObjectReference) Matches(object ast.Object) bool {
-	return object.SelfRef.ReferredPkg == ref.Package && strings.EqualFold(object.Name, ref.Object)
+func (ref ObjectReference) Matches(schema *ast.Schema, object ast.Object) bool {
+	return tools.GlobMatch(ref.Package, object.SelfRef.ReferredPkg) &&
+		tools.GlobMatchEqualFold(ref.Object, object.Name) &&
+		ref.Predicates.MatchesSchema(schema) &&
+		ref.Predicates.MatchesType(object.Type)
 }
 
 func ObjectReferenceFromString(ref string) (ObjectReference, error) {
@@ -28,16 +38,25 @@ func ObjectReferenceFromString(ref string) (ObjectReference, error) {
 	}, nil
 }
 
+// FieldReference selects struct fields by package, object and field name.
+// All of them support globs. The comparison on object and field names is
+// case-insensitive.
 type FieldReference struct {
 	Package string
 	Object  string
 	Field   string
+
+	// Predicates further narrow down the selection, based on the schema
+	// and the type of the field.
+	Predicates ast.Predicates
 }
 
-func (ref FieldReference) Matches(object ast.Object, field ast.StructField) bool {
-	return object.SelfRef.ReferredPkg == ref.Package &&
-		strings.EqualFold(object.Name, ref.Object) &&
-		strings.EqualFold(field.Name, ref.Field)
+func (ref FieldReference) Matches(schema *ast.Schema, object ast.Object, field ast.StructField) bool {
+	return tools.GlobMatch(ref.Package, object.SelfRef.ReferredPkg) &&
+		tools.GlobMatchEqualFold(ref.Object, object.Name) &&
+		tools.GlobMatchEqualFold(ref.Field, field.Name) &&
+		ref.Predicates.MatchesSchema(schema) &&
+		ref.Predicates.MatchesType(field.Type)
 }
 
 func FieldReferenceFromString(ref string) (FieldReference, error) {
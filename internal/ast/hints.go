@@ -16,6 +16,11 @@ const (
 	// ie: dataquery, panelcfg, ...
 	HintImplementsVariant = "implements_variant"
 
+	// HintPanelcfgVariantHost indicates that the options and field config
+	// of a struct are defined by the panelcfg variant identified by its
+	// `type` field. ie: dashboard panels
+	HintPanelcfgVariantHost = "panelcfg_variant_host"
+
 	// HintSkipVariantPluginRegistration preserves the variant hint on a type, but
 	// tells the jennies to not register it as a plugin.
 	HintSkipVariantPluginRegistration = "skip_variant_plugin_registration"
@@ -0,0 +1,122 @@
+package ast
+
+type ParameterLocation string
+
+const (
+	ParameterInPath   ParameterLocation = "path"
+	ParameterInQuery  ParameterLocation = "query"
+	ParameterInHeader ParameterLocation = "header"
+	ParameterInCookie ParameterLocation = "cookie"
+)
+
+// Operation describes an HTTP operation exposed by an API.
+// Types involved in an operation (parameters, request and response bodies)
+// are described by objects defined in the same schema.
+type Operation struct {
+	Name     string
+	Comments []string `json:",omitempty"`
+	Method   string   // GET, POST, ...
+	Path     string   // ex: /dashboards/{uid}
+
+	// Params refers to the object describing the parameters of the operation.
+	Params     *RefType             `json:",omitempty"`
+	Parameters []OperationParameter `json:",omitempty"`
+
+	RequestBody *OperationBody      `json:",omitempty"`
+	Responses   []OperationResponse `json:",omitempty"`
+}
+
+func (operation Operation) DeepCopy() Operation {
+	clone := Operation{
+		Name:     operation.Name,
+		Comments: make([]string, 0, len(operation.Comments)),
+		Method:   operation.Method,
+		Path:     operation.Path,
+	}
+
+	clone.Comments = append(clone.Comments, operation.Comments...)
+
+	if operation.Params != nil {
+		params := operation.Params.DeepCopy()
+		clone.Params = &params
+	}
+
+	clone.Parameters = append(clone.Parameters, operation.Parameters...)
+
+	if operation.RequestBody != nil {
+		body := operation.RequestBody.DeepCopy()
+		clone.RequestBody = &body
+	}
+
+	for _, response := range operation.Responses {
+		clone.Responses = append(clone.Responses, response.DeepCopy())
+	}
+
+	return clone
+}
+
+// SuccessResponse returns the response describing the successful outcome
+// of the operation, if any.
+func (operation Operation) SuccessResponse() (OperationResponse, bool) {
+	for _, response := range operation.Responses {
+		if response.Success {
+			return response, true
+		}
+	}
+
+	return OperationResponse{}, false
+}
+
+// ParametersIn returns the parameters of the operation that are located in
+// the given part of the request.
+func (operation Operation) ParametersIn(location ParameterLocation) []OperationParameter {
+	var parameters []OperationParameter
+	for _, parameter := range operation.Parameters {
+		if parameter.In == location {
+			parameters = append(parameters, parameter)
+		}
+	}
+
+	return parameters
+}
+
+type OperationParameter struct {
+	// Name of the parameter, and of its matching field in the Params object.
+	Name string
+	In   ParameterLocation
+}
+
+type OperationBody struct {
+	ContentType string
+	Required    bool
+	// Type of the body. Usually a reference to an object.
+	Type Type
+}
+
+func (body OperationBody) DeepCopy() OperationBody {
+	return OperationBody{
+		ContentType: body.ContentType,
+		Required:    body.Required,
+		Type:        body.Type.DeepCopy(),
+	}
+}
+
+type OperationResponse struct {
+	Status  string // "200", "404", "default", ...
+	Success bool
+	Body    *OperationBody `json:",omitempty"`
+}
+
+func (response OperationResponse) DeepCopy() OperationResponse {
+	clone := OperationResponse{
+		Status:  response.Status,
+		Success: response.Success,
+	}
+
+	if response.Body != nil {
+		body := response.Body.DeepCopy()
+		clone.Body = &body
+	}
+
+	return clone
+}
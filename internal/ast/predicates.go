@@ -0,0 +1,61 @@
+package ast
+
+import (
+	"github.com/grafana/cog/internal/tools"
+)
+
+// Predicates narrow down the objects, fields, builders or options selected
+// by veneers and compiler passes, based on the schema they are defined in
+// and on their type.
+// Empty predicates match anything.
+type Predicates struct {
+	// Variant of the schema.
+	Variant SchemaVariant
+	// Identifier of the schema. Globs are supported.
+	Identifier string
+
+	// Kind of the type.
+	Kind Kind
+	// ScalarKind of the type. Only scalar types match.
+	ScalarKind ScalarKind
+}
+
+func (predicates Predicates) IsEmpty() bool {
+	return predicates == Predicates{}
+}
+
+// MatchesSchema reports whether the given schema satisfies the predicates
+// on schema metadata.
+func (predicates Predicates) MatchesSchema(schema *Schema) bool {
+	if predicates.Variant == "" && predicates.Identifier == "" {
+		return true
+	}
+
+	if schema == nil {
+		return false
+	}
+
+	if predicates.Variant != "" && schema.Metadata.Variant != predicates.Variant {
+		return false
+	}
+
+	if predicates.Identifier != "" && !tools.GlobMatchEqualFold(predicates.Identifier, schema.Metadata.Identifier) {
+		return false
+	}
+
+	return true
+}
+
+// MatchesType reports whether the given type satisfies the predicates
+// on types.
+func (predicates Predicates) MatchesType(def Type) bool {
+	if predicates.Kind != "" && def.Kind != predicates.Kind {
+		return false
+	}
+
+	if predicates.ScalarKind != "" && (!def.IsScalar() || def.AsScalar().ScalarKind != predicates.ScalarKind) {
+		return false
+	}
+
+	return true
+}
@@ -75,6 +75,7 @@ type Schema struct { //nolint: musttag
 	Metadata   SchemaMeta `json:",omitempty"`
 	EntryPoint string     `json:",omitempty"`
 	Objects    *orderedmap.Map[string, Object]
+	Operations []Operation `json:",omitempty"`
 }
 
 func NewSchema(pkg string, metadata SchemaMeta) *Schema {
@@ -125,17 +126,31 @@ func (schema *Schema) Merge(other *Schema) error {
 		return err
 	}
 
+	for _, operation := range other.Operations {
+		if _, found := schema.LocateOperation(operation.Name); found {
+			continue
+		}
+
+		schema.Operations = append(schema.Operations, operation)
+	}
+
 	return nil
 }
 
 func (schema *Schema) DeepCopy() Schema {
-	return Schema{
+	clone := Schema{
 		Package:  schema.Package,
 		Metadata: schema.Metadata,
 		Objects: schema.Objects.Map(func(_ string, object Object) Object {
 			return object.DeepCopy()
 		}),
 	}
+
+	for _, operation := range schema.Operations {
+		clone.Operations = append(clone.Operations, operation.DeepCopy())
+	}
+
+	return clone
 }
 
 func (schema *Schema) LocateObject(name string) (Object, bool) {
@@ -146,6 +161,16 @@ func (schema *Schema) LocateObject(name string) (Object, bool) {
 	return schema.Objects.Get(name), true
 }
 
+func (schema *Schema) LocateOperation(name string) (Operation, bool) {
+	for _, operation := range schema.Operations {
+		if operation.Name == name {
+			return operation, true
+		}
+	}
+
+	return Operation{}, false
+}
+
 func (schema *Schema) Resolve(typeDef Type) (Type, bool) {
 	if !typeDef.IsRef() {
 		return typeDef, true
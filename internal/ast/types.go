@@ -53,6 +53,14 @@ const (
 	MinLengthOp        Op = "minLength"
 	MaxLengthOp        Op = "maxLength"
 	MultipleOfOp       Op = "multipleOf"
+	PatternOp          Op = "pattern"
+	NotPatternOp       Op = "notPattern"
+	HasPrefixOp        Op = "hasPrefix"
+	MinItemsOp         Op = "minItems"
+	MaxItemsOp         Op = "maxItems"
+	UniqueItemsOp      Op = "uniqueItems"
+	MinPropertiesOp    Op = "minProperties"
+	MaxPropertiesOp    Op = "maxProperties"
 	EqualOp            Op = "=="
 	NotEqualOp         Op = "!="
 	LessThanOp         Op = "<"
@@ -154,6 +162,20 @@ func (t Type) IsConcreteScalar() bool {
 	return t.IsScalar() && t.AsScalar().IsConcrete()
 }
 
+// Constraints returns the constraints defined on scalar, array and map types.
+func (t Type) Constraints() []TypeConstraint {
+	switch {
+	case t.IsScalar():
+		return t.AsScalar().Constraints
+	case t.IsArray():
+		return t.AsArray().Constraints
+	case t.IsMap():
+		return t.AsMap().Constraints
+	default:
+		return nil
+	}
+}
+
 func (t Type) IsArray() bool {
 	return t.Kind == KindArray
 }
@@ -613,13 +635,24 @@ func (t DisjunctionType) DeepCopy() DisjunctionType {
 }
 
 type ArrayType struct {
-	ValueType Type `yaml:"value_type"`
+	ValueType   Type             `yaml:"value_type"`
+	Constraints []TypeConstraint `json:",omitempty"`
 }
 
 func (t ArrayType) DeepCopy() ArrayType {
-	return ArrayType{
+	newT := ArrayType{
 		ValueType: t.ValueType.DeepCopy(),
 	}
+
+	if len(t.Constraints) != 0 {
+		newT.Constraints = make([]TypeConstraint, 0, len(t.Constraints))
+	}
+
+	for _, constraint := range t.Constraints {
+		newT.Constraints = append(newT.Constraints, constraint.DeepCopy())
+	}
+
+	return newT
 }
 
 func (t ArrayType) IsArrayOfScalars() bool {
@@ -661,19 +694,34 @@ func (t EnumValue) DeepCopy() EnumValue {
 }
 
 type MapType struct {
-	IndexType Type
-	ValueType Type
+	IndexType   Type
+	ValueType   Type
+	Constraints []TypeConstraint `json:",omitempty"`
 }
 
 func (t MapType) DeepCopy() MapType {
-	return MapType{
+	newT := MapType{
 		IndexType: t.IndexType.DeepCopy(),
 		ValueType: t.ValueType.DeepCopy(),
 	}
+
+	if len(t.Constraints) != 0 {
+		newT.Constraints = make([]TypeConstraint, 0, len(t.Constraints))
+	}
+
+	for _, constraint := range t.Constraints {
+		newT.Constraints = append(newT.Constraints, constraint.DeepCopy())
+	}
+
+	return newT
 }
 
 type StructType struct {
 	Fields []StructField
+
+	// AdditionalProperties describes the properties accepted by the struct
+	// beside its fields, as a map type. Nil if no such property is accepted.
+	AdditionalProperties *Type `json:",omitempty"`
 }
 
 func (structType StructType) DeepCopy() StructType {
@@ -685,6 +733,11 @@ func (structType StructType) DeepCopy() StructType {
 		newT.Fields = append(newT.Fields, field.DeepCopy())
 	}
 
+	if structType.AdditionalProperties != nil {
+		additionalProperties := structType.AdditionalProperties.DeepCopy()
+		newT.AdditionalProperties = &additionalProperties
+	}
+
 	return newT
 }
 
@@ -0,0 +1,369 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
+)
+
+type Severity string
+
+const (
+	Breaking    Severity = "breaking"
+	NonBreaking Severity = "non-breaking"
+)
+
+type ChangeKind string
+
+const (
+	PackageAdded              ChangeKind = "package_added"
+	PackageRemoved            ChangeKind = "package_removed"
+	ObjectAdded               ChangeKind = "object_added"
+	ObjectRemoved             ChangeKind = "object_removed"
+	FieldAdded                ChangeKind = "field_added"
+	FieldRemoved              ChangeKind = "field_removed"
+	FieldRequired             ChangeKind = "field_required"
+	FieldOptional             ChangeKind = "field_optional"
+	TypeChanged               ChangeKind = "type_changed"
+	NullableAdded             ChangeKind = "nullable_added"
+	NullableRemoved           ChangeKind = "nullable_removed"
+	EnumValueAdded            ChangeKind = "enum_value_added"
+	EnumValueRemoved          ChangeKind = "enum_value_removed"
+	DisjunctionBranchAdded    ChangeKind = "disjunction_branch_added"
+	DisjunctionBranchRemoved  ChangeKind = "disjunction_branch_removed"
+	IntersectionBranchAdded   ChangeKind = "intersection_branch_added"
+	IntersectionBranchRemoved ChangeKind = "intersection_branch_removed"
+)
+
+// Change describes a single difference between two versions of a schema.
+type Change struct {
+	Severity Severity   `json:"severity"`
+	Kind     ChangeKind `json:"kind"`
+	// Path to the element that changed.
+	// Ex: `dashboard.Panel.gridPos.x`
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (change Change) String() string {
+	return fmt.Sprintf("%s: %s", change.Path, change.Message)
+}
+
+// Report lists the changes found between two sets of schemas.
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+func (report Report) HasBreakingChanges() bool {
+	return len(report.Breaking()) != 0
+}
+
+func (report Report) Breaking() []Change {
+	return report.filter(Breaking)
+}
+
+func (report Report) NonBreaking() []Change {
+	return report.filter(NonBreaking)
+}
+
+func (report Report) filter(severity Severity) []Change {
+	changes := make([]Change, 0, len(report.Changes))
+	for _, change := range report.Changes {
+		if change.Severity == severity {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// Text renders the report in a human-readable format.
+func (report Report) Text() string {
+	if len(report.Changes) == 0 {
+		return "No changes detected.\n"
+	}
+
+	var buffer strings.Builder
+
+	writeSection := func(title string, changes []Change) {
+		if len(changes) == 0 {
+			return
+		}
+
+		buffer.WriteString(fmt.Sprintf("%s (%d):\n", title, len(changes)))
+		for _, change := range changes {
+			buffer.WriteString(fmt.Sprintf("  - %s\n", change))
+		}
+	}
+
+	writeSection("Breaking changes", report.Breaking())
+	writeSection("Non-breaking changes", report.NonBreaking())
+
+	return buffer.String()
+}
+
+// Schemas compares two versions of a set of schemas, object by object.
+//
+// Changes are classified from the point of view of the consumers of the
+// code generated from these schemas:
+//   - removing a package, an object, a field, an enum value or a disjunction branch is breaking
+//   - changing the type of an object or field is breaking
+//   - making a field required or non-nullable is breaking
+//   - adding an optional field, an object, an enum value or a disjunction branch is not breaking
+//   - making a field optional or nullable is not breaking
+func Schemas(oldSchemas ast.Schemas, newSchemas ast.Schemas) Report {
+	differ := &differ{changes: []Change{}}
+
+	oldByPkg := schemasByPackage(oldSchemas)
+	newByPkg := schemasByPackage(newSchemas)
+
+	for _, pkg := range sortedKeys(oldByPkg) {
+		newSchema, found := newByPkg[pkg]
+		if !found {
+			differ.add(Breaking, PackageRemoved, pkg, "package removed")
+			continue
+		}
+
+		differ.compareSchemas(oldByPkg[pkg], newSchema)
+	}
+
+	for _, pkg := range sortedKeys(newByPkg) {
+		if _, found := oldByPkg[pkg]; !found {
+			differ.add(NonBreaking, PackageAdded, pkg, "package added")
+		}
+	}
+
+	return Report{Changes: differ.changes}
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (differ *differ) add(severity Severity, kind ChangeKind, path string, message string, args ...any) {
+	differ.changes = append(differ.changes, Change{
+		Severity: severity,
+		Kind:     kind,
+		Path:     path,
+		Message:  fmt.Sprintf(message, args...),
+	})
+}
+
+func (differ *differ) compareSchemas(oldSchema *ast.Schema, newSchema *ast.Schema) {
+	oldSchema.Objects.Iterate(func(name string, oldObject ast.Object) {
+		path := oldSchema.Package + "." + name
+
+		if !newSchema.Objects.Has(name) {
+			differ.add(Breaking, ObjectRemoved, path, "object removed")
+			return
+		}
+
+		differ.compareTypes(path, oldObject.Type, newSchema.Objects.Get(name).Type)
+	})
+
+	newSchema.Objects.Iterate(func(name string, _ ast.Object) {
+		if !oldSchema.Objects.Has(name) {
+			differ.add(NonBreaking, ObjectAdded, newSchema.Package+"."+name, "object added")
+		}
+	})
+}
+
+func (differ *differ) compareTypes(path string, oldType ast.Type, newType ast.Type) {
+	if oldType.Nullable && !newType.Nullable {
+		differ.add(Breaking, NullableRemoved, path, "no longer nullable")
+	}
+	if !oldType.Nullable && newType.Nullable {
+		differ.add(NonBreaking, NullableAdded, path, "now nullable")
+	}
+
+	if oldType.Kind != newType.Kind {
+		differ.add(Breaking, TypeChanged, path, "type changed from '%s' to '%s'", typeName(oldType), typeName(newType))
+		return
+	}
+
+	switch oldType.Kind {
+	case ast.KindStruct:
+		differ.compareStructs(path, oldType.AsStruct(), newType.AsStruct())
+	case ast.KindEnum:
+		differ.compareEnums(path, oldType.AsEnum(), newType.AsEnum())
+	case ast.KindDisjunction:
+		differ.compareDisjunctions(path, oldType.AsDisjunction(), newType.AsDisjunction())
+	case ast.KindIntersection:
+		differ.compareIntersections(path, oldType.AsIntersection(), newType.AsIntersection())
+	case ast.KindArray:
+		differ.compareTypes(path+"[]", oldType.AsArray().ValueType, newType.AsArray().ValueType)
+	case ast.KindMap:
+		if typeName(oldType.AsMap().IndexType) != typeName(newType.AsMap().IndexType) {
+			differ.add(Breaking, TypeChanged, path, "type changed from '%s' to '%s'", typeName(oldType), typeName(newType))
+			return
+		}
+
+		differ.compareTypes(path+"[]", oldType.AsMap().ValueType, newType.AsMap().ValueType)
+	default:
+		// scalars, refs and composable slots
+		if typeName(oldType) != typeName(newType) {
+			differ.add(Breaking, TypeChanged, path, "type changed from '%s' to '%s'", typeName(oldType), typeName(newType))
+		}
+	}
+}
+
+func (differ *differ) compareStructs(path string, oldStruct ast.StructType, newStruct ast.StructType) {
+	for _, oldField := range oldStruct.Fields {
+		fieldPath := path + "." + oldField.Name
+
+		newField, found := newStruct.FieldByName(oldField.Name)
+		if !found {
+			differ.add(Breaking, FieldRemoved, fieldPath, "field removed")
+			continue
+		}
+
+		if !oldField.Required && newField.Required {
+			differ.add(Breaking, FieldRequired, fieldPath, "field is now required")
+		}
+		if oldField.Required && !newField.Required {
+			differ.add(NonBreaking, FieldOptional, fieldPath, "field is now optional")
+		}
+
+		differ.compareTypes(fieldPath, oldField.Type, newField.Type)
+	}
+
+	for _, newField := range newStruct.Fields {
+		if _, found := oldStruct.FieldByName(newField.Name); found {
+			continue
+		}
+
+		if newField.Required {
+			differ.add(Breaking, FieldAdded, path+"."+newField.Name, "required field added")
+			continue
+		}
+
+		differ.add(NonBreaking, FieldAdded, path+"."+newField.Name, "optional field added")
+	}
+}
+
+func (differ *differ) compareEnums(path string, oldEnum ast.EnumType, newEnum ast.EnumType) {
+	hasValue := func(enum ast.EnumType, value any) bool {
+		for _, candidate := range enum.Values {
+			if fmt.Sprintf("%v", candidate.Value) == fmt.Sprintf("%v", value) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for _, oldValue := range oldEnum.Values {
+		if !hasValue(newEnum, oldValue.Value) {
+			differ.add(Breaking, EnumValueRemoved, path, "enum value '%v' removed", oldValue.Value)
+		}
+	}
+
+	for _, newValue := range newEnum.Values {
+		if !hasValue(oldEnum, newValue.Value) {
+			differ.add(NonBreaking, EnumValueAdded, path, "enum value '%v' added", newValue.Value)
+		}
+	}
+}
+
+// compareDisjunctions reports branches added to or removed from a disjunction.
+// Added branches widen the set of accepted values, removed ones narrow it.
+func (differ *differ) compareDisjunctions(path string, oldDisjunction ast.DisjunctionType, newDisjunction ast.DisjunctionType) {
+	added, removed := compareBranches(oldDisjunction.Branches, newDisjunction.Branches)
+
+	for _, name := range removed {
+		differ.add(Breaking, DisjunctionBranchRemoved, path, "branch '%s' removed", name)
+	}
+	for _, name := range added {
+		differ.add(NonBreaking, DisjunctionBranchAdded, path, "branch '%s' added", name)
+	}
+}
+
+// compareIntersections reports branches added to or removed from an intersection.
+// Added branches narrow the set of accepted values, and removed ones take
+// away the fields they contributed: both are breaking.
+func (differ *differ) compareIntersections(path string, oldIntersection ast.IntersectionType, newIntersection ast.IntersectionType) {
+	added, removed := compareBranches(oldIntersection.Branches, newIntersection.Branches)
+
+	for _, name := range removed {
+		differ.add(Breaking, IntersectionBranchRemoved, path, "branch '%s' removed", name)
+	}
+	for _, name := range added {
+		differ.add(Breaking, IntersectionBranchAdded, path, "branch '%s' added", name)
+	}
+}
+
+// compareBranches returns the names of the branches added and removed
+// between two versions of a list of branches.
+func compareBranches(oldBranches ast.Types, newBranches ast.Types) ([]string, []string) {
+	oldNames := branchNames(oldBranches)
+	newNames := branchNames(newBranches)
+
+	var added, removed []string
+	for _, name := range oldNames {
+		if !tools.ItemInList(name, newNames) {
+			removed = append(removed, name)
+		}
+	}
+
+	for _, name := range newNames {
+		if !tools.ItemInList(name, oldNames) {
+			added = append(added, name)
+		}
+	}
+
+	return added, removed
+}
+
+func branchNames(branches ast.Types) []string {
+	names := make([]string, 0, len(branches))
+	for _, branch := range branches {
+		names = append(names, typeName(branch))
+	}
+
+	return names
+}
+
+// typeName returns a short, human-readable description of the given type.
+func typeName(def ast.Type) string {
+	switch def.Kind {
+	case ast.KindScalar:
+		return string(def.AsScalar().ScalarKind)
+	case ast.KindRef:
+		return def.AsRef().String()
+	case ast.KindArray:
+		return "[]" + typeName(def.AsArray().ValueType)
+	case ast.KindMap:
+		return fmt.Sprintf("map[%s]%s", typeName(def.AsMap().IndexType), typeName(def.AsMap().ValueType))
+	case ast.KindDisjunction:
+		return strings.Join(branchNames(def.AsDisjunction().Branches), " | ")
+	case ast.KindIntersection:
+		return strings.Join(branchNames(def.AsIntersection().Branches), " & ")
+	case ast.KindComposableSlot:
+		return fmt.Sprintf("composable_slot(%s)", def.AsComposableSlot().Variant)
+	default:
+		return string(def.Kind)
+	}
+}
+
+func schemasByPackage(schemas ast.Schemas) map[string]*ast.Schema {
+	byPkg := make(map[string]*ast.Schema, len(schemas))
+	for _, schema := range schemas {
+		byPkg[schema.Package] = schema
+	}
+
+	return byPkg
+}
+
+func sortedKeys(input map[string]*ast.Schema) []string {
+	keys := make([]string, 0, len(input))
+	for key := range input {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,150 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemas_noChanges(t *testing.T) {
+	req := require.New(t)
+
+	schema := &ast.Schema{
+		Package: "pkg",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("pkg", "Foo", ast.NewStruct(
+				ast.NewStructField("name", ast.String(), ast.Required()),
+			)),
+		),
+	}
+
+	report := Schemas(ast.Schemas{schema}, ast.Schemas{schema})
+
+	req.Empty(report.Changes)
+	req.False(report.HasBreakingChanges())
+	req.Equal("No changes detected.\n", report.Text())
+}
+
+func TestSchemas_classifiesChanges(t *testing.T) {
+	req := require.New(t)
+
+	mode := func(values ...string) ast.Type {
+		enumValues := make([]ast.EnumValue, 0, len(values))
+		for _, value := range values {
+			enumValues = append(enumValues, ast.EnumValue{Name: value, Value: value, Type: ast.String()})
+		}
+
+		return ast.NewEnum(enumValues)
+	}
+
+	oldSchemas := ast.Schemas{
+		&ast.Schema{
+			Package: "pkg",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("pkg", "Panel", ast.NewStruct(
+					ast.NewStructField("title", ast.String(), ast.Required()),
+					ast.NewStructField("description", ast.String()),
+					ast.NewStructField("id", ast.NewScalar(ast.KindInt64)),
+					ast.NewStructField("transparent", ast.Bool(), ast.Required()),
+					ast.NewStructField("value", ast.NewDisjunction(ast.Types{ast.String(), ast.Bool()})),
+				)),
+				ast.NewObject("pkg", "Mode", mode("auto", "manual")),
+				ast.NewObject("pkg", "Legacy", ast.String()),
+			),
+		},
+		&ast.Schema{Package: "removed", Objects: testutils.ObjectsMap()},
+	}
+	newSchemas := ast.Schemas{
+		&ast.Schema{
+			Package: "pkg",
+			Objects: testutils.ObjectsMap(
+				ast.NewObject("pkg", "Panel", ast.NewStruct(
+					ast.NewStructField("title", ast.String()),
+					ast.NewStructField("id", ast.String()),
+					ast.NewStructField("transparent", ast.Bool(), ast.Required()),
+					ast.NewStructField("value", ast.NewDisjunction(ast.Types{ast.String(), ast.NewScalar(ast.KindInt64)})),
+					ast.NewStructField("gridPos", ast.NewRef("pkg", "GridPos")),
+				)),
+				ast.NewObject("pkg", "Mode", mode("auto", "dynamic")),
+				ast.NewObject("pkg", "GridPos", ast.NewStruct()),
+			),
+		},
+		&ast.Schema{Package: "added", Objects: testutils.ObjectsMap()},
+	}
+
+	report := Schemas(oldSchemas, newSchemas)
+
+	req.True(report.HasBreakingChanges())
+	req.ElementsMatch([]Change{
+		{Severity: NonBreaking, Kind: FieldOptional, Path: "pkg.Panel.title", Message: "field is now optional"},
+		{Severity: Breaking, Kind: FieldRemoved, Path: "pkg.Panel.description", Message: "field removed"},
+		{Severity: Breaking, Kind: TypeChanged, Path: "pkg.Panel.id", Message: "type changed from 'int64' to 'string'"},
+		{Severity: Breaking, Kind: DisjunctionBranchRemoved, Path: "pkg.Panel.value", Message: "branch 'bool' removed"},
+		{Severity: NonBreaking, Kind: DisjunctionBranchAdded, Path: "pkg.Panel.value", Message: "branch 'int64' added"},
+		{Severity: NonBreaking, Kind: FieldAdded, Path: "pkg.Panel.gridPos", Message: "optional field added"},
+		{Severity: Breaking, Kind: EnumValueRemoved, Path: "pkg.Mode", Message: "enum value 'manual' removed"},
+		{Severity: NonBreaking, Kind: EnumValueAdded, Path: "pkg.Mode", Message: "enum value 'dynamic' added"},
+		{Severity: Breaking, Kind: ObjectRemoved, Path: "pkg.Legacy", Message: "object removed"},
+		{Severity: NonBreaking, Kind: ObjectAdded, Path: "pkg.GridPos", Message: "object added"},
+		{Severity: Breaking, Kind: PackageRemoved, Path: "removed", Message: "package removed"},
+		{Severity: NonBreaking, Kind: PackageAdded, Path: "added", Message: "package added"},
+	}, report.Changes)
+}
+
+func TestSchemas_requiredFieldAddedIsBreaking(t *testing.T) {
+	req := require.New(t)
+
+	oldSchema := &ast.Schema{
+		Package: "pkg",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("pkg", "Foo", ast.NewStruct()),
+		),
+	}
+	newSchema := &ast.Schema{
+		Package: "pkg",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("pkg", "Foo", ast.NewStruct(
+				ast.NewStructField("bar", ast.NewArray(ast.String()), ast.Required()),
+			)),
+		),
+	}
+
+	report := Schemas(ast.Schemas{oldSchema}, ast.Schemas{newSchema})
+
+	req.True(report.HasBreakingChanges())
+	req.Equal(`Breaking changes (1):
+  - pkg.Foo.bar: required field added
+`, report.Text())
+}
+
+func TestSchemas_intersectionBranches(t *testing.T) {
+	req := require.New(t)
+
+	oldSchema := &ast.Schema{
+		Package: "pkg",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("pkg", "Panel", ast.NewIntersection([]ast.Type{
+				ast.NewRef("pkg", "Base"),
+				ast.NewRef("pkg", "Legacy"),
+			})),
+		),
+	}
+	newSchema := &ast.Schema{
+		Package: "pkg",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("pkg", "Panel", ast.NewIntersection([]ast.Type{
+				ast.NewRef("pkg", "Base"),
+				ast.NewRef("pkg", "WithOptions"),
+			})),
+		),
+	}
+
+	report := Schemas(ast.Schemas{oldSchema}, ast.Schemas{newSchema})
+
+	req.ElementsMatch([]Change{
+		{Severity: Breaking, Kind: IntersectionBranchRemoved, Path: "pkg.Panel", Message: "branch 'pkg.Legacy' removed"},
+		{Severity: Breaking, Kind: IntersectionBranchAdded, Path: "pkg.Panel", Message: "branch 'pkg.WithOptions' added"},
+	}, report.Changes)
+}
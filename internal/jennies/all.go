@@ -10,7 +10,10 @@ import (
 	"github.com/grafana/cog/internal/jennies/java"
 	"github.com/grafana/cog/internal/jennies/jsonschema"
 	"github.com/grafana/cog/internal/jennies/openapi"
+	"github.com/grafana/cog/internal/jennies/plugin"
+	"github.com/grafana/cog/internal/jennies/protobuf"
 	"github.com/grafana/cog/internal/jennies/python"
+	"github.com/grafana/cog/internal/jennies/rust"
 	"github.com/grafana/cog/internal/jennies/typescript"
 	"github.com/spf13/cobra"
 )
@@ -41,6 +44,30 @@ func (languageJennies LanguageJennies) ForLanguages(languages []string) (Languag
 	return filtered, nil
 }
 
+// WithPlugins returns the given language targets, along with targets
+// delegating the generation of code to plugins.
+// Plugins are given as a map associating a language to an executable.
+func (languageJennies LanguageJennies) WithPlugins(plugins map[string]string) (LanguageJennies, error) {
+	if len(plugins) == 0 {
+		return languageJennies, nil
+	}
+
+	all := make(LanguageJennies, len(languageJennies)+len(plugins))
+	for language, target := range languageJennies {
+		all[language] = target
+	}
+
+	for language, executable := range plugins {
+		if _, exists := all[language]; exists {
+			return nil, fmt.Errorf("plugin '%s' conflicts with a built-in language", language)
+		}
+
+		all[language] = plugin.New(language, executable)
+	}
+
+	return all, nil
+}
+
 func (languageJennies LanguageJennies) AsLanguageRefs() []string {
 	result := make([]string, 0, len(languageJennies))
 	for language := range languageJennies {
@@ -55,7 +82,9 @@ func All() LanguageJennies {
 		java.LanguageRef:       java.New(),
 		jsonschema.LanguageRef: jsonschema.New(),
 		openapi.LanguageRef:    openapi.New(),
+		protobuf.LanguageRef:   protobuf.New(),
 		python.LanguageRef:     python.New(),
+		rust.LanguageRef:       rust.New(),
 		typescript.LanguageRef: typescript.New(),
 	}
 }
@@ -52,9 +52,9 @@ func GeneratedCommentHeader(config Config) codejen.FileMapper {
 	return func(f codejen.File) (codejen.File, error) {
 		var leader string
 		switch filepath.Ext(f.RelativePath) {
-		case ".ts", ".go", ".java":
+		case ".ts", ".go", ".java", ".proto", ".rs":
 			leader = "//"
-		case ".yml", ".yaml", ".py":
+		case ".yml", ".yaml", ".py", ".toml":
 			leader = "#"
 		default:
 			leader = ""
@@ -91,6 +91,20 @@ func (context *Context) ResolveToStruct(def ast.Type) bool {
 	return context.ResolveToStruct(referredObj.Type)
 }
 
+// ResolveRefs follows references until a type that isn't a reference is found.
+func (context *Context) ResolveRefs(def ast.Type) ast.Type {
+	if !def.IsRef() {
+		return def
+	}
+
+	referredObj, found := context.LocateObject(def.AsRef().ReferredPkg, def.AsRef().ReferredType)
+	if !found {
+		return def
+	}
+
+	return context.ResolveRefs(referredObj.Type)
+}
+
 type BuildOptions struct {
 	Languages []string
 }
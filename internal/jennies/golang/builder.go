@@ -78,6 +78,16 @@ func (jenny *Builder) generateBuilder(context common.Context, builder ast.Builde
 				_, found := context.ResolveToComposableSlot(typeDef)
 				return found
 			},
+			"formatValue": func(destinationType ast.Type, value any) string {
+				if destinationType.IsRef() {
+					referredObj, found := context.LocateObject(destinationType.AsRef().ReferredPkg, destinationType.AsRef().ReferredType)
+					if found && referredObj.Type.IsEnum() {
+						return jenny.formatEnumValue(referredObj, value)
+					}
+				}
+
+				return formatScalar(value)
+			},
 		}).
 		ExecuteTemplate(&buffer, "builders/builder.tmpl", template.Builder{
 			Package:              builder.Package,
@@ -296,6 +306,21 @@ func (jenny *Builder) constraints(argumentName string, constraints []ast.TypeCon
 
 // importType declares an import statement for the type definition of
 // the given object and returns a fully qualified type name for it.
+// formatEnumValue refers to the enum member holding the given value, so that
+// the resulting expression has the type of the enum.
+func (jenny *Builder) formatEnumValue(enumObj ast.Object, value any) string {
+	for _, member := range enumObj.Type.AsEnum().Values {
+		if member.Value == value {
+			return jenny.importType(ast.RefType{
+				ReferredPkg:  enumObj.SelfRef.ReferredPkg,
+				ReferredType: tools.CleanupNames(tools.UpperCamelCase(member.Name)),
+			})
+		}
+	}
+
+	return formatScalar(value)
+}
+
 func (jenny *Builder) importType(typeRef ast.RefType) string {
 	pkg := jenny.typeImportMapper(typeRef.ReferredPkg)
 	typeName := tools.UpperCamelCase(typeRef.ReferredType)
@@ -0,0 +1,360 @@
+package golang
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/tools"
+)
+
+var pathParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Client generates, for each schema defining operations, an HTTP client
+// with one method per operation.
+type Client struct {
+	Config Config
+
+	typeImportMapper func(pkg string) string
+	typeFormatter    *typeFormatter
+}
+
+func (jenny *Client) JennyName() string {
+	return "GoClient"
+}
+
+func (jenny *Client) Generate(context common.Context) (codejen.Files, error) {
+	files := codejen.Files{}
+
+	for _, schema := range context.Schemas {
+		if len(schema.Operations) == 0 {
+			continue
+		}
+
+		output, err := jenny.generateClient(context, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		filename := filepath.Join(formatPackageName(schema.Package), "client_gen.go")
+
+		files = append(files, *codejen.NewFile(filename, output, jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny *Client) generateClient(context common.Context, schema *ast.Schema) ([]byte, error) {
+	var buffer strings.Builder
+
+	imports := NewImportMap()
+	jenny.typeImportMapper = func(pkg string) string {
+		if imports.IsIdentical(pkg, schema.Package) {
+			return ""
+		}
+
+		return imports.Add(pkg, jenny.Config.importPath(pkg))
+	}
+	jenny.typeFormatter = defaultTypeFormatter(jenny.Config, context, jenny.typeImportMapper)
+
+	// the generated code relies on cog's runtime
+	jenny.typeImportMapper("cog")
+
+	buffer.WriteString(fmt.Sprintf(`// Client calls the operations exposed by the %[1]s API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a client sending requests to the given base URL.
+// If httpClient is nil, http.DefaultClient is used.
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		baseURL:    baseURL,
+		httpClient: httpClient,
+	}
+}
+`, schema.Package))
+
+	for _, operation := range schema.Operations {
+		method, err := jenny.generateOperation(context, operation)
+		if err != nil {
+			return nil, fmt.Errorf("operation '%s': %w", operation.Name, err)
+		}
+
+		buffer.WriteString("\n")
+		buffer.WriteString(method)
+	}
+
+	return []byte(fmt.Sprintf("package %s\n\n%s\n\n%s", formatPackageName(schema.Package), imports.String(), buffer.String())), nil
+}
+
+func (jenny *Client) generateOperation(context common.Context, operation ast.Operation) (string, error) {
+	var buffer strings.Builder
+
+	methodName := tools.UpperCamelCase(operation.Name)
+	args := []string{"ctx context.Context"}
+
+	var params ast.StructType
+	if operation.Params != nil {
+		paramsObject, found := context.LocateObject(operation.Params.ReferredPkg, operation.Params.ReferredType)
+		if !found || !paramsObject.Type.IsStruct() {
+			return "", fmt.Errorf("could not locate params object '%s'", operation.Params.String())
+		}
+
+		params = paramsObject.Type.AsStruct()
+		args = append(args, "params "+jenny.typeFormatter.formatType(ast.NewRef(operation.Params.ReferredPkg, operation.Params.ReferredType)))
+	}
+
+	if operation.RequestBody != nil {
+		bodyType := operation.RequestBody.Type
+		if !operation.RequestBody.Required {
+			bodyType.Nullable = true
+		}
+
+		args = append(args, "body "+jenny.typeFormatter.formatType(bodyType))
+	}
+
+	successType := ""
+	zeroResult := ""
+	success, hasSuccess := operation.SuccessResponse()
+	if hasSuccess && success.Body != nil {
+		successType = jenny.typeFormatter.formatType(success.Body.Type)
+		zeroResult = "nil, "
+	}
+
+	returnType := "error"
+	if successType != "" {
+		returnType = fmt.Sprintf("(*%s, error)", successType)
+	}
+
+	for _, comment := range operation.Comments {
+		buffer.WriteString(fmt.Sprintf("// %s\n", comment))
+	}
+	buffer.WriteString(fmt.Sprintf("func (client *Client) %s(%s) %s {\n", methodName, strings.Join(args, ", "), returnType))
+
+	path, err := jenny.formatPath(operation, params)
+	if err != nil {
+		return "", err
+	}
+
+	buffer.WriteString("\trequest := cog.Request{\n")
+	buffer.WriteString(fmt.Sprintf("\t\tMethod: %q,\n", operation.Method))
+	buffer.WriteString(fmt.Sprintf("\t\tPath:   %s,\n", path))
+	buffer.WriteString("\t\tQuery:  url.Values{},\n")
+	buffer.WriteString("\t\tHeader: http.Header{},\n")
+	buffer.WriteString("\t}\n")
+
+	for _, parameter := range operation.Parameters {
+		if parameter.In == ast.ParameterInPath {
+			continue
+		}
+
+		field, found := params.FieldByName(parameter.Name)
+		if !found {
+			return "", fmt.Errorf("could not locate parameter '%s'", parameter.Name)
+		}
+
+		buffer.WriteString(jenny.formatParameter(parameter, field))
+	}
+
+	if operation.RequestBody != nil {
+		buffer.WriteString(jenny.formatRequestBody(context, *operation.RequestBody, zeroResult))
+	}
+
+	buffer.WriteString(fmt.Sprintf(`
+	response, err := cog.DoRequest(ctx, client.httpClient, client.baseURL, request)
+	if err != nil {
+		return %[1]serr
+	}
+	defer response.Body.Close()
+
+	switch {
+`, zeroResult))
+
+	var defaultResponse *ast.OperationResponse
+	for i, response := range operation.Responses {
+		if response.Status == "default" {
+			defaultResponse = &operation.Responses[i]
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf("\tcase response.StatusCode == %s:\n", response.Status))
+		buffer.WriteString(jenny.formatResponse(context, response, zeroResult, "\t\t"))
+	}
+
+	// a successful default response covers any 2XX status code
+	if defaultResponse != nil && defaultResponse.Success {
+		buffer.WriteString("\tcase response.StatusCode >= 200 && response.StatusCode < 300:\n")
+		buffer.WriteString(jenny.formatResponse(context, *defaultResponse, zeroResult, "\t\t"))
+	}
+
+	buffer.WriteString("\t}\n")
+
+	// any other status code is described by a non-successful default response
+	if defaultResponse != nil && !defaultResponse.Success {
+		buffer.WriteString("\n")
+		buffer.WriteString(jenny.formatResponse(context, *defaultResponse, zeroResult, "\t"))
+	} else {
+		buffer.WriteString(fmt.Sprintf("\n\treturn %scog.NewAPIError(response)\n", zeroResult))
+	}
+
+	buffer.WriteString("}\n")
+
+	return buffer.String(), nil
+}
+
+func (jenny *Client) formatPath(operation ast.Operation, params ast.StructType) (string, error) {
+	var parts []string
+
+	last := 0
+	for _, match := range pathParamRegex.FindAllStringSubmatchIndex(operation.Path, -1) {
+		if prefix := operation.Path[last:match[0]]; prefix != "" {
+			parts = append(parts, fmt.Sprintf("%q", prefix))
+		}
+
+		name := operation.Path[match[2]:match[3]]
+		field, found := params.FieldByName(name)
+		if !found {
+			return "", fmt.Errorf("could not locate path parameter '%s'", name)
+		}
+
+		value := "params." + tools.UpperCamelCase(field.Name)
+		if field.Type.Nullable && !field.Type.IsAny() {
+			value = "*" + value
+		}
+
+		parts = append(parts, fmt.Sprintf("url.PathEscape(fmt.Sprint(%s))", value))
+		last = match[1]
+	}
+
+	if suffix := operation.Path[last:]; suffix != "" || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%q", suffix))
+	}
+
+	return strings.Join(parts, " + "), nil
+}
+
+func (jenny *Client) formatParameter(parameter ast.OperationParameter, field ast.StructField) string {
+	value := "params." + tools.UpperCamelCase(field.Name)
+
+	add := func(value string) string {
+		switch parameter.In {
+		case ast.ParameterInQuery:
+			return fmt.Sprintf("request.Query.Add(%q, fmt.Sprint(%s))", parameter.Name, value)
+		case ast.ParameterInHeader:
+			return fmt.Sprintf("request.Header.Add(%q, fmt.Sprint(%s))", parameter.Name, value)
+		default:
+			return fmt.Sprintf("request.Cookies = append(request.Cookies, &http.Cookie{Name: %q, Value: fmt.Sprint(%s)})", parameter.Name, value)
+		}
+	}
+
+	if field.Type.IsArray() {
+		return fmt.Sprintf("\tfor _, item := range %s {\n\t\t%s\n\t}\n", value, add("item"))
+	}
+
+	if field.Type.IsAny() || field.Type.IsMap() {
+		return fmt.Sprintf("\tif %s != nil {\n\t\t%s\n\t}\n", value, add(value))
+	}
+
+	if field.Type.Nullable {
+		return fmt.Sprintf("\tif %s != nil {\n\t\t%s\n\t}\n", value, add("*"+value))
+	}
+
+	return fmt.Sprintf("\t%s\n", add(value))
+}
+
+func (jenny *Client) formatRequestBody(context common.Context, body ast.OperationBody, zeroResult string) string {
+	var buffer strings.Builder
+
+	indent := "\t"
+	if !body.Required {
+		buffer.WriteString("\tif body != nil {\n")
+		indent = "\t\t"
+	}
+
+	encoder := "cog.JSONBody(body)"
+	if jenny.isRawBody(context, body) {
+		encoder = "cog.RawBody(string(body))"
+		if !body.Required {
+			encoder = "cog.RawBody(string(*body))"
+		}
+	}
+
+	buffer.WriteString(fmt.Sprintf(`%[1]sencodedBody, err := %[2]s
+%[1]sif err != nil {
+%[1]s	return %[3]serr
+%[1]s}
+%[1]srequest.Body = encodedBody
+%[1]srequest.ContentType = %[4]q
+`, indent, encoder, zeroResult, body.ContentType))
+
+	if !body.Required {
+		buffer.WriteString("\t}\n")
+	}
+
+	return buffer.String()
+}
+
+func (jenny *Client) formatResponse(context common.Context, response ast.OperationResponse, zeroResult string, indent string) string {
+	var buffer strings.Builder
+
+	if response.Body == nil {
+		if response.Success {
+			buffer.WriteString(fmt.Sprintf("%sreturn %snil\n", indent, zeroResult))
+		} else {
+			buffer.WriteString(fmt.Sprintf("%sreturn %scog.NewAPIError(response)\n", indent, zeroResult))
+		}
+
+		return buffer.String()
+	}
+
+	resultType := jenny.typeFormatter.formatType(response.Body.Type)
+
+	if jenny.isRawBody(context, *response.Body) {
+		buffer.WriteString(fmt.Sprintf(`%[1]sraw, err := io.ReadAll(response.Body)
+%[1]sif err != nil {
+%[1]s	return %[2]serr
+%[1]s}
+%[1]sresult := %[3]s(raw)
+`, indent, zeroResult, resultType))
+	} else {
+		buffer.WriteString(fmt.Sprintf(`%[1]svar result %[3]s
+%[1]sif err := cog.DecodeJSON(response, &result); err != nil {
+%[1]s	return %[2]serr
+%[1]s}
+`, indent, zeroResult, resultType))
+	}
+
+	switch {
+	case !response.Success:
+		buffer.WriteString(fmt.Sprintf("%sreturn %s&cog.APIError{StatusCode: response.StatusCode, Body: result}\n", indent, zeroResult))
+	case zeroResult != "":
+		buffer.WriteString(fmt.Sprintf("%sreturn &result, nil\n", indent))
+	default:
+		buffer.WriteString(fmt.Sprintf("%sreturn nil\n", indent))
+	}
+
+	return buffer.String()
+}
+
+// isRawBody tells whether a body should be sent or read as-is instead of
+// being encoded as JSON: it is the case for strings described with a non-JSON
+// content type.
+func (jenny *Client) isRawBody(context common.Context, body ast.OperationBody) bool {
+	if strings.Contains(body.ContentType, "json") {
+		return false
+	}
+
+	resolved := context.ResolveRefs(body.Type)
+
+	return resolved.IsScalar() && resolved.AsScalar().ScalarKind == ast.KindString
+}
@@ -0,0 +1,75 @@
+package golang
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/openapi"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Generate(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite{
+		TestDataRoot: "../../../testdata/jennies/clients",
+		Name:         "GoClient",
+	}
+
+	jenny := Client{
+		Config: Config{
+			PackageRoot: "github.com/grafana/cog/generated",
+		},
+	}
+	compilerPasses := New().CompilerPasses()
+
+	test.Run(t, func(tc *testutils.Test) {
+		req := require.New(tc)
+
+		processedAsts, err := compilerPasses.Process(ast.Schemas{tc.TypesIR()})
+		req.NoError(err)
+
+		files, err := jenny.Generate(common.Context{
+			Schemas: processedAsts,
+		})
+		req.NoError(err)
+
+		tc.WriteFiles(files)
+	})
+}
+
+func TestClient_Generate_withRenamedObject(t *testing.T) {
+	req := require.New(t)
+
+	schema, err := openapi.GenerateAST("../../../testdata/openapi/paths/schema.json", openapi.Config{Package: "paths"})
+	req.NoError(err)
+
+	compilerPasses := compiler.Passes{
+		&compiler.RenameObject{
+			From: compiler.ObjectReference{Package: "paths", Object: "GetDashboardResponse"},
+			To:   "DashboardResponse",
+		},
+	}.Concat(New().CompilerPasses())
+
+	processedAsts, err := compilerPasses.Process(ast.Schemas{schema})
+	req.NoError(err)
+
+	jenny := Client{
+		Config: Config{
+			PackageRoot: "github.com/grafana/cog/generated",
+		},
+	}
+
+	files, err := jenny.Generate(common.Context{
+		Schemas: processedAsts,
+	})
+	req.NoError(err)
+	req.Len(files, 1)
+
+	client := string(files[0].Data)
+	req.Contains(client, "(*DashboardResponse, error)")
+	req.Contains(client, "var result DashboardResponse\n")
+	req.NotContains(client, "GetDashboardResponse\n")
+	req.NotContains(client, "*GetDashboardResponse,")
+}
@@ -0,0 +1,595 @@
+package golang
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// Converter generates, for each builder, a function taking a populated
+// object and returning the Go code that builds this object using builders.
+// Options are inverted from their assignments:
+//   - constants assigned by an option act as conditions for this option to be used,
+//   - arguments are read from the paths they are assigned to,
+//   - "append" assignments result in one option call per item.
+//
+// Options that can not be inverted (ex: composable slots) are ignored.
+type Converter struct {
+	Config Config
+
+	typeImportMapper func(pkg string) string
+	typeFormatter    *typeFormatter
+
+	// convertible caches, for each builder, whether a converter can be
+	// generated for it.
+	convertible map[string]bool
+}
+
+func (jenny *Converter) JennyName() string {
+	return "GoConverter"
+}
+
+func (jenny *Converter) Generate(context common.Context) (codejen.Files, error) {
+	files := codejen.Files{}
+	jenny.convertible = make(map[string]bool)
+
+	for _, builder := range context.Builders {
+		if !jenny.hasConverter(context, builder) {
+			continue
+		}
+
+		output, err := jenny.generateConverter(context, builder)
+		if err != nil {
+			return nil, err
+		}
+
+		filename := filepath.Join(
+			formatPackageName(builder.Package),
+			fmt.Sprintf("%s_converter_gen.go", strings.ToLower(builder.Name)),
+		)
+
+		files = append(files, *codejen.NewFile(filename, output, jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny *Converter) generateConverter(context common.Context, builder ast.Builder) ([]byte, error) {
+	var buffer strings.Builder
+
+	imports := NewImportMap()
+	jenny.typeImportMapper = func(pkg string) string {
+		if imports.IsIdentical(pkg, builder.Package) {
+			return ""
+		}
+
+		return imports.Add(pkg, jenny.Config.importPath(pkg))
+	}
+	jenny.typeFormatter = builderTypeFormatter(jenny.Config, context, jenny.typeImportMapper)
+
+	// the generated code relies on cog's runtime
+	jenny.typeImportMapper("cog")
+
+	converterName := tools.UpperCamelCase(builder.Name) + "Converter"
+	objectName := jenny.importType(builder.For.SelfRef)
+
+	buffer.WriteString(fmt.Sprintf("// %[1]s accepts a `%[2]s` object and generates the Go code to build this object using builders.\n", converterName, tools.UpperCamelCase(builder.For.Name)))
+	buffer.WriteString(fmt.Sprintf("func %s(input %s) string {\n", converterName, objectName))
+	buffer.WriteString(jenny.generateConstructorCall(context, builder))
+
+	// the generated code uses its own `buffer` variable: it is only
+	// declared if at least one option call needs it.
+	var optionCalls strings.Builder
+	seenOptions := make(map[string]bool)
+	for _, option := range builder.Options {
+		if option.IsConstructorArg {
+			continue
+		}
+
+		// several options might assign the same paths from arguments
+		// (ex: when veneers duplicate an option, or add one appending to
+		// an array): only the first one is used.
+		signature := optionSignature(option)
+		if signature != "" && seenOptions[signature] {
+			continue
+		}
+
+		optionCode, ok := jenny.generateOptionCall(context, option)
+		if !ok {
+			continue
+		}
+
+		if signature != "" {
+			seenOptions[signature] = true
+		}
+
+		optionCalls.WriteString("\n")
+		optionCalls.WriteString(optionCode)
+	}
+
+	if optionCalls.Len() != 0 {
+		buffer.WriteString("\tvar buffer strings.Builder\n")
+		buffer.WriteString(optionCalls.String())
+	}
+
+	buffer.WriteString("\n\treturn strings.Join(calls, \".\\n\")\n")
+	buffer.WriteString("}\n")
+
+	return []byte(fmt.Sprintf("package %s\n\n%s\n\n%s", formatPackageName(builder.Package), imports.String(), buffer.String())), nil
+}
+
+// hasConverter tells whether a converter can be generated for the given
+// builder: its constructor arguments must all be convertible.
+func (jenny *Converter) hasConverter(context common.Context, builder ast.Builder) bool {
+	key := builder.Package + "." + builder.Name
+	if convertible, found := jenny.convertible[key]; found {
+		return convertible
+	}
+
+	// optimistic guess, for builders referencing themselves
+	jenny.convertible[key] = true
+
+	convertible := true
+	for _, option := range builder.Options {
+		if !option.IsConstructorArg || len(option.Args) == 0 || len(option.Assignments) == 0 {
+			continue
+		}
+
+		if !jenny.isConvertibleArgument(context, option.Args[0].Type) {
+			convertible = false
+			break
+		}
+	}
+
+	jenny.convertible[key] = convertible
+
+	return convertible
+}
+
+// isConvertibleArgument tells whether renderArgument is able to render
+// values of the given type.
+func (jenny *Converter) isConvertibleArgument(context common.Context, argType ast.Type) bool {
+	if _, isSlot := context.ResolveToComposableSlot(argType); isSlot {
+		return false
+	}
+
+	if !context.ResolveToBuilder(argType) {
+		return true
+	}
+
+	for argType.IsArray() {
+		argType = argType.AsArray().ValueType
+	}
+
+	if !argType.IsRef() {
+		return false
+	}
+
+	builder, found := context.Builders.LocateByObject(argType.AsRef().ReferredPkg, argType.AsRef().ReferredType)
+
+	return found && jenny.hasConverter(context, builder)
+}
+
+func (jenny *Converter) generateConstructorCall(context common.Context, builder ast.Builder) string {
+	var buffer strings.Builder
+	var args []string
+
+	for _, option := range builder.Options {
+		if !option.IsConstructorArg || len(option.Args) == 0 || len(option.Assignments) == 0 {
+			continue
+		}
+
+		argName := fmt.Sprintf("constructorArg%d", len(args))
+		assignment := option.Assignments[0]
+		guards, access := jenny.pathAccess("input", assignment.Path)
+
+		buffer.WriteString(fmt.Sprintf("\tvar %s %s\n", argName, jenny.argumentType(option.Args[0])))
+		if len(guards) == 0 {
+			buffer.WriteString(fmt.Sprintf("\t%s = %s\n", argName, jenny.valueAccess(access, assignment.Path.Last().Type)))
+		} else {
+			buffer.WriteString(fmt.Sprintf("\tif %s {\n", strings.Join(guards, " && ")))
+			buffer.WriteString(fmt.Sprintf("\t\t%s = %s\n", argName, jenny.valueAccess(access, assignment.Path.Last().Type)))
+			buffer.WriteString("\t}\n")
+		}
+
+		// hasConverter ensures that constructor arguments can be rendered
+		rendered, _ := jenny.renderArgument(context, option.Args[0].Type, argName)
+		args = append(args, rendered)
+	}
+
+	constructor := fmt.Sprintf("%s.New%sBuilder(", formatPackageName(builder.Package), tools.UpperCamelCase(builder.Name))
+	if len(args) == 0 {
+		buffer.WriteString(fmt.Sprintf("\tcalls := []string{\n\t\t`%s)`,\n\t}\n", constructor))
+		return buffer.String()
+	}
+
+	buffer.WriteString(fmt.Sprintf("\tcalls := []string{\n\t\t`%s` + %s + `)`,\n\t}\n", constructor, strings.Join(args, " + \", \" + ")))
+
+	return buffer.String()
+}
+
+func (jenny *Converter) generateOptionCall(context common.Context, option ast.Option) (string, bool) {
+	var conditions []string
+	var argAssignments []ast.Assignment
+	var loopAssignment *ast.Assignment
+
+	for i, assignment := range option.Assignments {
+		if !jenny.isInvertiblePath(assignment.Path) {
+			return "", false
+		}
+
+		if assignment.Value.Constant != nil {
+			guards, access := jenny.pathAccess("input", assignment.Path)
+			conditions = append(conditions, guards...)
+			if isPointer(assignment.Path.Last().Type) {
+				conditions = append(conditions, access+" != nil")
+			}
+			conditions = append(conditions, fmt.Sprintf("%s == %s", jenny.valueAccess(access, assignment.Path.Last().Type), formatScalar(assignment.Value.Constant)))
+			continue
+		}
+
+		if assignment.Method == ast.AppendAssignment || assignment.Value.Envelope != nil {
+			if loopAssignment != nil {
+				return "", false
+			}
+
+			loopAssignment = &option.Assignments[i]
+			continue
+		}
+
+		argAssignments = append(argAssignments, assignment)
+	}
+
+	if loopAssignment != nil && len(argAssignments) != 0 {
+		return "", false
+	}
+
+	if loopAssignment != nil {
+		return jenny.generateLoopOptionCall(context, option, conditions, *loopAssignment)
+	}
+
+	return jenny.generateDirectOptionCall(context, option, conditions, argAssignments)
+}
+
+// generateDirectOptionCall handles options assigning their arguments directly.
+func (jenny *Converter) generateDirectOptionCall(context common.Context, option ast.Option, conditions []string, assignments []ast.Assignment) (string, bool) {
+	var buffer strings.Builder
+
+	args, ok := jenny.argumentsAssignments(option, assignments)
+	if !ok {
+		return "", false
+	}
+
+	// arguments must be read from the input only if they're set.
+	var presence []string
+	for _, assignment := range args {
+		guards, access := jenny.pathAccess("input", assignment.Path)
+		guards = append(guards, jenny.presenceCheck(access, assignment.Path.Last().Type))
+
+		presence = append(presence, strings.Join(guards, " && "))
+	}
+
+	if len(presence) == 1 {
+		conditions = append(conditions, presence[0])
+	} else if len(presence) > 1 {
+		conditions = append(conditions, "("+strings.Join(presence, " || ")+")")
+	}
+
+	if len(conditions) == 0 {
+		return "", false
+	}
+
+	buffer.WriteString(fmt.Sprintf("\tif %s {\n", strings.Join(conditions, " && ")))
+
+	var renderedArgs []string
+	for i, assignment := range args {
+		argName := fmt.Sprintf("arg%d", i)
+		guards, access := jenny.pathAccess("input", assignment.Path)
+		value := jenny.valueAccess(access, assignment.Path.Last().Type)
+
+		if len(args) == 1 {
+			buffer.WriteString(fmt.Sprintf("\t\t%s := %s\n", argName, value))
+		} else {
+			guards = append(guards, jenny.presenceCheck(access, assignment.Path.Last().Type))
+
+			buffer.WriteString(fmt.Sprintf("\t\tvar %s %s\n", argName, jenny.argumentType(option.Args[i])))
+			buffer.WriteString(fmt.Sprintf("\t\tif %s {\n", strings.Join(guards, " && ")))
+			buffer.WriteString(fmt.Sprintf("\t\t\t%s = %s\n", argName, value))
+			buffer.WriteString("\t\t}\n")
+		}
+
+		rendered, ok := jenny.renderArgument(context, option.Args[i].Type, argName)
+		if !ok {
+			return "", false
+		}
+
+		renderedArgs = append(renderedArgs, rendered)
+	}
+
+	buffer.WriteString(jenny.optionCallCode("\t\t", option, renderedArgs))
+	buffer.WriteString("\t}\n")
+
+	return buffer.String(), true
+}
+
+// generateLoopOptionCall handles options appending values, or assigning
+// arguments within an envelope.
+func (jenny *Converter) generateLoopOptionCall(context common.Context, option ast.Option, conditions []string, assignment ast.Assignment) (string, bool) {
+	var buffer strings.Builder
+	var renderedArgs []string
+
+	guards, access := jenny.pathAccess("input", assignment.Path)
+	conditions = append(conditions, guards...)
+
+	itemsVar := jenny.valueAccess(access, assignment.Path.Last().Type)
+	item := "item"
+
+	if assignment.Method != ast.AppendAssignment {
+		// direct assignment of an envelope: the "item" is the assigned value itself
+		conditions = append(conditions, jenny.presenceCheck(access, assignment.Path.Last().Type))
+		item = itemsVar
+		if isPointer(assignment.Path.Last().Type) {
+			item = "(" + itemsVar + ")"
+		}
+	}
+
+	switch {
+	case assignment.Value.Argument != nil:
+		if len(option.Args) != 1 {
+			return "", false
+		}
+
+		rendered, ok := jenny.renderArgument(context, option.Args[0].Type, item)
+		if !ok {
+			return "", false
+		}
+		renderedArgs = append(renderedArgs, rendered)
+	case assignment.Value.Envelope != nil:
+		for _, arg := range option.Args {
+			envelopeValue, found := envelopeValueForArgument(assignment.Value.Envelope, arg)
+			if !found {
+				return "", false
+			}
+
+			itemGuards, itemAccess := jenny.pathAccess(item, envelopeValue.Path)
+			if len(itemGuards) != 0 {
+				return "", false
+			}
+
+			rendered, ok := jenny.renderArgument(context, arg.Type, jenny.valueAccess(itemAccess, envelopeValue.Path.Last().Type))
+			if !ok {
+				return "", false
+			}
+			renderedArgs = append(renderedArgs, rendered)
+		}
+	default:
+		return "", false
+	}
+
+	if len(conditions) == 0 {
+		buffer.WriteString(fmt.Sprintf("\tfor _, item := range %s {\n", itemsVar))
+		buffer.WriteString(jenny.optionCallCode("\t\t", option, renderedArgs))
+		buffer.WriteString("\t}\n")
+
+		return buffer.String(), true
+	}
+
+	buffer.WriteString(fmt.Sprintf("\tif %s {\n", strings.Join(conditions, " && ")))
+	if assignment.Method == ast.AppendAssignment {
+		buffer.WriteString(fmt.Sprintf("\t\tfor _, item := range %s {\n", itemsVar))
+		buffer.WriteString(jenny.optionCallCode("\t\t\t", option, renderedArgs))
+		buffer.WriteString("\t\t}\n")
+	} else {
+		buffer.WriteString(jenny.optionCallCode("\t\t", option, renderedArgs))
+	}
+	buffer.WriteString("\t}\n")
+
+	return buffer.String(), true
+}
+
+func (jenny *Converter) optionCallCode(indent string, option ast.Option, renderedArgs []string) string {
+	var buffer strings.Builder
+
+	buffer.WriteString(fmt.Sprintf("%sbuffer.WriteString(`%s(`)\n", indent, tools.UpperCamelCase(option.Name)))
+	for i, rendered := range renderedArgs {
+		if i != 0 {
+			buffer.WriteString(fmt.Sprintf("%sbuffer.WriteString(\", \")\n", indent))
+		}
+		buffer.WriteString(fmt.Sprintf("%sbuffer.WriteString(%s)\n", indent, rendered))
+	}
+	buffer.WriteString(fmt.Sprintf("%sbuffer.WriteString(\")\")\n\n", indent))
+	buffer.WriteString(fmt.Sprintf("%scalls = append(calls, buffer.String())\n", indent))
+	buffer.WriteString(fmt.Sprintf("%sbuffer.Reset()\n", indent))
+
+	return buffer.String()
+}
+
+// renderArgument returns an expression rendering the Go code for the
+// value held in the given variable.
+func (jenny *Converter) renderArgument(context common.Context, argType ast.Type, variable string) (string, bool) {
+	if _, isSlot := context.ResolveToComposableSlot(argType); isSlot {
+		return "", false
+	}
+
+	if !context.ResolveToBuilder(argType) {
+		return fmt.Sprintf("cog.Dump(%s)", variable), true
+	}
+
+	converter, itemType, ok := jenny.builderConverter(context, argType)
+	if !ok {
+		return "", false
+	}
+
+	if !argType.IsArray() {
+		return fmt.Sprintf("%s(%s)", converter, variable), true
+	}
+
+	return fmt.Sprintf("cog.ConvertArray(%s, %q, %s)", variable, itemType, converter), true
+}
+
+// builderConverter returns the function converting values of the given type
+// to builder code, as well as the type of these builders, as it would
+// appear in the generated code.
+func (jenny *Converter) builderConverter(context common.Context, typeDef ast.Type) (string, string, bool) {
+	if typeDef.IsArray() {
+		converter, itemType, ok := jenny.builderConverter(context, typeDef.AsArray().ValueType)
+		if !ok {
+			return "", "", false
+		}
+
+		if !typeDef.AsArray().ValueType.IsArray() {
+			return converter, itemType, true
+		}
+
+		// arrays of arrays: each item is itself converted as an array
+		valueType := jenny.typeFormatter.doFormatType(typeDef.AsArray().ValueType, false)
+		nestedConverter := fmt.Sprintf("func(items %s) string { return cog.ConvertArray(items, %q, %s) }", valueType, itemType, converter)
+
+		return nestedConverter, "[]" + itemType, true
+	}
+
+	if !typeDef.IsRef() {
+		return "", "", false
+	}
+
+	ref := typeDef.AsRef()
+	builder, found := context.Builders.LocateByObject(ref.ReferredPkg, ref.ReferredType)
+	if !found || !jenny.hasConverter(context, builder) {
+		return "", "", false
+	}
+
+	converter := tools.UpperCamelCase(builder.Name) + "Converter"
+	if pkg := jenny.typeImportMapper(builder.Package); pkg != "" {
+		converter = pkg + "." + converter
+	}
+
+	itemType := fmt.Sprintf("cog.Builder[%s.%s]", formatPackageName(ref.ReferredPkg), tools.UpperCamelCase(ref.ReferredType))
+
+	return converter, itemType, true
+}
+
+// pathAccess returns the Go expression to access the given path from a root
+// variable, along with the nil-checks needed to safely do so.
+func (jenny *Converter) pathAccess(root string, path ast.Path) ([]string, string) {
+	var guards []string
+	access := root
+
+	for i, item := range path {
+		access += "." + tools.UpperCamelCase(item.Identifier)
+
+		if i != len(path)-1 && item.Type.Nullable {
+			guards = append(guards, access+" != nil")
+		}
+	}
+
+	return guards, access
+}
+
+// valueAccess dereferences pointers: arguments are never pointers.
+func (jenny *Converter) valueAccess(access string, typeDef ast.Type) string {
+	if isPointer(typeDef) {
+		return "*" + access
+	}
+
+	return access
+}
+
+func (jenny *Converter) presenceCheck(access string, typeDef ast.Type) string {
+	if isPointer(typeDef) {
+		return access + " != nil"
+	}
+
+	if typeDef.IsAnyOf(ast.KindArray, ast.KindMap) {
+		return fmt.Sprintf("len(%s) != 0", access)
+	}
+
+	return fmt.Sprintf("!cog.IsZero(%s)", access)
+}
+
+func (jenny *Converter) argumentType(arg ast.Argument) string {
+	return strings.TrimPrefix(jenny.typeFormatter.doFormatType(arg.Type, false), "*")
+}
+
+// isInvertiblePath tells whether values assigned to the given path can be
+// read back. Values stored in "any" fields can not.
+func (jenny *Converter) isInvertiblePath(path ast.Path) bool {
+	for i, item := range path {
+		if i != len(path)-1 && item.Type.IsAny() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// argumentsAssignments sorts the given assignments in the same order as the
+// option's arguments.
+func (jenny *Converter) argumentsAssignments(option ast.Option, assignments []ast.Assignment) ([]ast.Assignment, bool) {
+	if len(assignments) != len(option.Args) {
+		return nil, false
+	}
+
+	sorted := make([]ast.Assignment, 0, len(assignments))
+	for _, arg := range option.Args {
+		found := false
+		for _, assignment := range assignments {
+			if assignment.Value.Argument != nil && assignment.Value.Argument.Name == arg.Name {
+				sorted = append(sorted, assignment)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, false
+		}
+	}
+
+	return sorted, true
+}
+
+// importType declares an import statement for the type definition of
+// the given object and returns a fully qualified type name for it.
+func (jenny *Converter) importType(typeRef ast.RefType) string {
+	pkg := jenny.typeImportMapper(typeRef.ReferredPkg)
+	typeName := tools.UpperCamelCase(typeRef.ReferredType)
+	if pkg == "" {
+		return typeName
+	}
+
+	return fmt.Sprintf("%s.%s", pkg, typeName)
+}
+
+func envelopeValueForArgument(envelope *ast.AssignmentEnvelope, arg ast.Argument) (ast.EnvelopeFieldValue, bool) {
+	for _, value := range envelope.Values {
+		if value.Value.Argument != nil && value.Value.Argument.Name == arg.Name {
+			return value, true
+		}
+	}
+
+	return ast.EnvelopeFieldValue{}, false
+}
+
+// optionSignature identifies the paths an option assigns arguments to.
+// Options only assigning constants have no signature.
+func optionSignature(option ast.Option) string {
+	var paths []string
+	for _, assignment := range option.Assignments {
+		if assignment.Value.Constant != nil {
+			continue
+		}
+
+		paths = append(paths, assignment.Path.String())
+	}
+
+	return strings.Join(paths, ",")
+}
+
+func isPointer(typeDef ast.Type) bool {
+	return typeDef.Nullable && !typeDef.IsAnyOf(ast.KindArray, ast.KindMap) && !typeDef.IsAny()
+}
@@ -0,0 +1,91 @@
+package golang
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/grafana/cog/internal/tools"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConverter_Generate_compiles generates the whole Go output for
+// each builders test case, converters included, and makes sure that it
+// compiles and passes `go vet`.
+func TestConverter_Generate_compiles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("compiling generated code is slow")
+	}
+
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not found")
+	}
+
+	testDataRoot := "../../../testdata/jennies/builders"
+	skip := map[string]string{
+		"builder_delegation_in_disjunction": "disjunctions are eliminated with compiler passes",
+		"constant_assignment":               "the IR uses the 'boolean' scalar kind, unknown to Go",
+		"initialization_safeguards":         "the IR uses the 'boolean' scalar kind, unknown to Go",
+		"struct_with_defaults":              "the IR defines defaults for fields that don't exist",
+	}
+
+	language := &Language{
+		config: Config{
+			PackageRoot:        "github.com/grafana/cog/generated",
+			GenerateGoMod:      true,
+			GenerateConverters: true,
+		},
+	}
+	jennies := language.Jennies(common.Config{Types: true, Builders: true})
+
+	entries, err := os.ReadDir(testDataRoot)
+	require.NoError(t, err)
+
+	for _, entry := range entries {
+		testRootDir := filepath.Join(testDataRoot, entry.Name())
+		if _, err := os.Stat(filepath.Join(testRootDir, "GoConverter")); err != nil {
+			continue
+		}
+
+		t.Run(entry.Name(), func(t *testing.T) {
+			if reason, ok := skip[entry.Name()]; ok {
+				t.Skip(reason)
+			}
+
+			tc := &testutils.Test{T: t, RootDir: testRootDir}
+			req := require.New(t)
+
+			generatedFS, err := jennies.GenerateFS(tc.BuildersContext())
+			req.NoError(err)
+
+			outputDir := t.TempDir()
+			req.NoError(generatedFS.Write(context.Background(), outputDir))
+
+			// only the packages containing converters are checked
+			var packages []string
+			for _, file := range generatedFS.AsFiles() {
+				pkg := "./" + filepath.Dir(file.RelativePath)
+				if strings.HasSuffix(file.RelativePath, "_converter_gen.go") && !tools.ItemInList(pkg, packages) {
+					packages = append(packages, pkg)
+				}
+			}
+			req.NotEmpty(packages)
+
+			for _, command := range []string{"build", "vet"} {
+				args := append([]string{command}, packages...)
+				cmd := exec.Command(goBin, args...)
+				cmd.Dir = outputDir
+				cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod", "GOPROXY=off")
+
+				output, err := cmd.CombinedOutput()
+				req.NoError(err, "go %s failed:\n%s", command, output)
+			}
+		})
+	}
+}
@@ -22,6 +22,10 @@ type Config struct {
 	// Root path for imports.
 	// Ex: github.com/grafana/cog/generated
 	PackageRoot string
+
+	// GenerateConverters indicates whether converters from objects to the
+	// Go code building them should be generated alongside builders.
+	GenerateConverters bool
 }
 
 func (config Config) MergeWithGlobal(global common.Config) Config {
@@ -33,7 +37,14 @@ func (config Config) MergeWithGlobal(global common.Config) Config {
 
 func (config Config) importPath(suffix string) string {
 	root := strings.TrimSuffix(config.PackageRoot, "/")
-	return fmt.Sprintf("%s/%s", root, suffix)
+
+	// packages are generated in directories named after their formatted name
+	segments := strings.Split(suffix, "/")
+	for i, segment := range segments {
+		segments[i] = formatPackageName(segment)
+	}
+
+	return fmt.Sprintf("%s/%s", root, strings.Join(segments, "/"))
 }
 
 type Language struct {
@@ -49,6 +60,7 @@ func New() *Language {
 func (language *Language) RegisterCliFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&language.config.PackageRoot, "go-package-root", "github.com/grafana/cog/generated", "Go package root.")
 	cmd.Flags().BoolVar(&language.config.GenerateGoMod, "go-mod", false, "Generate a go.mod file. If enabled, 'go-package-root' is used as module path.")
+	cmd.Flags().BoolVar(&language.config.GenerateConverters, "go-converters", false, "Generate converters turning objects into the Go code building them. Requires builders.")
 }
 
 func (language *Language) Jennies(globalConfig common.Config) *codejen.JennyList[common.Context] {
@@ -65,8 +77,10 @@ func (language *Language) Jennies(globalConfig common.Config) *codejen.JennyList
 
 		common.If[common.Context](globalConfig.Types, RawTypes{Config: config}),
 		common.If[common.Context](globalConfig.Types, JSONMarshalling{Config: config}),
+		common.If[common.Context](globalConfig.Types, &Client{Config: config}),
 
 		common.If[common.Context](globalConfig.Builders, &Builder{Config: config}),
+		common.If[common.Context](globalConfig.Builders && config.GenerateConverters, &Converter{Config: config}),
 	)
 	jenny.AddPostprocessors(PostProcessFile, common.GeneratedCommentHeader(globalConfig))
 
@@ -114,10 +114,15 @@ func (jenny JSONMarshalling) generateSchema(context common.Context, schema *ast.
 }
 
 func (jenny JSONMarshalling) objectNeedsCustomMarshal(obj ast.Object) bool {
-	// the only case for which we need a custom marshaller is for structs
-	// that are generated from a disjunction by the `DisjunctionToType` compiler pass.
+	// an object needs a custom marshal if:
+	// - it is a struct that was generated from a disjunction by the `DisjunctionToType` compiler pass.
+	// - it is a struct accepting additional properties
+
+	return obj.Type.IsStructGeneratedFromDisjunction() || jenny.hasAdditionalProperties(obj)
+}
 
-	return obj.Type.IsStructGeneratedFromDisjunction()
+func (jenny JSONMarshalling) hasAdditionalProperties(obj ast.Object) bool {
+	return obj.Type.IsStruct() && obj.Type.AsStruct().AdditionalProperties != nil
 }
 
 func (jenny JSONMarshalling) renderCustomMarshal(obj ast.Object) (string, error) {
@@ -137,6 +142,12 @@ func (jenny JSONMarshalling) renderCustomMarshal(obj ast.Object) (string, error)
 		})
 	}
 
+	if jenny.hasAdditionalProperties(obj) {
+		return jenny.renderTemplate("types/additional_properties.json_marshal.tmpl", map[string]any{
+			"def": obj,
+		})
+	}
+
 	return "", fmt.Errorf("could not determine how to render custom marshal")
 }
 
@@ -144,6 +155,7 @@ func (jenny JSONMarshalling) objectNeedsCustomUnmarshal(context common.Context,
 	// an object needs a custom unmarshal if:
 	// - it is a struct that was generated from a disjunction by the `DisjunctionToType` compiler pass.
 	// - it is a struct and one or more of its fields is a KindComposableSlot, or an array of KindComposableSlot
+	// - it is a struct accepting additional properties
 
 	if !obj.Type.IsStruct() {
 		return false
@@ -154,7 +166,14 @@ func (jenny JSONMarshalling) objectNeedsCustomUnmarshal(context common.Context,
 		return true
 	}
 
-	// is there a KindComposableSlot field somewhere?
+	if jenny.hasAdditionalProperties(obj) {
+		return true
+	}
+
+	return jenny.hasComposableSlotField(context, obj)
+}
+
+func (jenny JSONMarshalling) hasComposableSlotField(context common.Context, obj ast.Object) bool {
 	for _, field := range obj.Type.AsStruct().Fields {
 		if _, ok := context.ResolveToComposableSlot(field.Type); ok {
 			return true
@@ -178,6 +197,12 @@ func (jenny JSONMarshalling) renderCustomUnmarshal(context common.Context, obj a
 		})
 	}
 
+	if jenny.hasAdditionalProperties(obj) && !jenny.hasComposableSlotField(context, obj) {
+		return jenny.renderTemplate("types/additional_properties.json_unmarshal.tmpl", map[string]any{
+			"def": obj,
+		})
+	}
+
 	return jenny.renderCustomComposableSlotUnmarshal(context, obj)
 }
 
@@ -302,6 +327,8 @@ func (jenny JSONMarshalling) renderUnmarshalDataqueryField(parentStruct ast.Obje
 	}
 
 	// then: unmarshalling boilerplate
+	// each field is unmarshalled in its own scope, since several fields
+	// can hold dataqueries.
 	hintValue := `dataqueryTypeHint := ""
 `
 
@@ -314,22 +341,26 @@ dataqueryTypeHint = *resource.%[1]s.Type
 
 	if field.Type.IsArray() {
 		return fmt.Sprintf(`
+	{
 	%[3]s
 	%[2]s, err := cog.UnmarshalDataqueryArray(fields["%[2]s"], dataqueryTypeHint)
 	if err != nil {
 		return err
 	}
 	resource.%[1]s = %[2]s
+	}
 `, tools.UpperCamelCase(field.Name), field.Name, hintValue)
 	}
 
 	return fmt.Sprintf(`
+	{
 	%[3]s
 	%[2]s, err := cog.UnmarshalDataquery(fields["%[2]s"], dataqueryTypeHint)
 	if err != nil {
 		return err
 	}
 	resource.%[1]s = %[2]s
+	}
 `, tools.UpperCamelCase(field.Name), field.Name, hintValue)
 }
 
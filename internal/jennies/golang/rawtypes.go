@@ -15,6 +15,9 @@ type RawTypes struct {
 	Config Config
 
 	typeFormatter *typeFormatter
+
+	// whether objects need validation, indexed by reference.
+	validatedObjects map[string]bool
 }
 
 func (jenny RawTypes) JennyName() string {
@@ -53,6 +56,7 @@ func (jenny RawTypes) generateSchema(context common.Context, schema *ast.Schema)
 
 		return imports.Add(pkg, jenny.Config.importPath(pkg))
 	})
+	jenny.validatedObjects = make(map[string]bool)
 
 	schema.Objects.Iterate(func(_ string, object ast.Object) {
 		objectOutput, innerErr := jenny.formatObject(object)
@@ -126,6 +130,11 @@ func (jenny RawTypes) formatObject(def ast.Object) ([]byte, error) {
 		buffer.WriteString("\n")
 	}
 
+	if def.Type.IsStruct() {
+		buffer.WriteString("\n")
+		buffer.WriteString(jenny.formatValidateMethod(def))
+	}
+
 	return []byte(buffer.String()), nil
 }
 
@@ -13,18 +13,28 @@ func (jenny Runtime) JennyName() string {
 	return "GoRuntime"
 }
 
-func (jenny Runtime) Generate(_ common.Context) (codejen.Files, error) {
+func (jenny Runtime) Generate(context common.Context) (codejen.Files, error) {
 	runtime, err := jenny.Runtime()
 	if err != nil {
 		return nil, err
 	}
 
-	return codejen.Files{
+	files := codejen.Files{
 		*codejen.NewFile("cog/builder.go", []byte(jenny.generateBuilderInterface()), jenny),
 		*codejen.NewFile("cog/errors.go", []byte(jenny.generateErrorTools()), jenny),
 		*codejen.NewFile("cog/runtime.go", []byte(runtime), jenny),
-		*codejen.NewFile("cog/tools.go", []byte(jenny.generateToPtrFunc()), jenny),
-	}, nil
+		*codejen.NewFile("cog/tools.go", []byte(jenny.generateTools()), jenny),
+	}
+
+	if jenny.Config.GenerateConverters {
+		files = append(files, *codejen.NewFile("cog/converters.go", []byte(jenny.generateConverterTools()), jenny))
+	}
+
+	if hasOperations(context) {
+		files = append(files, *codejen.NewFile("cog/http.go", []byte(jenny.generateHTTPTools()), jenny))
+	}
+
+	return files, nil
 }
 
 func (jenny Runtime) generateBuilderInterface() string {
@@ -100,12 +110,243 @@ func MakeBuildErrors(rootPath string, err error) BuildErrors {
 `
 }
 
-func (jenny Runtime) generateToPtrFunc() string {
+func (jenny Runtime) generateTools() string {
 	return `package cog
 
+import (
+	"reflect"
+)
+
 func ToPtr[T any](v T) *T {
   return &v
 }
 
+// UniqueItems tells whether all the items of the given list are distinct.
+func UniqueItems[T any](items []T) bool {
+	for i := range items {
+		for j := i + 1; j < len(items); j++ {
+			if reflect.DeepEqual(items[i], items[j]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
 `
 }
+
+func (jenny Runtime) generateConverterTools() string {
+	return `package cog
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Dump returns the Go code representing the given value.
+func Dump(root any) string {
+	return dumpValue(reflect.ValueOf(root))
+}
+
+// IsZero reports whether the given value is the zero value for its type.
+func IsZero(value any) bool {
+	return value == nil || reflect.ValueOf(value).IsZero()
+}
+
+// ConvertArray returns the Go code representing an array, using the given
+// converter for each of its items.
+func ConvertArray[T any](items []T, itemType string, converter func(item T) string) string {
+	converted := make([]string, 0, len(items))
+	for _, item := range items {
+		converted = append(converted, converter(item))
+	}
+
+	return "[]" + itemType + "{" + strings.Join(converted, ",\n") + "}"
+}
+
+func dumpValue(value reflect.Value) string {
+	if !value.IsValid() {
+		return "nil"
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		return dumpNamed(value, strconv.FormatBool(value.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return dumpNamed(value, strconv.FormatInt(value.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return dumpNamed(value, strconv.FormatUint(value.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		return dumpNamed(value, strconv.FormatFloat(value.Float(), 'g', -1, 64))
+	case reflect.String:
+		return dumpNamed(value, strconv.Quote(value.String()))
+	case reflect.Interface:
+		if value.IsNil() {
+			return "nil"
+		}
+		return dumpValue(value.Elem())
+	case reflect.Pointer:
+		if value.IsNil() {
+			return "nil"
+		}
+		return fmt.Sprintf("cog.ToPtr[%s](%s)", value.Type().Elem().String(), dumpValue(value.Elem()))
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return "nil"
+		}
+
+		items := make([]string, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, dumpValue(value.Index(i)))
+		}
+
+		return fmt.Sprintf("%s{%s}", value.Type().String(), strings.Join(items, ", "))
+	case reflect.Map:
+		if value.IsNil() {
+			return "nil"
+		}
+
+		items := make([]string, 0, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			items = append(items, fmt.Sprintf("%s: %s", dumpValue(iter.Key()), dumpValue(iter.Value())))
+		}
+		sort.Strings(items)
+
+		return fmt.Sprintf("%s{%s}", value.Type().String(), strings.Join(items, ", "))
+	case reflect.Struct:
+		fields := make([]string, 0, value.NumField())
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() || value.Field(i).IsZero() {
+				continue
+			}
+
+			fields = append(fields, fmt.Sprintf("%s: %s", field.Name, dumpValue(value.Field(i))))
+		}
+
+		return fmt.Sprintf("%s{%s}", value.Type().String(), strings.Join(fields, ", "))
+	default:
+		return "nil"
+	}
+}
+
+// dumpNamed explicitly converts literals to their named type (ex: enums).
+func dumpNamed(value reflect.Value, literal string) string {
+	if value.Type().PkgPath() == "" {
+		return literal
+	}
+
+	return value.Type().String() + "(" + literal + ")"
+}
+`
+}
+
+func (jenny Runtime) generateHTTPTools() string {
+	return `package cog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Request describes an HTTP request sent by API clients.
+type Request struct {
+	Method      string
+	Path        string
+	Query       url.Values
+	Header      http.Header
+	Cookies     []*http.Cookie
+	Body        io.Reader
+	ContentType string
+}
+
+// APIError is returned by API clients when an operation does not succeed.
+type APIError struct {
+	StatusCode int
+	// Body holds the decoded body of the response if its type is
+	// documented by the API, or its raw content otherwise.
+	Body any
+}
+
+func (err *APIError) Error() string {
+	return fmt.Sprintf("unexpected response status: %d %s", err.StatusCode, http.StatusText(err.StatusCode))
+}
+
+// NewAPIError creates an APIError from an undocumented response.
+func NewAPIError(response *http.Response) *APIError {
+	body, _ := io.ReadAll(response.Body)
+
+	return &APIError{
+		StatusCode: response.StatusCode,
+		Body:       body,
+	}
+}
+
+// DoRequest sends the given request to an API exposed at baseURL.
+func DoRequest(ctx context.Context, client *http.Client, baseURL string, request Request) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, request.Method, strings.TrimSuffix(baseURL, "/")+request.Path, request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(request.Query) != 0 {
+		req.URL.RawQuery = request.Query.Encode()
+	}
+	for name, values := range request.Header {
+		for _, value := range values {
+			req.Header.Add(name, value)
+		}
+	}
+	for _, cookie := range request.Cookies {
+		req.AddCookie(cookie)
+	}
+	if request.ContentType != "" {
+		req.Header.Set("Content-Type", request.ContentType)
+	}
+
+	return client.Do(req)
+}
+
+// JSONBody encodes the given value as a JSON request body.
+func JSONBody(value any) (io.Reader, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(encoded), nil
+}
+
+// RawBody uses the given value as a request body.
+func RawBody(value string) (io.Reader, error) {
+	return strings.NewReader(value), nil
+}
+
+// DecodeJSON decodes the JSON body of a response into target.
+func DecodeJSON(response *http.Response, target any) error {
+	return json.NewDecoder(response.Body).Decode(target)
+}
+`
+}
+
+func hasOperations(context common.Context) bool {
+	for _, schema := range context.Schemas {
+		if len(schema.Operations) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
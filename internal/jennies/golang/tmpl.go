@@ -37,6 +37,9 @@ func init() {
 			"formatTypeNoBuilder": func(_ ast.Type) string {
 				panic("formatType() needs to be overridden by a jenny")
 			},
+			"formatValue": func(_ ast.Type, _ any) string {
+				panic("formatValue() needs to be overridden by a jenny")
+			},
 		}).
 		Funcs(map[string]any{
 			"formatPackageName": formatPackageName,
@@ -120,6 +120,11 @@ func (formatter *typeFormatter) formatStructBody(def ast.StructType) string {
 		buffer.WriteString("\t" + formatter.formatField(fieldDef))
 	}
 
+	if def.AdditionalProperties != nil {
+		buffer.WriteString("\t// AdditionalProperties holds the properties that are not declared as fields.\n")
+		buffer.WriteString(fmt.Sprintf("\tAdditionalProperties %s `json:\"-\"`\n", formatter.doFormatType(*def.AdditionalProperties, false)))
+	}
+
 	buffer.WriteString("}")
 
 	return buffer.String()
@@ -0,0 +1,239 @@
+package golang
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// formatValidateMethod generates a `Validate() error` method for the given
+// struct object. The generated method checks the constraints defined on the
+// object's fields, walking through nested structs, arrays, maps and references.
+// Errors are aggregated into a `cog.BuildErrors` value.
+func (jenny RawTypes) formatValidateMethod(def ast.Object) string {
+	var checks strings.Builder
+
+	for _, field := range def.Type.AsStruct().Fields {
+		checks.WriteString(jenny.validateType(field.Type, "resource."+tools.UpperCamelCase(field.Name), strconv.Quote(field.Name), 0))
+	}
+
+	objectName := tools.UpperCamelCase(def.Name)
+
+	if checks.Len() == 0 {
+		return fmt.Sprintf(`func (resource %s) Validate() error {
+	return nil
+}
+`, objectName)
+	}
+
+	return fmt.Sprintf(`func (resource %[1]s) Validate() error {
+	var errs %[2]s.BuildErrors
+%[3]s
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+`, objectName, jenny.typeFormatter.packageMapper("cog"), indent(checks.String()))
+}
+
+// validateType returns the statements needed to validate the value described
+// by `valueExpr`, of type `typeDef`.
+// `pathExpr` is a Go expression evaluating to the path of the value, used in
+// error messages.
+// An empty string is returned when there is nothing to validate.
+func (jenny RawTypes) validateType(typeDef ast.Type, valueExpr string, pathExpr string, depth int) string {
+	switch {
+	case typeDef.IsRef():
+		return jenny.validateRef(typeDef, valueExpr, pathExpr, depth)
+	case typeDef.IsScalar():
+		return jenny.validateScalar(typeDef, valueExpr, pathExpr)
+	case typeDef.IsArray():
+		indexVar := fmt.Sprintf("i%d", depth+1)
+		itemPath := concatPath(pathExpr, `"["`) + " + strconv.Itoa(" + indexVar + `) + "]"`
+
+		constraintChecks := jenny.validateConstraints(typeDef.AsArray().Constraints, valueExpr, pathExpr)
+		checks := jenny.validateType(typeDef.AsArray().ValueType, valueExpr+"["+indexVar+"]", itemPath, depth+1)
+		if checks == "" {
+			return constraintChecks
+		}
+
+		return constraintChecks + fmt.Sprintf("for %[1]s := range %[2]s {\n%[3]s}\n", indexVar, valueExpr, indent(checks))
+	case typeDef.IsMap():
+		keyVar := fmt.Sprintf("key%d", depth+1)
+		keyExpr := keyVar
+		if !typeDef.AsMap().IndexType.IsScalar() || typeDef.AsMap().IndexType.AsScalar().ScalarKind != ast.KindString {
+			keyExpr = "fmt.Sprint(" + keyVar + ")"
+		}
+		itemPath := concatPath(pathExpr, `"["`) + " + " + keyExpr + ` + "]"`
+
+		constraintChecks := jenny.validateConstraints(typeDef.AsMap().Constraints, valueExpr, pathExpr)
+		checks := jenny.validateType(typeDef.AsMap().ValueType, valueExpr+"["+keyVar+"]", itemPath, depth+1)
+		if checks == "" {
+			return constraintChecks
+		}
+
+		return constraintChecks + fmt.Sprintf("for %[1]s := range %[2]s {\n%[3]s}\n", keyVar, valueExpr, indent(checks))
+	case typeDef.IsStruct():
+		var checks strings.Builder
+		for _, field := range typeDef.AsStruct().Fields {
+			fieldPath := concatPath(pathExpr, strconv.Quote("."+field.Name))
+			checks.WriteString(jenny.validateType(field.Type, valueExpr+"."+tools.UpperCamelCase(field.Name), fieldPath, depth))
+		}
+
+		return nilGuard(typeDef.Nullable, valueExpr, checks.String())
+	}
+
+	// any, enums, composable slots, ...: nothing to validate
+	return ""
+}
+
+func (jenny RawTypes) validateRef(typeDef ast.Type, valueExpr string, pathExpr string, depth int) string {
+	ref := typeDef.AsRef()
+	referredObject, found := jenny.typeFormatter.context.LocateObject(ref.ReferredPkg, ref.ReferredType)
+	if !found {
+		return ""
+	}
+
+	switch {
+	case referredObject.Type.IsStruct():
+		if !jenny.objectNeedsValidation(referredObject) {
+			return ""
+		}
+
+		check := fmt.Sprintf(`if err := %[1]s.Validate(); err != nil {
+	errs = append(errs, %[2]s.MakeBuildErrors(%[3]s, err)...)
+}
+`, valueExpr, jenny.typeFormatter.packageMapper("cog"), pathExpr)
+
+		return nilGuard(typeDef.Nullable, valueExpr, check)
+	case referredObject.Type.IsScalar(), referredObject.Type.IsRef(), referredObject.Type.IsArray(), referredObject.Type.IsMap():
+		resolvedType := referredObject.Type
+		resolvedType.Nullable = typeDef.Nullable
+
+		return jenny.validateType(resolvedType, valueExpr, pathExpr, depth)
+	}
+
+	return ""
+}
+
+func (jenny RawTypes) validateScalar(typeDef ast.Type, valueExpr string, pathExpr string) string {
+	scalarType := typeDef.AsScalar()
+	if len(scalarType.Constraints) == 0 || scalarType.IsConcrete() {
+		return ""
+	}
+
+	value := valueExpr
+	if typeDef.Nullable {
+		value = "*" + valueExpr
+	}
+
+	return nilGuard(typeDef.Nullable, valueExpr, jenny.validateConstraints(scalarType.Constraints, value, pathExpr))
+}
+
+// validateConstraints returns a check for each of the given constraints,
+// applied to the value described by `valueExpr`.
+func (jenny RawTypes) validateConstraints(constraints []ast.TypeConstraint, valueExpr string, pathExpr string) string {
+	var checks strings.Builder
+	for _, constraint := range constraints {
+		condition, message := jenny.formatConstraint(constraint, valueExpr)
+
+		checks.WriteString(fmt.Sprintf(`if !(%[1]s) {
+	errs = append(errs, %[2]s.MakeBuildErrors(%[3]s, errors.New(%[4]s))...)
+}
+`, condition, jenny.typeFormatter.packageMapper("cog"), pathExpr, strconv.Quote(message)))
+	}
+
+	return checks.String()
+}
+
+// formatConstraint returns a condition that is true when the given value
+// satisfies the constraint, along with the error message to use when it doesn't.
+func (jenny RawTypes) formatConstraint(constraint ast.TypeConstraint, value string) (string, string) {
+	parameter := formatScalar(constraint.Args[0])
+
+	switch constraint.Op {
+	case ast.MinLengthOp:
+		return fmt.Sprintf("len([]rune(%s)) >= %s", value, parameter), fmt.Sprintf("length must be >= %s", parameter)
+	case ast.MaxLengthOp:
+		return fmt.Sprintf("len([]rune(%s)) <= %s", value, parameter), fmt.Sprintf("length must be <= %s", parameter)
+	case ast.MultipleOfOp:
+		return fmt.Sprintf("math.Mod(float64(%s), float64(%s)) == 0", value, parameter), fmt.Sprintf("must be a multiple of %s", parameter)
+	case ast.PatternOp:
+		return fmt.Sprintf("regexp.MustCompile(%s).MatchString(%s)", parameter, value), fmt.Sprintf("must match %s", parameter)
+	case ast.NotPatternOp:
+		return fmt.Sprintf("!regexp.MustCompile(%s).MatchString(%s)", parameter, value), fmt.Sprintf("must not match %s", parameter)
+	case ast.HasPrefixOp:
+		return fmt.Sprintf("strings.HasPrefix(%s, %s)", value, parameter), fmt.Sprintf("must start with %s", parameter)
+	case ast.MinItemsOp:
+		return fmt.Sprintf("len(%s) >= %s", value, parameter), fmt.Sprintf("must have at least %s items", parameter)
+	case ast.MaxItemsOp:
+		return fmt.Sprintf("len(%s) <= %s", value, parameter), fmt.Sprintf("must have at most %s items", parameter)
+	case ast.MinPropertiesOp:
+		return fmt.Sprintf("len(%s) >= %s", value, parameter), fmt.Sprintf("must have at least %s properties", parameter)
+	case ast.MaxPropertiesOp:
+		return fmt.Sprintf("len(%s) <= %s", value, parameter), fmt.Sprintf("must have at most %s properties", parameter)
+	case ast.UniqueItemsOp:
+		return fmt.Sprintf("%s.UniqueItems(%s)", jenny.typeFormatter.packageMapper("cog"), value), "must contain unique items"
+	}
+
+	return fmt.Sprintf("%s %s %s", value, constraint.Op, parameter), fmt.Sprintf("must be %s %s", constraint.Op, parameter)
+}
+
+// objectNeedsValidation tells whether the given struct object has anything
+// to validate, directly or via one of its fields.
+func (jenny RawTypes) objectNeedsValidation(object ast.Object) bool {
+	objectID := object.SelfRef.String()
+
+	if needsValidation, found := jenny.validatedObjects[objectID]; found {
+		return needsValidation
+	}
+
+	// recursive references: we can't know yet, so let's assume that it does.
+	jenny.validatedObjects[objectID] = true
+
+	needsValidation := false
+	for _, field := range object.Type.AsStruct().Fields {
+		if jenny.validateType(field.Type, "resource", `""`, 0) != "" {
+			needsValidation = true
+			break
+		}
+	}
+
+	jenny.validatedObjects[objectID] = needsValidation
+
+	return needsValidation
+}
+
+// concatPath appends a string literal to a path expression.
+func concatPath(pathExpr string, literal string) string {
+	// merge consecutive string literals: `"foo" + ".bar"` → `"foo.bar"`
+	if strings.HasSuffix(pathExpr, `"`) && !strings.HasSuffix(pathExpr, `\"`) {
+		return pathExpr[:len(pathExpr)-1] + literal[1:]
+	}
+
+	return pathExpr + " + " + literal
+}
+
+func nilGuard(nullable bool, valueExpr string, checks string) string {
+	if !nullable || checks == "" {
+		return checks
+	}
+
+	return fmt.Sprintf("if %[1]s != nil {\n%[2]s}\n", valueExpr, indent(checks))
+}
+
+func indent(code string) string {
+	lines := strings.Split(strings.TrimSuffix(code, "\n"), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = "\t" + line
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
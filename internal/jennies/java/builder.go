@@ -0,0 +1,493 @@
+package java
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/jennies/template"
+	"github.com/grafana/cog/internal/tools"
+	"github.com/huandu/xstrings"
+)
+
+type Builder struct {
+	config Config
+
+	context       common.Context
+	imports       *common.DirectImportMap
+	typeFormatter *typeFormatter
+}
+
+func (jenny *Builder) JennyName() string {
+	return "JavaBuilder"
+}
+
+func (jenny *Builder) Generate(context common.Context) (codejen.Files, error) {
+	files := codejen.Files{}
+	jenny.context = context
+
+	for _, builder := range context.Builders {
+		output, err := jenny.generateBuilder(context, builder)
+		if err != nil {
+			return nil, err
+		}
+
+		filename := filepath.Join(
+			strings.ToLower(builder.Package),
+			fmt.Sprintf("%sBuilder.java", tools.UpperCamelCase(builder.Name)),
+		)
+
+		files = append(files, *codejen.NewFile(filename, output, jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny *Builder) generateBuilder(context common.Context, builder ast.Builder) ([]byte, error) {
+	var buffer strings.Builder
+
+	jenny.imports = NewImportMap()
+	jenny.typeFormatter = builderTypeFormatter(context, func(pkg string, class string) string {
+		if jenny.imports.IsIdentical(pkg, builder.Package) {
+			return ""
+		}
+
+		return jenny.imports.Add(class, pkg)
+	})
+
+	objectName := jenny.typeFormatter.formatReference(builder.For.SelfRef)
+	buildObjectSignature := objectName
+	if builder.For.Type.ImplementsVariant() {
+		buildObjectSignature = jenny.typeFormatter.formatComposable(ast.ComposableSlotType{
+			Variant: ast.SchemaVariant(builder.For.Type.ImplementedVariant()),
+		})
+	}
+
+	defaults, err := jenny.genDefaultOptionsCalls(builder)
+	if err != nil {
+		return nil, err
+	}
+
+	err = templates.
+		Funcs(map[string]any{
+			"formatType":           jenny.typeFormatter.formatFieldType,
+			"formatTypeNoBuilder":  jenny.formatTypeNoBuilder,
+			"formatValue":          jenny.formatValue,
+			"formatPathAccess":     jenny.formatPathAccess,
+			"formatPathAssign":     jenny.formatPathAssignment,
+			"formatEnvelopeAssign": jenny.formatEnvelopeAssignment,
+			"newList":              jenny.newList,
+			"typeHasBuilder":       context.ResolveToBuilder,
+			"resolvesToComposableSlot": func(typeDef ast.Type) bool {
+				_, found := context.ResolveToComposableSlot(typeDef)
+				return found
+			},
+			"defaultValueForType": jenny.defaultValueForType,
+		}).
+		ExecuteTemplate(&buffer, "builders/builder.tmpl", BuilderTemplate{
+			Builder: template.Builder{
+				Package:              builder.Package,
+				BuilderSignatureType: buildObjectSignature,
+				BuilderName:          tools.UpperCamelCase(builder.Name),
+				ObjectName:           objectName,
+				Imports:              jenny.imports,
+				Comments:             builder.For.Comments,
+				Constructor:          jenny.generateConstructor(builder),
+				Properties:           builder.Properties,
+				Options:              tools.Map(builder.Options, jenny.generateOption),
+				Defaults:             defaults,
+			},
+			GenGettersAndSetters: jenny.config.GenGettersAndSetters,
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(buffer.String()), nil
+}
+
+func (jenny *Builder) generateConstructor(builder ast.Builder) template.Constructor {
+	var argsList []ast.Argument
+	var assignments []template.Assignment
+	for _, opt := range builder.Options {
+		if !opt.IsConstructorArg {
+			continue
+		}
+
+		// FIXME: this is assuming that there's only one argument for that option
+		argsList = append(argsList, opt.Args[0])
+		assignments = append(assignments, jenny.generateAssignment(opt.Assignments[0]))
+	}
+
+	for _, init := range builder.Initializations {
+		assignments = append(assignments, jenny.generateAssignment(init))
+	}
+
+	return template.Constructor{
+		Args:        argsList,
+		Assignments: assignments,
+	}
+}
+
+func (jenny *Builder) generateOption(def ast.Option) template.Option {
+	return template.Option{
+		Name:        tools.LowerCamelCase(def.Name),
+		Comments:    def.Comments,
+		Args:        def.Args,
+		Assignments: tools.Map(def.Assignments, jenny.generateAssignment),
+	}
+}
+
+func (jenny *Builder) genDefaultOptionsCalls(builder ast.Builder) ([]template.OptionCall, error) {
+	calls := make([]template.OptionCall, 0)
+	for _, opt := range builder.Options {
+		if opt.Default == nil {
+			continue
+		}
+
+		if len(opt.Args) == 0 || len(opt.Args) != len(opt.Default.ArgsValues) {
+			continue
+		}
+
+		args := make([]string, 0, len(opt.Args))
+		for i, arg := range opt.Args {
+			formatted, ok, err := jenny.formatDefaultArg(arg.Type, opt.Default.ArgsValues[i])
+			if err != nil {
+				return nil, fmt.Errorf("default value for option '%s': %w", opt.Name, err)
+			}
+			if !ok {
+				break
+			}
+
+			args = append(args, formatted)
+		}
+
+		// we could not represent every default value: skip the call entirely.
+		if len(args) != len(opt.Args) {
+			continue
+		}
+
+		calls = append(calls, template.OptionCall{
+			OptionName: tools.LowerCamelCase(opt.Name),
+			Args:       args,
+		})
+	}
+
+	return calls, nil
+}
+
+// formatDefaultArg formats a default value for an argument of the given type.
+// Struct defaults can only be represented if the struct has a builder, since
+// Java doesn't have struct literals.
+func (jenny *Builder) formatDefaultArg(argType ast.Type, value any) (string, bool, error) {
+	structValue, isStructValue := value.(map[string]any)
+	if !isStructValue {
+		formatted, err := jenny.formatValue(argType, value)
+		if err != nil {
+			return "", false, err
+		}
+
+		return formatted, true, nil
+	}
+
+	if !argType.IsRef() {
+		return "", false, nil
+	}
+
+	ref := argType.AsRef()
+	refBuilder, found := jenny.context.Builders.LocateByObject(ref.ReferredPkg, ref.ReferredType)
+	if !found {
+		return "", false, nil
+	}
+
+	builderClass := tools.UpperCamelCase(refBuilder.Name) + "Builder"
+	jenny.typeFormatter.packageMapper(refBuilder.Package, builderClass)
+
+	var buffer strings.Builder
+	buffer.WriteString(fmt.Sprintf("new %s()", builderClass))
+
+	for _, opt := range refBuilder.Options {
+		if len(opt.Args) != 1 || len(opt.Assignments) != 1 {
+			continue
+		}
+
+		fieldValue, found := structValue[opt.Assignments[0].Path.Last().Identifier]
+		if !found {
+			continue
+		}
+
+		formatted, ok, err := jenny.formatDefaultArg(opt.Args[0].Type, fieldValue)
+		if err != nil {
+			return "", false, err
+		}
+		if !ok {
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf(".%s(%s)", tools.LowerCamelCase(opt.Name), formatted))
+	}
+
+	return buffer.String(), true, nil
+}
+
+func (jenny *Builder) generateAssignment(assignment ast.Assignment) template.Assignment {
+	var initSafeGuards []string
+	for i, chunk := range assignment.Path {
+		if i == len(assignment.Path)-1 && assignment.Method != ast.AppendAssignment {
+			continue
+		}
+
+		chunkType := chunk.Type
+		if chunk.TypeHint != nil {
+			chunkType = *chunk.TypeHint
+		}
+
+		// in Java, every non-scalar field is initialized with null
+		maybeNull := chunkType.Nullable ||
+			chunkType.IsAnyOf(ast.KindMap, ast.KindArray, ast.KindRef)
+		if !maybeNull {
+			continue
+		}
+
+		safeguard := jenny.generatePathInitializationSafeGuard(assignment.Path[:i+1])
+		if safeguard != "" {
+			initSafeGuards = append(initSafeGuards, safeguard)
+		}
+	}
+
+	var constraints []template.Constraint
+	if assignment.Value.Argument != nil {
+		argName := escapeVarName(tools.LowerCamelCase(assignment.Value.Argument.Name))
+		constraints = jenny.constraints(argName, assignment.Constraints)
+	}
+
+	return template.Assignment{
+		Path:           assignment.Path,
+		InitSafeguards: initSafeGuards,
+		Constraints:    constraints,
+		Method:         assignment.Method,
+		Value:          assignment.Value,
+	}
+}
+
+func (jenny *Builder) generatePathInitializationSafeGuard(path ast.Path) string {
+	valueType := path.Last().Type
+	if path.Last().TypeHint != nil {
+		valueType = *path.Last().TypeHint
+	}
+
+	emptyValue := jenny.emptyValueForType(valueType)
+	if emptyValue == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(`if (%s == null) {
+    %s
+}`, jenny.formatPathAccess(path), jenny.formatPathAssignment(path, emptyValue))
+}
+
+func (jenny *Builder) emptyValueForType(typeDef ast.Type) string {
+	switch typeDef.Kind {
+	case ast.KindArray:
+		return jenny.newList()
+	case ast.KindMap:
+		jenny.typeFormatter.packageMapper("java.util", "HashMap")
+		return "new HashMap<>()"
+	case ast.KindRef:
+		ref := typeDef.AsRef()
+		referredObj, found := jenny.context.LocateObject(ref.ReferredPkg, ref.ReferredType)
+		if !found {
+			return ""
+		}
+
+		if referredObj.Type.IsMap() {
+			return jenny.emptyValueForType(referredObj.Type)
+		}
+
+		if !referredObj.Type.IsAnyOf(ast.KindStruct, ast.KindRef, ast.KindIntersection) {
+			return ""
+		}
+
+		return fmt.Sprintf("new %s()", jenny.typeFormatter.formatReference(ref))
+	default:
+		return ""
+	}
+}
+
+func (jenny *Builder) constraints(argumentName string, constraints []ast.TypeConstraint) []template.Constraint {
+	return tools.Map(constraints, func(constraint ast.TypeConstraint) template.Constraint {
+		return template.Constraint{
+			ArgName:   argumentName,
+			Op:        constraint.Op,
+			Parameter: formatRawValue(constraint.Args[0]),
+		}
+	})
+}
+
+// formatPathAccess returns an expression reading the value located at
+// the given path within the object being built.
+func (jenny *Builder) formatPathAccess(path ast.Path) string {
+	return jenny.doFormatPathAccess("this.internal", path, false)
+}
+
+func (jenny *Builder) doFormatPathAccess(root string, path ast.Path, castTrailingItem bool) string {
+	expr := root
+
+	for i, item := range path {
+		if jenny.config.GenGettersAndSetters {
+			expr += fmt.Sprintf(".get%s()", xstrings.ToCamelCase(item.Identifier))
+		} else {
+			expr += "." + escapeVarName(item.Identifier)
+		}
+
+		// fields of type "any" are typed as Object: we need to cast them to
+		// the type hinted by the path to access their own fields.
+		if !item.Type.IsAny() || item.TypeHint == nil {
+			continue
+		}
+		if i == len(path)-1 && !castTrailingItem {
+			continue
+		}
+
+		expr = fmt.Sprintf("((%s) %s)", jenny.formatTypeNoBuilder(*item.TypeHint), expr)
+	}
+
+	return expr
+}
+
+// formatPathAssignment returns a statement assigning the given value to the
+// given path within the object being built.
+func (jenny *Builder) formatPathAssignment(path ast.Path, value string) string {
+	return jenny.doFormatPathAssignment("this.internal", path, value)
+}
+
+// formatEnvelopeAssignment returns a statement assigning the given value to the
+// given path within an envelope variable.
+func (jenny *Builder) formatEnvelopeAssignment(envelopeVar string, path ast.Path, value string) string {
+	return jenny.doFormatPathAssignment(envelopeVar, path, value)
+}
+
+func (jenny *Builder) doFormatPathAssignment(root string, path ast.Path, value string) string {
+	parent := jenny.doFormatPathAccess(root, path[:len(path)-1], true)
+	field := path.Last().Identifier
+
+	if jenny.config.GenGettersAndSetters {
+		return fmt.Sprintf("%s.set%s(%s);", parent, xstrings.ToCamelCase(field), value)
+	}
+
+	return fmt.Sprintf("%s.%s = %s;", parent, escapeVarName(field), value)
+}
+
+func (jenny *Builder) formatTypeNoBuilder(typeDef ast.Type) string {
+	return jenny.typeFormatter.doFormatType(typeDef, false)
+}
+
+func (jenny *Builder) newList() string {
+	jenny.typeFormatter.packageMapper("java.util", "LinkedList")
+	return "new LinkedList<>()"
+}
+
+func (jenny *Builder) defaultValueForType(typeDef ast.Type) string {
+	if !typeDef.IsScalar() {
+		return "null"
+	}
+
+	switch typeDef.AsScalar().ScalarKind {
+	case ast.KindString:
+		return `""`
+	case ast.KindBool:
+		return "false"
+	case ast.KindAny, ast.KindNull:
+		return "null"
+	default:
+		return formatScalarValue(typeDef.AsScalar().ScalarKind, 0)
+	}
+}
+
+func (jenny *Builder) formatValue(destinationType ast.Type, value any) (string, error) {
+	if destinationType.IsRef() {
+		ref := destinationType.AsRef()
+		referredObj, found := jenny.context.LocateObject(ref.ReferredPkg, ref.ReferredType)
+		if found && referredObj.Type.IsEnum() {
+			return jenny.formatEnumValue(ref, referredObj.Type.AsEnum(), value)
+		}
+		if found && referredObj.Type.IsScalar() {
+			destinationType = referredObj.Type
+		}
+	}
+
+	if items, ok := value.([]any); ok {
+		valueType := ast.Any()
+		if destinationType.IsArray() {
+			valueType = destinationType.AsArray().ValueType
+		}
+
+		formattedItems := make([]string, 0, len(items))
+		for _, item := range items {
+			formatted, err := jenny.formatValue(valueType, item)
+			if err != nil {
+				return "", err
+			}
+
+			formattedItems = append(formattedItems, formatted)
+		}
+
+		jenny.typeFormatter.packageMapper("java.util", "List")
+
+		return fmt.Sprintf("List.of(%s)", strings.Join(formattedItems, ", ")), nil
+	}
+
+	if destinationType.IsScalar() {
+		return formatScalarValue(destinationType.AsScalar().ScalarKind, value), nil
+	}
+
+	return formatRawValue(value), nil
+}
+
+func (jenny *Builder) formatEnumValue(ref ast.RefType, enum ast.EnumType, value any) (string, error) {
+	enumName := jenny.typeFormatter.formatReference(ref)
+
+	for _, enumValue := range enum.Values {
+		if fmt.Sprintf("%v", enumValue.Value) == fmt.Sprintf("%v", value) {
+			return fmt.Sprintf("%s.%s", enumName, tools.UpperSnakeCase(enumValue.Name)), nil
+		}
+	}
+
+	return "", fmt.Errorf("value '%v' is not a member of enum '%s'", value, ref.String())
+}
+
+func formatScalarValue(kind ast.ScalarKind, value any) string {
+	if value == nil {
+		return "null"
+	}
+
+	switch kind {
+	case ast.KindInt64, ast.KindUint64:
+		return fmt.Sprintf("%vL", value)
+	case ast.KindFloat32:
+		return fmt.Sprintf("%vf", value)
+	case ast.KindFloat64:
+		return fmt.Sprintf("%vd", value)
+	case ast.KindInt16, ast.KindUint16:
+		return fmt.Sprintf("(short) %v", value)
+	case ast.KindInt8, ast.KindUint8, ast.KindBytes:
+		return fmt.Sprintf("(byte) %v", value)
+	}
+
+	return formatRawValue(value)
+}
+
+func formatRawValue(value any) string {
+	if value == nil {
+		return "null"
+	}
+
+	if str, ok := value.(string); ok {
+		return fmt.Sprintf("%#v", str)
+	}
+
+	return fmt.Sprintf("%v", value)
+}
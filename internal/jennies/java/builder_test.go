@@ -0,0 +1,69 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder_Generate(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite{
+		TestDataRoot: "../../../testdata/jennies/builders",
+		Name:         "JavaBuilder",
+		Skip: map[string]string{
+			"builder_delegation_in_disjunction": "disjunctions are eliminated with compiler passes",
+		},
+	}
+
+	jenny := Builder{}
+
+	test.Run(t, func(tc *testutils.Test) {
+		req := require.New(tc)
+
+		files, err := jenny.Generate(tc.BuildersContext())
+		req.NoError(err)
+
+		tc.WriteFiles(files)
+	})
+}
+
+func TestBuilder_Generate_rejectsDefaultNotInEnum(t *testing.T) {
+	req := require.New(t)
+
+	modeField := ast.NewStructField("mode", ast.NewRef("sandbox", "Mode"))
+	schema := &ast.Schema{
+		Package: "sandbox",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("sandbox", "Mode", ast.NewEnum([]ast.EnumValue{
+				{Type: ast.String(), Name: "auto", Value: "auto"},
+				{Type: ast.String(), Name: "manual", Value: "manual"},
+			})),
+			ast.NewObject("sandbox", "SomeStruct", ast.NewStruct(modeField)),
+		),
+	}
+
+	builder := ast.Builder{
+		Schema:  schema,
+		For:     schema.Objects.Get("SomeStruct"),
+		Package: "sandbox",
+		Name:    "SomeStruct",
+		Options: []ast.Option{
+			{
+				Name:        "mode",
+				Args:        []ast.Argument{{Name: "mode", Type: modeField.Type}},
+				Assignments: []ast.Assignment{ast.FieldAssignment(modeField)},
+				Default:     &ast.OptionDefault{ArgsValues: []any{"unknown"}},
+			},
+		},
+	}
+
+	jenny := Builder{}
+	_, err := jenny.Generate(common.Context{
+		Schemas:  ast.Schemas{schema},
+		Builders: ast.Builders{builder},
+	})
+	req.ErrorContains(err, "value 'unknown' is not a member of enum 'sandbox.Mode'")
+}
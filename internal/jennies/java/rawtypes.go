@@ -175,12 +175,28 @@ func (jenny RawTypes) formatInnerStruct(pkg string, name string, comments []stri
 		Name:                 tools.UpperCamelCase(name),
 		Fields:               fields,
 		InnerClasses:         nestedStructs,
+		AdditionalProperties: jenny.formatAdditionalProperties(def),
 		GenGettersAndSetters: jenny.config.GenGettersAndSetters,
 		Comments:             comments,
 		Variant:              tools.UpperCamelCase(variant),
 	}
 }
 
+// formatAdditionalProperties returns the type of the properties that are not
+// declared as fields. They are collected in a map by Jackson.
+func (jenny RawTypes) formatAdditionalProperties(def ast.StructType) string {
+	if def.AdditionalProperties == nil {
+		return ""
+	}
+
+	jenny.imports.Add("JsonAnyGetter", "com.fasterxml.jackson.annotation")
+	jenny.imports.Add("JsonAnySetter", "com.fasterxml.jackson.annotation")
+	jenny.imports.Add("HashMap", "java.util")
+	jenny.imports.Add("Map", "java.util")
+
+	return jenny.typeFormatter.doFormatType(def.AdditionalProperties.AsMap().ValueType, false)
+}
+
 func (jenny RawTypes) formatScalars(pkg string, scalars map[string]ast.ScalarType) ([]byte, error) {
 	var buffer strings.Builder
 
@@ -21,16 +21,26 @@ func (jenny Runtime) Generate(_ common.Context) (codejen.Files, error) {
 		return nil, err
 	}
 
+	builder, err := jenny.render("runtime/builder.tmpl", nil)
+	if err != nil {
+		return nil, err
+	}
+
 	return codejen.Files{
 		*codejen.NewFile("cog/variants/Dataquery.java", variants, jenny),
+		*codejen.NewFile("cog/Builder.java", builder, jenny),
 	}, nil
 }
 
 func (jenny Runtime) renderDataQueryVariant(variant string) ([]byte, error) {
-	buf := bytes.Buffer{}
-	if err := templates.ExecuteTemplate(&buf, "runtime/variants.tmpl", map[string]any{
+	return jenny.render("runtime/variants.tmpl", map[string]any{
 		"Variant": variant,
-	}); err != nil {
+	})
+}
+
+func (jenny Runtime) render(templateFile string, data map[string]any) ([]byte, error) {
+	buf := bytes.Buffer{}
+	if err := templates.ExecuteTemplate(&buf, templateFile, data); err != nil {
 		return nil, fmt.Errorf("failed executing template: %w", err)
 	}
 
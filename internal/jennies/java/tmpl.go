@@ -6,13 +6,15 @@ import (
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
+	"github.com/grafana/cog/internal/ast"
 	cogtemplate "github.com/grafana/cog/internal/jennies/template"
+	"github.com/grafana/cog/internal/tools"
 )
 
 //nolint:gochecknoglobals
 var templates *template.Template
 
-//go:embed templates/runtime/*.tmpl templates/types/*.tmpl
+//go:embed templates/runtime/*.tmpl templates/types/*.tmpl templates/builders/*.tmpl templates/builders/veneers/*.tmpl
 //nolint:gochecknoglobals
 var templatesFS embed.FS
 
@@ -34,9 +36,43 @@ func functions() template.FuncMap {
 			return len(values)-1 == index
 		},
 		"escapeVar": escapeVarName,
+		"formatArgName": func(name string) string {
+			return escapeVarName(tools.LowerCamelCase(name))
+		},
+		// placeholder functions, will be overridden by jennies
+		"formatType": func(_ ast.Type) string {
+			panic("formatType() needs to be overridden by a jenny")
+		},
+		"formatTypeNoBuilder": func(_ ast.Type) string {
+			panic("formatTypeNoBuilder() needs to be overridden by a jenny")
+		},
+		"formatValue": func(_ ast.Type, _ any) (string, error) {
+			panic("formatValue() needs to be overridden by a jenny")
+		},
+		"formatPathAccess": func(_ ast.Path) string {
+			panic("formatPathAccess() needs to be overridden by a jenny")
+		},
+		"formatPathAssign": func(_ ast.Path, _ string) string {
+			panic("formatPathAssign() needs to be overridden by a jenny")
+		},
+		"formatEnvelopeAssign": func(_ string, _ ast.Path, _ string) string {
+			panic("formatEnvelopeAssign() needs to be overridden by a jenny")
+		},
+		"newList": func() string {
+			panic("newList() needs to be overridden by a jenny")
+		},
+		"defaultValueForType": func(_ ast.Type) string {
+			panic("defaultValueForType() needs to be overridden by a jenny")
+		},
 	}
 }
 
+type BuilderTemplate struct {
+	cogtemplate.Builder
+
+	GenGettersAndSetters bool
+}
+
 type EnumTemplate struct {
 	Package  string
 	Name     string
@@ -60,6 +96,10 @@ type ClassTemplate struct {
 	Fields       []Field
 	InnerClasses []ClassTemplate
 
+	// Type of the properties that are not declared as fields, if the class
+	// accepts any.
+	AdditionalProperties string
+
 	GenGettersAndSetters bool
 	Variant              string
 }
@@ -10,28 +10,52 @@ import (
 
 type typeFormatter struct {
 	packageMapper func(pkg string, class string) string
-	context       common.Context
+
+	forBuilder bool
+	context    common.Context
 }
 
 func createFormatter(ctx common.Context) *typeFormatter {
 	return &typeFormatter{context: ctx}
 }
 
+func builderTypeFormatter(ctx common.Context, packageMapper func(pkg string, class string) string) *typeFormatter {
+	return &typeFormatter{
+		packageMapper: packageMapper,
+		forBuilder:    true,
+		context:       ctx,
+	}
+}
+
 func (tf *typeFormatter) withPackageMapper(packageMapper func(pkg string, class string) string) *typeFormatter {
 	tf.packageMapper = packageMapper
 	return tf
 }
 
 func (tf *typeFormatter) formatFieldType(def ast.Type) string {
+	return tf.doFormatType(def, tf.forBuilder)
+}
+
+func (tf *typeFormatter) doFormatType(def ast.Type, resolveBuilders bool) string {
 	switch def.Kind {
 	case ast.KindScalar:
 		return formatScalarType(def.AsScalar())
 	case ast.KindRef:
-		return tf.formatReference(def.AsRef())
+		formatted := tf.formatReference(def.AsRef())
+		if resolveBuilders && tf.context.ResolveToBuilder(def) {
+			return fmt.Sprintf("cog.Builder<%s>", formatted)
+		}
+
+		return formatted
 	case ast.KindArray:
-		return tf.formatArray(def.AsArray())
+		return tf.formatArray(def.AsArray(), resolveBuilders)
 	case ast.KindComposableSlot:
-		return tf.formatComposable(def.AsComposableSlot())
+		formatted := tf.formatComposable(def.AsComposableSlot())
+		if resolveBuilders {
+			return fmt.Sprintf("cog.Builder<%s>", formatted)
+		}
+
+		return formatted
 	case ast.KindMap:
 		return tf.formatMap(def.AsMap())
 	case ast.KindStruct:
@@ -55,25 +79,26 @@ func (tf *typeFormatter) formatReference(def ast.RefType) string {
 	}
 }
 
-func (tf *typeFormatter) formatArray(def ast.ArrayType) string {
+func (tf *typeFormatter) formatArray(def ast.ArrayType, resolveBuilders bool) string {
 	tf.packageMapper("java.util", "List")
-	return fmt.Sprintf("List<%s>", tf.formatFieldType(def.ValueType))
+	return fmt.Sprintf("List<%s>", tf.doFormatType(def.ValueType, resolveBuilders))
 }
 
 func (tf *typeFormatter) formatMap(def ast.MapType) string {
+	tf.packageMapper("java.util", "Map")
+
 	mapType := "unknown"
 	switch def.ValueType.Kind {
 	case ast.KindRef:
 		ref := def.ValueType.AsRef()
 		tf.packageMapper(ref.ReferredPkg, ref.ReferredType)
-		tf.packageMapper("java.util", "Map")
 		mapType = ref.ReferredType
 	case ast.KindScalar:
 		mapType = formatScalarType(def.ValueType.AsScalar())
 	case ast.KindMap:
 		mapType = tf.formatMap(def.ValueType.AsMap())
 	case ast.KindArray:
-		mapType = tf.formatArray(def.ValueType.AsArray())
+		mapType = tf.formatArray(def.ValueType.AsArray(), false)
 	}
 
 	return fmt.Sprintf("Map<String, %s>", mapType)
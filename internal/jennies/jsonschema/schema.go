@@ -3,6 +3,7 @@ package jsonschema
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/grafana/codejen"
@@ -162,14 +163,48 @@ func (jenny Schema) formatScalar(typeDef ast.Type) Definition {
 }
 
 func (jenny Schema) addStringConstraints(definition *orderedmap.Map[string, any], typeDef ast.Type) {
+	var patterns []any
+	var notPatterns []any
+
 	for _, constraint := range typeDef.AsScalar().Constraints {
 		switch constraint.Op {
 		case ast.MinLengthOp:
 			definition.Set("minLength", constraint.Args[0])
 		case ast.MaxLengthOp:
 			definition.Set("maxLength", constraint.Args[0])
+		case ast.PatternOp:
+			patterns = append(patterns, constraint.Args[0])
+		case ast.HasPrefixOp:
+			patterns = append(patterns, "^"+regexp.QuoteMeta(fmt.Sprintf("%v", constraint.Args[0])))
+		case ast.NotPatternOp:
+			notPatterns = append(notPatterns, constraint.Args[0])
 		}
 	}
+
+	// A schema holds a single pattern: additional ones are expressed with allOf
+	if len(patterns) != 0 {
+		definition.Set("pattern", patterns[0])
+	}
+	if len(patterns) > 1 {
+		definition.Set("allOf", tools.Map(patterns[1:], jenny.patternDefinition))
+	}
+
+	if len(notPatterns) == 1 {
+		definition.Set("not", jenny.patternDefinition(notPatterns[0]))
+	}
+	if len(notPatterns) > 1 {
+		not := orderedmap.New[string, any]()
+		not.Set("anyOf", tools.Map(notPatterns, jenny.patternDefinition))
+
+		definition.Set("not", not)
+	}
+}
+
+func (jenny Schema) patternDefinition(pattern any) Definition {
+	definition := orderedmap.New[string, any]()
+	definition.Set("pattern", pattern)
+
+	return definition
 }
 
 func (jenny Schema) addNumberConstraints(definition *orderedmap.Map[string, any], typeDef ast.Type) {
@@ -193,7 +228,7 @@ func (jenny Schema) formatStruct(typeDef ast.Type) Definition {
 	definition := orderedmap.New[string, any]()
 
 	definition.Set("type", "object")
-	definition.Set("additionalProperties", false)
+	jenny.addAdditionalProperties(definition, typeDef.AsStruct())
 
 	properties := orderedmap.New[string, any]()
 	var required []string
@@ -226,6 +261,36 @@ func (jenny Schema) formatStruct(typeDef ast.Type) Definition {
 	return definition
 }
 
+// addAdditionalProperties describes the properties accepted by a struct
+// beside its fields: either all of them, or only the ones matching a pattern.
+func (jenny Schema) addAdditionalProperties(definition Definition, structType ast.StructType) {
+	if structType.AdditionalProperties == nil {
+		definition.Set("additionalProperties", false)
+		return
+	}
+
+	mapType := structType.AdditionalProperties.AsMap()
+	valueDef := jenny.formatType(mapType.ValueType)
+
+	if mapType.IndexType.IsScalar() {
+		for _, constraint := range mapType.IndexType.AsScalar().Constraints {
+			if constraint.Op != ast.PatternOp {
+				continue
+			}
+
+			patternProperties := orderedmap.New[string, any]()
+			patternProperties.Set(fmt.Sprintf("%v", constraint.Args[0]), valueDef)
+
+			definition.Set("additionalProperties", false)
+			definition.Set("patternProperties", patternProperties)
+
+			return
+		}
+	}
+
+	definition.Set("additionalProperties", valueDef)
+}
+
 func (jenny Schema) formatRef(typeDef ast.Type) Definition {
 	definition := orderedmap.New[string, any]()
 	ref := typeDef.AsRef()
@@ -266,6 +331,17 @@ func (jenny Schema) formatArray(typeDef ast.Type) Definition {
 	definition.Set("type", "array")
 	definition.Set("items", jenny.formatType(typeDef.AsArray().ValueType))
 
+	for _, constraint := range typeDef.AsArray().Constraints {
+		switch constraint.Op {
+		case ast.MinItemsOp:
+			definition.Set("minItems", constraint.Args[0])
+		case ast.MaxItemsOp:
+			definition.Set("maxItems", constraint.Args[0])
+		case ast.UniqueItemsOp:
+			definition.Set("uniqueItems", constraint.Args[0])
+		}
+	}
+
 	return definition
 }
 
@@ -275,6 +351,15 @@ func (jenny Schema) formatMap(typeDef ast.Type) Definition {
 	definition.Set("type", "object")
 	definition.Set("additionalProperties", jenny.formatType(typeDef.AsMap().ValueType))
 
+	for _, constraint := range typeDef.AsMap().Constraints {
+		switch constraint.Op {
+		case ast.MinPropertiesOp:
+			definition.Set("minProperties", constraint.Args[0])
+		case ast.MaxPropertiesOp:
+			definition.Set("maxProperties", constraint.Args[0])
+		}
+	}
+
 	return definition
 }
 
@@ -0,0 +1,147 @@
+// Package plugin implements language targets backed by external executables,
+// allowing teams to generate code for languages that cog doesn't support
+// without forking it.
+//
+// For each generation, cog starts the plugin's executable and writes a
+// Request, encoded as JSON, on its standard input. It contains the schemas
+// processed by the compiler passes and the builders on which veneers were
+// applied, described with the same JSON representation as cog's IR.
+//
+// The plugin is expected to write a Response, encoded as JSON, on its
+// standard output. Anything written on its standard error is forwarded to
+// cog's. Exiting with a non-zero status or setting Response.Error aborts the
+// generation.
+package plugin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/spf13/cobra"
+)
+
+// ProtocolVersion is incremented whenever Request or Response change in a
+// backward-incompatible way.
+const ProtocolVersion = 1
+
+// Request is sent by cog to plugins.
+type Request struct {
+	ProtocolVersion int
+	// Language is the name under which the plugin was registered.
+	Language string
+	Config   common.Config
+	Schemas  ast.Schemas
+	Builders ast.Builders
+}
+
+// Response is sent back by plugins to cog.
+type Response struct {
+	Files []File
+	// Error, if not empty, reports a failure of the plugin.
+	Error string `json:",omitempty"`
+}
+
+// File describes a generated file. Its path is relative to the output
+// directory of the language.
+type File struct {
+	Path    string
+	Content string
+}
+
+type Language struct {
+	ref        string
+	executable string
+}
+
+// New creates a language target delegating the generation of code to
+// the given executable.
+func New(ref string, executable string) *Language {
+	return &Language{
+		ref:        ref,
+		executable: executable,
+	}
+}
+
+func (language *Language) RegisterCliFlags(_ *cobra.Command) {
+}
+
+func (language *Language) Jennies(globalConfig common.Config) *codejen.JennyList[common.Context] {
+	jenny := codejen.JennyListWithNamer[common.Context](func(_ common.Context) string {
+		return language.ref
+	})
+	jenny.AppendOneToMany(Executable{
+		Language:   language.ref,
+		Executable: language.executable,
+		Config:     globalConfig,
+	})
+
+	return jenny
+}
+
+func (language *Language) CompilerPasses() compiler.Passes {
+	return nil
+}
+
+// Executable is a jenny running a plugin's executable.
+type Executable struct {
+	Language   string
+	Executable string
+	Config     common.Config
+}
+
+func (jenny Executable) JennyName() string {
+	return "Plugin"
+}
+
+func (jenny Executable) Generate(context common.Context) (codejen.Files, error) {
+	request, err := json.Marshal(Request{
+		ProtocolVersion: ProtocolVersion,
+		Language:        jenny.Language,
+		Config:          jenny.Config,
+		Schemas:         context.Schemas,
+		Builders:        context.Builders,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stdout := &bytes.Buffer{}
+
+	cmd := exec.Command(jenny.Executable)
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("plugin '%s': %w", jenny.Language, err)
+	}
+
+	response := Response{}
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("plugin '%s': invalid response: %w", jenny.Language, err)
+	}
+
+	if response.Error != "" {
+		return nil, fmt.Errorf("plugin '%s': %s", jenny.Language, response.Error)
+	}
+
+	files := make(codejen.Files, 0, len(response.Files))
+	for _, file := range response.Files {
+		// plugins are only allowed to write within their output directory
+		if !filepath.IsLocal(file.Path) {
+			return nil, fmt.Errorf("plugin '%s': invalid path '%s'", jenny.Language, file.Path)
+		}
+
+		files = append(files, *codejen.NewFile(file.Path, []byte(file.Content), jenny))
+	}
+
+	return files, nil
+}
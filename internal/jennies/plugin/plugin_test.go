@@ -0,0 +1,104 @@
+package plugin
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/stretchr/testify/require"
+)
+
+// the test binary doubles as a fake plugin, behaving as configured by this
+// environment variable.
+const fakePluginModeEnv = "COG_FAKE_PLUGIN_MODE"
+
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(fakePluginModeEnv); mode != "" {
+		runFakePlugin(mode)
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+func runFakePlugin(mode string) {
+	request := Request{}
+	if err := json.NewDecoder(os.Stdin).Decode(&request); err != nil {
+		os.Exit(2)
+	}
+
+	response := Response{}
+
+	switch mode {
+	case "error":
+		response.Error = "something went wrong"
+	case "escape":
+		response.Files = []File{{Path: "../outside.txt"}}
+	case "exit":
+		os.Exit(1)
+	default:
+		for _, schema := range request.Schemas {
+			var objects []string
+			schema.Objects.Iterate(func(name string, _ ast.Object) {
+				objects = append(objects, name)
+			})
+
+			response.Files = append(response.Files, File{
+				Path:    request.Language + "/" + schema.Package + ".txt",
+				Content: strings.Join(objects, "\n"),
+			})
+		}
+	}
+
+	_ = json.NewEncoder(os.Stdout).Encode(response)
+}
+
+func runPlugin(t *testing.T, mode string) (map[string]string, error) {
+	t.Helper()
+
+	t.Setenv(fakePluginModeEnv, mode)
+
+	schema := ast.NewSchema("sandbox", ast.SchemaMeta{})
+	schema.AddObject(ast.NewObject("sandbox", "Foo", ast.String()))
+	schema.AddObject(ast.NewObject("sandbox", "Bar", ast.String()))
+
+	jennies := New("fake", os.Args[0]).Jennies(common.Config{Types: true})
+	fs, err := jennies.GenerateFS(common.Context{Schemas: ast.Schemas{schema}})
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(map[string]string)
+	for _, file := range fs.AsFiles() {
+		files[file.RelativePath] = string(file.Data)
+	}
+
+	return files, nil
+}
+
+func TestExecutable_Generate(t *testing.T) {
+	req := require.New(t)
+
+	files, err := runPlugin(t, "ok")
+	req.NoError(err)
+
+	req.Equal(map[string]string{"fake/sandbox.txt": "Foo\nBar"}, files)
+}
+
+func TestExecutable_Generate_withErrorResponse(t *testing.T) {
+	_, err := runPlugin(t, "error")
+	require.ErrorContains(t, err, "plugin 'fake': something went wrong")
+}
+
+func TestExecutable_Generate_withFailingExecutable(t *testing.T) {
+	_, err := runPlugin(t, "exit")
+	require.ErrorContains(t, err, "plugin 'fake': exit status 1")
+}
+
+func TestExecutable_Generate_withPathOutsideOfOutputDirectory(t *testing.T) {
+	_, err := runPlugin(t, "escape")
+	require.ErrorContains(t, err, "invalid path '../outside.txt'")
+}
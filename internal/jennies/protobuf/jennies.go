@@ -0,0 +1,63 @@
+package protobuf
+
+import (
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/spf13/cobra"
+)
+
+const LanguageRef = "protobuf"
+
+type Config struct {
+	Debug bool
+
+	// PackageRoot is used as a prefix for the name of generated protobuf packages.
+	// Ex: with "grafana.sdk", types from the "dashboard" package will be
+	// generated in the "grafana.sdk.dashboard" protobuf package.
+	PackageRoot string
+}
+
+func (config Config) MergeWithGlobal(global common.Config) Config {
+	newConfig := config
+	newConfig.Debug = global.Debug
+
+	return newConfig
+}
+
+type Language struct {
+	config Config
+}
+
+func New() *Language {
+	return &Language{
+		config: Config{},
+	}
+}
+
+func (language *Language) RegisterCliFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&language.config.PackageRoot, "protobuf-package-root", "", "Prefix used for the name of generated protobuf packages.")
+}
+
+func (language *Language) Jennies(globalConfig common.Config) *codejen.JennyList[common.Context] {
+	config := language.config.MergeWithGlobal(globalConfig)
+	jenny := codejen.JennyListWithNamer[common.Context](func(_ common.Context) string {
+		return LanguageRef
+	})
+
+	jenny.AppendOneToMany(
+		common.If[common.Context](globalConfig.Types, RawTypes{Config: config}),
+	)
+	jenny.AddPostprocessors(common.GeneratedCommentHeader(globalConfig))
+
+	return jenny
+}
+
+func (language *Language) CompilerPasses() compiler.Passes {
+	return compiler.Passes{
+		&compiler.AnonymousEnumToExplicitType{},
+		&compiler.AnonymousStructsToNamed{},
+		&compiler.FlattenDisjunctions{},
+		&compiler.DisjunctionWithNullToOptional{},
+	}
+}
@@ -0,0 +1,584 @@
+package protobuf
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/orderedmap"
+	"github.com/grafana/cog/internal/tools"
+)
+
+const (
+	structImport   = "google/protobuf/struct.proto"
+	wrappersImport = "google/protobuf/wrappers.proto"
+)
+
+type RawTypes struct {
+	Config Config
+
+	context common.Context
+	schema  *ast.Schema
+	imports map[string]struct{}
+
+	// Messages generated for disjunctions that can not be represented
+	// by a `oneof` field, indexed by name.
+	disjunctionMessages *orderedmap.Map[string, ast.Type]
+}
+
+func (jenny RawTypes) JennyName() string {
+	return "ProtobufRawTypes"
+}
+
+func (jenny RawTypes) Generate(context common.Context) (codejen.Files, error) {
+	files := make(codejen.Files, 0, len(context.Schemas))
+
+	for _, schema := range context.Schemas {
+		output, err := jenny.generateSchema(context, schema)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] %w", schema.Package, err)
+		}
+
+		files = append(files, *codejen.NewFile(protoFilename(schema.Package), output, jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny RawTypes) generateSchema(context common.Context, schema *ast.Schema) ([]byte, error) {
+	var buffer strings.Builder
+	var err error
+
+	jenny.context = context
+	jenny.schema = schema
+	jenny.imports = make(map[string]struct{})
+	jenny.disjunctionMessages = orderedmap.New[string, ast.Type]()
+
+	schema.Objects.Iterate(func(_ string, object ast.Object) {
+		if err != nil {
+			return
+		}
+
+		objectOutput, innerErr := jenny.formatObject(object)
+		if innerErr != nil {
+			err = fmt.Errorf("%s: %w", object.Name, innerErr)
+			return
+		}
+
+		if objectOutput == "" {
+			return
+		}
+
+		buffer.WriteString(objectOutput)
+		buffer.WriteString("\n")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// rendering a disjunction message can reference other disjunctions
+	// and generate more messages.
+	for i := 0; i < jenny.disjunctionMessages.Len(); i++ {
+		disjunction := jenny.disjunctionMessages.At(i)
+
+		message, err := jenny.formatOneofMessage(disjunctionMessageName(disjunction), disjunction)
+		if err != nil {
+			return nil, err
+		}
+
+		buffer.WriteString(message)
+		buffer.WriteString("\n")
+	}
+
+	var header strings.Builder
+	header.WriteString("syntax = \"proto3\";\n\n")
+	header.WriteString(fmt.Sprintf("package %s;\n\n", jenny.protoPackage(schema.Package)))
+
+	imports := make([]string, 0, len(jenny.imports))
+	for importPath := range jenny.imports {
+		imports = append(imports, importPath)
+	}
+	sort.Strings(imports)
+
+	for _, importPath := range imports {
+		header.WriteString(fmt.Sprintf("import \"%s\";\n", importPath))
+	}
+	if len(imports) != 0 {
+		header.WriteString("\n")
+	}
+
+	return []byte(header.String() + buffer.String()), nil
+}
+
+func (jenny RawTypes) formatObject(object ast.Object) (string, error) {
+	var buffer strings.Builder
+	var err error
+	var definition string
+
+	objectName := tools.UpperCamelCase(object.Name)
+
+	switch object.Type.Kind {
+	case ast.KindStruct:
+		definition, err = jenny.formatMessage(objectName, object.Type.AsStruct())
+	case ast.KindEnum:
+		definition = jenny.formatEnum(objectName, object.Type.AsEnum())
+	case ast.KindDisjunction:
+		definition, err = jenny.formatOneofMessage(objectName, object.Type)
+	case ast.KindIntersection:
+		definition, err = jenny.formatMessage(objectName, ast.StructType{Fields: jenny.intersectionFields(object.Type.AsIntersection())})
+	default:
+		// Scalars, arrays, maps and references can not be aliased in
+		// protobuf: they are resolved wherever they are used.
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	comments := object.Comments
+	if jenny.Config.Debug {
+		passesTrail := tools.Map(object.PassesTrail, func(trail string) string {
+			return fmt.Sprintf("Modified by compiler pass '%s'", trail)
+		})
+		comments = append(comments, passesTrail...)
+	}
+
+	for _, commentLine := range comments {
+		buffer.WriteString(fmt.Sprintf("// %s\n", commentLine))
+	}
+
+	buffer.WriteString(definition)
+
+	return buffer.String(), nil
+}
+
+func (jenny RawTypes) formatMessage(name string, def ast.StructType) (string, error) {
+	var buffer strings.Builder
+
+	buffer.WriteString(fmt.Sprintf("message %s {\n", name))
+
+	fieldNumber := 1
+	for _, field := range def.Fields {
+		for _, commentLine := range field.Comments {
+			buffer.WriteString(fmt.Sprintf("  // %s\n", commentLine))
+		}
+
+		fieldName := formatFieldName(field.Name)
+		fieldType := jenny.resolveAlias(field.Type)
+
+		if fieldType.IsDisjunction() {
+			branches, err := jenny.formatOneofBranches(fieldName+"_", fieldType, &fieldNumber)
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", field.Name, err)
+			}
+
+			buffer.WriteString(fmt.Sprintf("  oneof %s {\n", fieldName))
+			buffer.WriteString(branches)
+			buffer.WriteString("  }\n")
+			continue
+		}
+
+		label, typeName, err := jenny.formatFieldType(fieldType, field.Required)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", field.Name, err)
+		}
+
+		if label != "" {
+			label += " "
+		}
+
+		options := ""
+		if jsonName(fieldName) != field.Name {
+			options = fmt.Sprintf(" [json_name = \"%s\"]", field.Name)
+		}
+
+		buffer.WriteString(fmt.Sprintf("  %s%s %s = %d%s;\n", label, typeName, fieldName, fieldNumber, options))
+		fieldNumber++
+	}
+
+	// protobuf messages are closed: properties that are not declared as
+	// fields are kept in a map.
+	if def.AdditionalProperties != nil {
+		_, typeName, err := jenny.formatFieldType(*def.AdditionalProperties, true)
+		if err != nil {
+			return "", fmt.Errorf("additional properties: %w", err)
+		}
+
+		buffer.WriteString("  // Properties that are not declared as fields.\n")
+		buffer.WriteString(fmt.Sprintf("  %s additional_properties = %d;\n", typeName, fieldNumber))
+	}
+
+	buffer.WriteString("}\n")
+
+	return buffer.String(), nil
+}
+
+func (jenny RawTypes) formatOneofMessage(name string, disjunction ast.Type) (string, error) {
+	fieldNumber := 1
+
+	branches, err := jenny.formatOneofBranches("", disjunction, &fieldNumber)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf(`message %[1]s {
+  oneof value {
+%[2]s  }
+}
+`, name, branches), nil
+}
+
+func (jenny RawTypes) formatOneofBranches(namePrefix string, disjunction ast.Type, fieldNumber *int) (string, error) {
+	var buffer strings.Builder
+
+	for _, branch := range disjunction.AsDisjunction().Branches {
+		// a `oneof` that isn't set is already the equivalent of null
+		if branch.IsNull() {
+			continue
+		}
+
+		branchType := branch
+		branchType.Nullable = false
+
+		typeName, err := jenny.formatValueType(branchType)
+		if err != nil {
+			return "", err
+		}
+
+		buffer.WriteString(fmt.Sprintf("    %s %s%s = %d;\n", typeName, namePrefix, formatFieldName(ast.TypeName(branch)), *fieldNumber))
+		*fieldNumber++
+	}
+
+	return buffer.String(), nil
+}
+
+func (jenny RawTypes) formatEnum(name string, enum ast.EnumType) string {
+	var buffer strings.Builder
+
+	prefix := tools.UpperSnakeCase(name) + "_"
+	valueName := func(val ast.EnumValue) string {
+		cleanName := tools.UpperSnakeCase(tools.CleanupNames(val.Name))
+		if cleanName == "" {
+			cleanName = "EMPTY"
+		}
+
+		return prefix + cleanName
+	}
+
+	buffer.WriteString(fmt.Sprintf("enum %s {\n", name))
+
+	// Integer enums keep their values, as long as the first one is zero.
+	if numbers, ok := integerEnumValues(enum); ok {
+		if !tools.ItemInList(0, numbers) {
+			buffer.WriteString(fmt.Sprintf("  %sUNSPECIFIED = 0;\n", prefix))
+		}
+
+		// proto3 requires the first enum value to be zero
+		for i, val := range enum.Values {
+			if numbers[i] == 0 {
+				buffer.WriteString(fmt.Sprintf("  %s = 0;\n", valueName(val)))
+			}
+		}
+		for i, val := range enum.Values {
+			if numbers[i] != 0 {
+				buffer.WriteString(fmt.Sprintf("  %s = %d;\n", valueName(val), numbers[i]))
+			}
+		}
+
+		buffer.WriteString("}\n")
+
+		return buffer.String()
+	}
+
+	buffer.WriteString(fmt.Sprintf("  %sUNSPECIFIED = 0;\n", prefix))
+	for i, val := range enum.Values {
+		buffer.WriteString(fmt.Sprintf("  %s = %d; // %#v\n", valueName(val), i+1, val.Value))
+	}
+
+	buffer.WriteString("}\n")
+
+	return buffer.String()
+}
+
+// formatFieldType returns the label and type to use for a message field.
+func (jenny RawTypes) formatFieldType(def ast.Type, required bool) (string, string, error) {
+	switch {
+	case def.IsArray():
+		itemType, err := jenny.formatValueType(def.AsArray().ValueType)
+		if err != nil {
+			return "", "", err
+		}
+
+		return "repeated", itemType, nil
+	case def.IsMap():
+		indexType := jenny.resolveAlias(def.AsMap().IndexType)
+		if !indexType.IsScalar() || !isValidMapKey(indexType.AsScalar().ScalarKind) {
+			return "", "", fmt.Errorf("unsupported map key type '%s'", ast.TypeName(indexType))
+		}
+
+		valueType, err := jenny.formatValueType(def.AsMap().ValueType)
+		if err != nil {
+			return "", "", err
+		}
+
+		return "", fmt.Sprintf("map<%s, %s>", formatScalarKind(indexType.AsScalar().ScalarKind), valueType), nil
+	case def.IsScalar() && !def.IsAny():
+		typeName := jenny.formatScalar(def.AsScalar().ScalarKind, def.Nullable)
+		if !required && !def.Nullable {
+			return "optional", typeName, nil
+		}
+
+		return "", typeName, nil
+	}
+
+	typeName, err := jenny.formatValueType(def)
+	if err != nil {
+		return "", "", err
+	}
+
+	if !required && def.IsRef() && jenny.isEnumRef(def) {
+		return "optional", typeName, nil
+	}
+
+	return "", typeName, nil
+}
+
+// formatValueType returns the type to use for a value that can not be
+// labelled: array items, map values, `oneof` branches.
+func (jenny RawTypes) formatValueType(def ast.Type) (string, error) {
+	def = jenny.resolveAlias(def)
+
+	switch def.Kind {
+	case ast.KindScalar:
+		if def.IsAny() {
+			jenny.imports[structImport] = struct{}{}
+			return "google.protobuf.Value", nil
+		}
+
+		return jenny.formatScalar(def.AsScalar().ScalarKind, false), nil
+	case ast.KindRef:
+		return jenny.formatRef(def.AsRef()), nil
+	case ast.KindArray:
+		// nested repeated fields aren't supported
+		jenny.imports[structImport] = struct{}{}
+		return "google.protobuf.ListValue", nil
+	case ast.KindMap, ast.KindStruct, ast.KindComposableSlot, ast.KindIntersection:
+		jenny.imports[structImport] = struct{}{}
+		return "google.protobuf.Struct", nil
+	case ast.KindDisjunction:
+		name := disjunctionMessageName(def)
+		if !jenny.disjunctionMessages.Has(name) {
+			jenny.disjunctionMessages.Set(name, def)
+		}
+
+		return name, nil
+	default:
+		return "", fmt.Errorf("unsupported type '%s'", def.Kind)
+	}
+}
+
+func (jenny RawTypes) formatScalar(kind ast.ScalarKind, nullable bool) string {
+	if kind == ast.KindNull {
+		jenny.imports[structImport] = struct{}{}
+		return "google.protobuf.NullValue"
+	}
+
+	if !nullable {
+		return formatScalarKind(kind)
+	}
+
+	jenny.imports[wrappersImport] = struct{}{}
+
+	switch kind {
+	case ast.KindString:
+		return "google.protobuf.StringValue"
+	case ast.KindBytes:
+		return "google.protobuf.BytesValue"
+	case ast.KindBool:
+		return "google.protobuf.BoolValue"
+	case ast.KindFloat32:
+		return "google.protobuf.FloatValue"
+	case ast.KindFloat64:
+		return "google.protobuf.DoubleValue"
+	case ast.KindUint8, ast.KindUint16, ast.KindUint32:
+		return "google.protobuf.UInt32Value"
+	case ast.KindUint64:
+		return "google.protobuf.UInt64Value"
+	case ast.KindInt64:
+		return "google.protobuf.Int64Value"
+	default:
+		return "google.protobuf.Int32Value"
+	}
+}
+
+func formatScalarKind(kind ast.ScalarKind) string {
+	switch kind {
+	case ast.KindFloat32:
+		return "float"
+	case ast.KindFloat64:
+		return "double"
+	case ast.KindUint8, ast.KindUint16, ast.KindUint32:
+		return "uint32"
+	case ast.KindInt8, ast.KindInt16, ast.KindInt32:
+		return "int32"
+	default:
+		// string, bytes, bool, int64, uint64
+		return string(kind)
+	}
+}
+
+func (jenny RawTypes) formatRef(ref ast.RefType) string {
+	typeName := tools.UpperCamelCase(ref.ReferredType)
+	if ref.ReferredPkg == jenny.schema.Package {
+		return typeName
+	}
+
+	jenny.imports[protoFilename(ref.ReferredPkg)] = struct{}{}
+
+	return jenny.protoPackage(ref.ReferredPkg) + "." + typeName
+}
+
+// resolveAlias follows references to objects that can't be represented
+// as protobuf definitions (scalars, arrays, maps, references) and returns
+// the actual type they alias.
+func (jenny RawTypes) resolveAlias(def ast.Type) ast.Type {
+	seen := make(map[string]struct{})
+
+	for def.IsRef() {
+		ref := def.AsRef()
+		if _, found := seen[ref.String()]; found {
+			return def
+		}
+		seen[ref.String()] = struct{}{}
+
+		object, found := jenny.context.LocateObject(ref.ReferredPkg, ref.ReferredType)
+		if !found || !object.Type.IsAnyOf(ast.KindScalar, ast.KindArray, ast.KindMap, ast.KindRef) {
+			return def
+		}
+
+		resolved := object.Type
+		resolved.Nullable = resolved.Nullable || def.Nullable
+		def = resolved
+	}
+
+	return def
+}
+
+func (jenny RawTypes) isEnumRef(def ast.Type) bool {
+	object, found := jenny.context.LocateObject(def.AsRef().ReferredPkg, def.AsRef().ReferredType)
+
+	return found && object.Type.IsEnum()
+}
+
+func (jenny RawTypes) intersectionFields(intersection ast.IntersectionType) []ast.StructField {
+	var fields []ast.StructField
+
+	for _, branch := range intersection.Branches {
+		if branch.IsRef() {
+			object, found := jenny.context.LocateObject(branch.AsRef().ReferredPkg, branch.AsRef().ReferredType)
+			if found && object.Type.IsStruct() {
+				fields = append(fields, object.Type.AsStruct().Fields...)
+			}
+
+			continue
+		}
+
+		if branch.IsStruct() {
+			fields = append(fields, branch.AsStruct().Fields...)
+		}
+	}
+
+	return fields
+}
+
+func (jenny RawTypes) protoPackage(pkg string) string {
+	if jenny.Config.PackageRoot == "" {
+		return formatPackageName(pkg)
+	}
+
+	return strings.TrimSuffix(jenny.Config.PackageRoot, ".") + "." + formatPackageName(pkg)
+}
+
+func disjunctionMessageName(disjunction ast.Type) string {
+	names := make([]string, 0, len(disjunction.AsDisjunction().Branches))
+	for _, branch := range disjunction.AsDisjunction().Branches {
+		if branch.IsNull() {
+			continue
+		}
+
+		names = append(names, ast.TypeName(branch))
+	}
+
+	return strings.Join(names, "Or")
+}
+
+func integerEnumValues(enum ast.EnumType) ([]int64, bool) {
+	numbers := make([]int64, 0, len(enum.Values))
+
+	for _, val := range enum.Values {
+		switch number := val.Value.(type) {
+		case int64:
+			numbers = append(numbers, number)
+		case int:
+			numbers = append(numbers, int64(number))
+		case float64:
+			if number != float64(int64(number)) {
+				return nil, false
+			}
+			numbers = append(numbers, int64(number))
+		default:
+			return nil, false
+		}
+	}
+
+	return numbers, true
+}
+
+func isValidMapKey(kind ast.ScalarKind) bool {
+	return kind != ast.KindFloat32 && kind != ast.KindFloat64 && kind != ast.KindBytes && kind != ast.KindAny && kind != ast.KindNull
+}
+
+func protoFilename(pkg string) string {
+	return filepath.Join(formatPackageName(pkg), "types.proto")
+}
+
+func formatPackageName(pkg string) string {
+	rgx := regexp.MustCompile("[^a-zA-Z0-9_]+")
+
+	return strings.ToLower(rgx.ReplaceAllString(pkg, ""))
+}
+
+func formatFieldName(name string) string {
+	rgx := regexp.MustCompile("[^a-z0-9_]+")
+
+	return strings.Trim(rgx.ReplaceAllString(tools.SnakeCase(name), "_"), "_")
+}
+
+// jsonName mirrors the way protobuf derives the JSON name of a field
+// from its name.
+func jsonName(fieldName string) string {
+	var buffer strings.Builder
+
+	upperNext := false
+	for _, char := range fieldName {
+		if char == '_' {
+			upperNext = true
+			continue
+		}
+
+		if upperNext {
+			buffer.WriteString(strings.ToUpper(string(char)))
+			upperNext = false
+			continue
+		}
+
+		buffer.WriteRune(char)
+	}
+
+	return buffer.String()
+}
@@ -68,6 +68,14 @@ func (jenny *Builder) Generate(context common.Context) (codejen.Files, error) {
 func (jenny *Builder) generateBuilder(context common.Context, builder ast.Builder) ([]byte, error) {
 	var buffer strings.Builder
 
+	for _, option := range builder.Options {
+		for _, arg := range option.Args {
+			if err := checkRepresentable(arg.Type); err != nil {
+				return nil, fmt.Errorf("%s.%s builder, option %s: %w", builder.Package, builder.Name, option.Name, err)
+			}
+		}
+	}
+
 	// every builder uses the following imports
 	jenny.imports.AddPackage("typing", "typing")
 	jenny.importModule("cogbuilder", "..cog", "builder")
@@ -219,6 +227,10 @@ func (jenny *Builder) generateAssignment(context common.Context, assignment ast.
 
 func (jenny *Builder) constraints(argumentName string, constraints []ast.TypeConstraint) []template.Constraint {
 	return tools.Map(constraints, func(constraint ast.TypeConstraint) template.Constraint {
+		if constraint.Op == ast.PatternOp || constraint.Op == ast.NotPatternOp {
+			jenny.imports.AddPackage("re", "re")
+		}
+
 		return template.Constraint{
 			ArgName:   argumentName,
 			Op:        constraint.Op,
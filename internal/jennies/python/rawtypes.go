@@ -41,7 +41,6 @@ func (jenny RawTypes) Generate(context common.Context) (codejen.Files, error) {
 
 func (jenny RawTypes) generateSchema(context common.Context, schema *ast.Schema) ([]byte, error) {
 	var buffer strings.Builder
-	var err error
 
 	imports := NewImportMap()
 	jenny.importModule = func(alias string, pkg string, module string) string {
@@ -61,23 +60,50 @@ func (jenny RawTypes) generateSchema(context common.Context, schema *ast.Schema)
 	jenny.typeFormatter = defaultTypeFormatter(context, jenny.importPkg, jenny.importModule)
 
 	i := 0
-	schema.Objects.Iterate(func(_ string, object ast.Object) {
-		objectOutput, innerErr := jenny.typeFormatter.formatObject(object)
-		if innerErr != nil {
-			err = innerErr
-			return
+	for _, object := range objectsInDeclarationOrder(schema) {
+		if err := checkObjectRepresentable(object); err != nil {
+			return nil, err
+		}
+
+		objectOutput, err := jenny.typeFormatter.formatObject(object)
+		if err != nil {
+			return nil, err
 		}
 		buffer.WriteString(objectOutput)
 
 		if object.Type.IsStruct() {
 			buffer.WriteString("\n\n")
-			buffer.WriteString(jenny.generateInitMethod(context.Schemas, object))
+			buffer.WriteString(jenny.generateInitMethod(context.Schemas, object, nil))
+
+			buffer.WriteString("\n\n")
+			buffer.WriteString(jenny.generateToJSONMethod(object, nil))
+
+			buffer.WriteString("\n\n")
+			buffer.WriteString(jenny.generateFromJSONMethod(context, object, nil))
+		}
+
+		if object.Type.IsIntersection() {
+			parents, err := jenny.typeFormatter.intersectionParents(object)
+			if err != nil {
+				return nil, err
+			}
+			if len(parents) == 1 && parents[0] == "object" {
+				parents = nil
+			}
+
+			// the intersection's own fields are handled as if they were
+			// part of a struct, parents take care of the others.
+			structObject := object
+			structObject.Type = ast.NewStruct(intersectionFields(object.Type.AsIntersection())...)
+
+			buffer.WriteString("\n\n")
+			buffer.WriteString(jenny.generateInitMethod(context.Schemas, structObject, parents))
 
 			buffer.WriteString("\n\n")
-			buffer.WriteString(jenny.generateToJSONMethod(object))
+			buffer.WriteString(jenny.generateToJSONMethod(structObject, parents))
 
 			buffer.WriteString("\n\n")
-			buffer.WriteString(jenny.generateFromJSONMethod(context, object))
+			buffer.WriteString(jenny.generateFromJSONMethod(context, structObject, parents))
 		}
 
 		if object.Type.ImplementedVariant() == string(ast.SchemaVariantDataQuery) && !object.Type.HasHint(ast.HintSkipVariantPluginRegistration) {
@@ -89,9 +115,6 @@ func (jenny RawTypes) generateSchema(context common.Context, schema *ast.Schema)
 		if i != schema.Objects.Len()-1 {
 			buffer.WriteString("\n\n\n")
 		}
-	})
-	if err != nil {
-		return nil, err
 	}
 
 	if schema.Metadata.Kind == ast.SchemaKindComposable && schema.Metadata.Variant == ast.SchemaVariantPanel {
@@ -109,7 +132,7 @@ func (jenny RawTypes) generateSchema(context common.Context, schema *ast.Schema)
 	return []byte(importStatements + buffer.String()), nil
 }
 
-func (jenny RawTypes) generateInitMethod(schemas ast.Schemas, object ast.Object) string {
+func (jenny RawTypes) generateInitMethod(schemas ast.Schemas, object ast.Object, parents []string) string {
 	var buffer strings.Builder
 
 	var args []string
@@ -152,13 +175,24 @@ func (jenny RawTypes) generateInitMethod(schemas ast.Schemas, object ast.Object)
 		assignments = append(assignments, fmt.Sprintf("        self.%[1]s = %[1]s", fieldName))
 	}
 
+	if additionalProperties := object.Type.AsStruct().AdditionalProperties; additionalProperties != nil {
+		typingPkg := jenny.importPkg("typing", "typing")
+
+		args = append(args, fmt.Sprintf("additional_properties: %s.Optional[%s] = None", typingPkg, jenny.typeFormatter.formatType(*additionalProperties)))
+		assignments = append(assignments, "        self.additional_properties = additional_properties if additional_properties is not None else {}")
+	}
+
+	parentsInit := tools.Map(parents, func(parent string) string {
+		return fmt.Sprintf("        %s.__init__(self)", parent)
+	})
+
 	buffer.WriteString(fmt.Sprintf("    def __init__(self, %s):\n", strings.Join(args, ", ")))
-	buffer.WriteString(strings.Join(assignments, "\n"))
+	buffer.WriteString(strings.Join(append(parentsInit, assignments...), "\n"))
 
 	return strings.TrimSuffix(buffer.String(), "\n")
 }
 
-func (jenny RawTypes) generateToJSONMethod(object ast.Object) string {
+func (jenny RawTypes) generateToJSONMethod(object ast.Object, parents []string) string {
 	var buffer strings.Builder
 
 	buffer.WriteString("    def to_json(self) -> dict[str, object]:\n")
@@ -174,6 +208,10 @@ func (jenny RawTypes) generateToJSONMethod(object ast.Object) string {
 
 	buffer.WriteString("        }\n")
 
+	for _, parent := range parents {
+		buffer.WriteString(fmt.Sprintf("        payload.update(%s.to_json(self))\n", parent))
+	}
+
 	for _, field := range object.Type.AsStruct().Fields {
 		if field.Required {
 			continue
@@ -185,12 +223,18 @@ func (jenny RawTypes) generateToJSONMethod(object ast.Object) string {
 		buffer.WriteString(fmt.Sprintf(`            payload["%s"] = self.%s`+"\n", field.Name, fieldName))
 	}
 
+	// declared fields take precedence over additional properties
+	if object.Type.AsStruct().AdditionalProperties != nil {
+		buffer.WriteString("        for key, value in self.additional_properties.items():\n")
+		buffer.WriteString("            payload.setdefault(key, value)\n")
+	}
+
 	buffer.WriteString("        return payload")
 
 	return buffer.String()
 }
 
-func (jenny RawTypes) generateFromJSONMethod(context common.Context, object ast.Object) string {
+func (jenny RawTypes) generateFromJSONMethod(context common.Context, object ast.Object, parents []string) string {
 	var buffer strings.Builder
 
 	typingPkg := jenny.importPkg("typing", "typing")
@@ -282,13 +326,32 @@ func (jenny RawTypes) generateFromJSONMethod(context common.Context, object ast.
 		assignments = append(assignments, assignment)
 	}
 
+	if object.Type.AsStruct().AdditionalProperties != nil {
+		knownKeys := tools.Map(object.Type.AsStruct().Fields, func(field ast.StructField) string {
+			return fmt.Sprintf("%q", field.Name)
+		})
+
+		assignment := fmt.Sprintf(`        args["additional_properties"] = {key: value for key, value in data.items() if key not in [%s]}`, strings.Join(knownKeys, ", "))
+		assignments = append(assignments, assignment)
+	}
+
 	if len(assignments) != 0 {
 		buffer.WriteString("        \n")
 		buffer.WriteString(strings.Join(assignments, "\n"))
 		buffer.WriteString("        \n\n")
 	}
 
-	buffer.WriteString("        return cls(**args)")
+	if len(parents) == 0 {
+		buffer.WriteString("        return cls(**args)")
+
+		return buffer.String()
+	}
+
+	buffer.WriteString("        instance = cls(**args)\n")
+	for _, parent := range parents {
+		buffer.WriteString(fmt.Sprintf("        instance.__dict__.update(vars(%s.from_json(data)))\n", parent))
+	}
+	buffer.WriteString("        return instance")
 
 	return buffer.String()
 }
@@ -409,3 +472,72 @@ func (jenny RawTypes) composableSlotFromJSON(context common.Context, parentStruc
 
 	return fmt.Sprintf(`%[3]s.dataquery_from_json(data["%[1]s"], %[2]s)`, field.Name, hintValue, cogruntime)
 }
+
+// objectsInDeclarationOrder sorts the objects of a schema in the order in
+// which they can be declared: since python classes must be declared before
+// being inherited from, intersections are declared last, after the
+// intersections they inherit from.
+func objectsInDeclarationOrder(schema *ast.Schema) []ast.Object {
+	objects := make([]ast.Object, 0, schema.Objects.Len())
+	var intersections []ast.Object
+
+	schema.Objects.Iterate(func(_ string, object ast.Object) {
+		if object.Type.IsIntersection() {
+			intersections = append(intersections, object)
+			return
+		}
+
+		objects = append(objects, object)
+	})
+
+	declared := make(map[string]bool, len(intersections))
+	var declare func(object ast.Object)
+	declare = func(object ast.Object) {
+		if declared[object.Name] {
+			return
+		}
+		declared[object.Name] = true
+
+		for _, branch := range object.Type.AsIntersection().Branches {
+			if !branch.IsRef() || branch.AsRef().ReferredPkg != schema.Package {
+				continue
+			}
+
+			parent, found := schema.LocateObject(branch.AsRef().ReferredType)
+			if found && parent.Type.IsIntersection() {
+				declare(parent)
+			}
+		}
+
+		objects = append(objects, object)
+	}
+
+	for _, intersection := range intersections {
+		declare(intersection)
+	}
+
+	return objects
+}
+
+func checkObjectRepresentable(object ast.Object) error {
+	types := ast.Types{object.Type}
+	if object.Type.IsIntersection() {
+		types = object.Type.AsIntersection().Branches
+	}
+
+	for _, def := range types {
+		if def.IsIntersection() {
+			return fmt.Errorf("%s: nested intersection types can not be represented in python", object.SelfRef.String())
+		}
+
+		if object.Type.IsIntersection() && def.IsStruct() && def.AsStruct().AdditionalProperties != nil {
+			return fmt.Errorf("%s: additional properties in intersection branches can not be represented in python", object.SelfRef.String())
+		}
+
+		if err := checkRepresentable(def); err != nil {
+			return fmt.Errorf("%s: %w", object.SelfRef.String(), err)
+		}
+	}
+
+	return nil
+}
@@ -13,9 +13,6 @@ func TestRawTypes_Generate(t *testing.T) {
 	test := testutils.GoldenFilesTestSuite{
 		TestDataRoot: "../../../testdata/jennies/rawtypes",
 		Name:         "PythonRawTypes",
-		Skip: map[string]string{
-			"intersections": "Intersections are not implemented",
-		},
 	}
 
 	jenny := RawTypes{}
@@ -38,3 +35,22 @@ func TestRawTypes_Generate(t *testing.T) {
 		tc.WriteFiles(files)
 	})
 }
+
+func TestRawTypes_Generate_inlineIntersection(t *testing.T) {
+	req := require.New(t)
+
+	schema := &ast.Schema{
+		Package: "test",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("test", "SomeStruct", ast.NewStruct(
+				ast.NewStructField("field", ast.NewIntersection([]ast.Type{
+					ast.NewRef("test", "Foo"),
+					ast.NewRef("test", "Bar"),
+				}), ast.Required()),
+			)),
+		),
+	}
+
+	_, err := RawTypes{}.Generate(common.Context{Schemas: ast.Schemas{schema}})
+	req.EqualError(err, "test.SomeStruct: field: inline intersection types can not be represented in python")
+}
@@ -57,6 +57,8 @@ func (formatter *typeFormatter) formatObject(def ast.Object) (string, error) {
 		buffer.WriteString(formatter.formatEnum(def))
 	case ast.KindStruct:
 		return formatter.formatStruct(def), nil
+	case ast.KindIntersection:
+		return formatter.formatIntersection(def)
 	default:
 		buffer.WriteString(fmt.Sprintf("%s = %s", defName, formatter.formatType(def.Type)))
 	}
@@ -101,10 +103,6 @@ func (formatter *typeFormatter) formatType(def ast.Type) string {
 		result = formatter.formatAnonymousEnum(def)
 	}
 
-	if def.IsIntersection() {
-		panic("formatting intersection type is not implemented for python")
-	}
-
 	if def.IsDisjunction() {
 		result = formatter.formatDisjunction(def.AsDisjunction())
 	}
@@ -185,9 +183,119 @@ func (formatter *typeFormatter) formatStruct(def ast.Object) string {
 		}
 	}
 
+	if additionalProperties := def.Type.AsStruct().AdditionalProperties; additionalProperties != nil {
+		buffer.WriteString("\n    # Properties that are not declared as fields.\n")
+		buffer.WriteString(fmt.Sprintf("    additional_properties: %s", formatter.formatType(*additionalProperties)))
+	}
+
 	return buffer.String()
 }
 
+// formatIntersection declares an intersection as a class inheriting from
+// each of its referenced branches, and holding the fields of its
+// struct branches.
+func (formatter *typeFormatter) formatIntersection(def ast.Object) (string, error) {
+	var buffer strings.Builder
+
+	parents, err := formatter.intersectionParents(def)
+	if err != nil {
+		return "", err
+	}
+
+	fields := intersectionFields(def.Type.AsIntersection())
+
+	buffer.WriteString(fmt.Sprintf("class %s(%s):\n", tools.UpperCamelCase(def.Name), strings.Join(parents, ", ")))
+	buffer.WriteString(formatter.formatClassComments(def.Comments))
+
+	if len(fields) == 0 {
+		buffer.WriteString("    pass")
+	}
+
+	for i, fieldDef := range fields {
+		buffer.WriteString(formatter.formatStructField(fieldDef))
+
+		if i != len(fields)-1 {
+			buffer.WriteString("\n")
+		}
+	}
+
+	return buffer.String(), nil
+}
+
+// intersectionParents returns the classes an intersection inherits from.
+// Only references to structs (or other intersections) and struct branches
+// can be represented.
+func (formatter *typeFormatter) intersectionParents(def ast.Object) ([]string, error) {
+	var parents []string
+
+	for _, branch := range def.Type.AsIntersection().Branches {
+		if branch.IsStruct() {
+			continue
+		}
+
+		if !branch.IsRef() {
+			return nil, fmt.Errorf("%s: intersection branches of kind '%s' can not be represented in python", def.SelfRef.String(), branch.Kind)
+		}
+
+		ref := branch.AsRef()
+		referredObject, found := formatter.context.LocateObject(ref.ReferredPkg, ref.ReferredType)
+		if found && !referredObject.Type.IsAnyOf(ast.KindStruct, ast.KindIntersection) {
+			return nil, fmt.Errorf("%s: intersection branch '%s' is a %s and can not be represented in python", def.SelfRef.String(), ref.String(), referredObject.Type.Kind)
+		}
+
+		parents = append(parents, formatter.formatFullyQualifiedRef(ref, false))
+	}
+
+	if len(parents) == 0 {
+		parents = append(parents, "object")
+	}
+
+	return parents, nil
+}
+
+func intersectionFields(def ast.IntersectionType) []ast.StructField {
+	var fields []ast.StructField
+
+	for _, branch := range def.Branches {
+		if branch.IsStruct() {
+			fields = append(fields, branch.AsStruct().Fields...)
+		}
+	}
+
+	return fields
+}
+
+// checkRepresentable ensures that a type can be represented in python:
+// intersections can only be declared as objects, not inline.
+func checkRepresentable(def ast.Type) error {
+	switch def.Kind {
+	case ast.KindIntersection:
+		return fmt.Errorf("inline intersection types can not be represented in python")
+	case ast.KindArray:
+		return checkRepresentable(def.AsArray().ValueType)
+	case ast.KindMap:
+		if err := checkRepresentable(def.AsMap().IndexType); err != nil {
+			return err
+		}
+
+		return checkRepresentable(def.AsMap().ValueType)
+	case ast.KindDisjunction:
+		for _, branch := range def.AsDisjunction().Branches {
+			if err := checkRepresentable(branch); err != nil {
+				return err
+			}
+		}
+	case ast.KindStruct:
+		for _, field := range def.AsStruct().Fields {
+			if err := checkRepresentable(field.Type); err != nil {
+				return fmt.Errorf("%s: %w", field.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func (formatter *typeFormatter) formatStructField(def ast.StructField) string {
 	var buffer strings.Builder
 
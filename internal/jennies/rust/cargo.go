@@ -0,0 +1,35 @@
+package rust
+
+import (
+	"fmt"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/jennies/common"
+)
+
+type CargoToml struct {
+	Config Config
+}
+
+func (jenny CargoToml) JennyName() string {
+	return "RustCargoToml"
+}
+
+func (jenny CargoToml) Generate(_ common.Context) (codejen.Files, error) {
+	return codejen.Files{
+		*codejen.NewFile("Cargo.toml", []byte(jenny.generateCargoToml()), jenny),
+	}, nil
+}
+
+func (jenny CargoToml) generateCargoToml() string {
+	return fmt.Sprintf(`[package]
+name = "%s"
+version = "0.1.0"
+edition = "2021"
+
+[dependencies]
+serde = { version = "1.0", features = ["derive"] }
+serde_json = "1.0"
+serde_repr = "0.1"
+`, jenny.Config.CrateName)
+}
@@ -0,0 +1,35 @@
+package rust
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCargoToml_Generate(t *testing.T) {
+	req := require.New(t)
+
+	jenny := CargoToml{
+		Config: Config{CrateName: "grafana_heey"},
+	}
+
+	files, err := jenny.Generate(common.Context{})
+	req.NoError(err)
+
+	req.Len(files, 1)
+
+	cargoFile := files[0]
+
+	req.Equal("Cargo.toml", cargoFile.RelativePath)
+	req.Equal(`[package]
+name = "grafana_heey"
+version = "0.1.0"
+edition = "2021"
+
+[dependencies]
+serde = { version = "1.0", features = ["derive"] }
+serde_json = "1.0"
+serde_repr = "0.1"
+`, string(cargoFile.Data))
+}
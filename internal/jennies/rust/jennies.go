@@ -0,0 +1,69 @@
+package rust
+
+import (
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/spf13/cobra"
+)
+
+const LanguageRef = "rust"
+
+type Config struct {
+	Debug bool
+
+	// GenerateCargoToml indicates whether a Cargo.toml file should be generated.
+	// If enabled, CrateName is used as package name.
+	GenerateCargoToml bool
+
+	// Name of the crate in which the types are generated.
+	CrateName string
+}
+
+func (config Config) MergeWithGlobal(global common.Config) Config {
+	newConfig := config
+	newConfig.Debug = global.Debug
+
+	return newConfig
+}
+
+type Language struct {
+	config Config
+}
+
+func New() *Language {
+	return &Language{
+		config: Config{},
+	}
+}
+
+func (language *Language) RegisterCliFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&language.config.CrateName, "rust-crate-name", "grafana_cog", "Rust crate name.")
+	cmd.Flags().BoolVar(&language.config.GenerateCargoToml, "rust-cargo-toml", false, "Generate a Cargo.toml file. If enabled, 'rust-crate-name' is used as package name.")
+}
+
+func (language *Language) Jennies(globalConfig common.Config) *codejen.JennyList[common.Context] {
+	config := language.config.MergeWithGlobal(globalConfig)
+
+	jenny := codejen.JennyListWithNamer[common.Context](func(_ common.Context) string {
+		return LanguageRef
+	})
+	jenny.AppendOneToMany(
+		common.If[common.Context](config.GenerateCargoToml, CargoToml{Config: config}),
+
+		common.If[common.Context](globalConfig.Types, RawTypes{Config: config}),
+	)
+	jenny.AddPostprocessors(common.GeneratedCommentHeader(globalConfig))
+
+	return jenny
+}
+
+func (language *Language) CompilerPasses() compiler.Passes {
+	return compiler.Passes{
+		&compiler.AnonymousEnumToExplicitType{},
+		&compiler.AnonymousStructsToNamed{},
+		&compiler.FlattenDisjunctions{},
+		&compiler.DisjunctionWithNullToOptional{},
+		&compiler.DisjunctionInferMapping{},
+	}
+}
@@ -0,0 +1,400 @@
+package rust
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/tools"
+)
+
+const derives = "#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]"
+
+type RawTypes struct {
+	Config Config
+
+	typeFormatter *typeFormatter
+}
+
+func (jenny RawTypes) JennyName() string {
+	return "RustRawTypes"
+}
+
+func (jenny RawTypes) Generate(context common.Context) (codejen.Files, error) {
+	files := make(codejen.Files, 0, len(context.Schemas)+1)
+	modules := make([]string, 0, len(context.Schemas))
+
+	for _, schema := range context.Schemas {
+		output, err := jenny.generateSchema(context, schema)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] %w", schema.Package, err)
+		}
+
+		module := formatModuleName(schema.Package)
+		modules = append(modules, module)
+
+		filename := filepath.Join("src", strings.TrimPrefix(module, "r#")+".rs")
+		files = append(files, *codejen.NewFile(filename, output, jenny))
+	}
+
+	sort.Strings(modules)
+
+	var lib strings.Builder
+	for _, module := range modules {
+		lib.WriteString(fmt.Sprintf("pub mod %s;\n", module))
+	}
+
+	files = append(files, *codejen.NewFile(filepath.Join("src", "lib.rs"), []byte(lib.String()), jenny))
+
+	return files, nil
+}
+
+func (jenny RawTypes) generateSchema(context common.Context, schema *ast.Schema) ([]byte, error) {
+	var buffer strings.Builder
+	var err error
+
+	jenny.typeFormatter = newTypeFormatter(context, schema.Package)
+	uses := make(map[string]struct{})
+
+	schema.Objects.Iterate(func(_ string, object ast.Object) {
+		if err != nil {
+			return
+		}
+
+		objectOutput, innerErr := jenny.formatObject(object)
+		if innerErr != nil {
+			err = fmt.Errorf("%s: %w", object.Name, innerErr)
+			return
+		}
+
+		if object.Type.IsEnum() && isIntegerEnum(object.Type.AsEnum()) {
+			uses["serde_repr::{Deserialize_repr, Serialize_repr}"] = struct{}{}
+		}
+
+		buffer.WriteString(objectOutput)
+		buffer.WriteString("\n")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// disjunctions used as struct fields, array values, ... are declared
+	// as standalone enums. Declaring one can require more of them.
+	for i := 0; i < jenny.typeFormatter.disjunctions.Len(); i++ {
+		disjunction := jenny.typeFormatter.disjunctions.At(i)
+
+		buffer.WriteString(jenny.formatDisjunction(disjunctionName(disjunction), disjunction))
+		buffer.WriteString("\n")
+	}
+
+	if strings.Contains(buffer.String(), "#[derive(Serialize, Deserialize") {
+		uses["serde::{Deserialize, Serialize}"] = struct{}{}
+	}
+
+	for use := range jenny.typeFormatter.uses {
+		uses[use] = struct{}{}
+	}
+
+	useStatements := make([]string, 0, len(uses))
+	for use := range uses {
+		useStatements = append(useStatements, fmt.Sprintf("use %s;", use))
+	}
+	sort.Strings(useStatements)
+
+	if len(useStatements) == 0 {
+		return []byte(buffer.String()), nil
+	}
+
+	return []byte(strings.Join(useStatements, "\n") + "\n\n" + buffer.String()), nil
+}
+
+func (jenny RawTypes) formatObject(def ast.Object) (string, error) {
+	var buffer strings.Builder
+
+	comments := def.Comments
+	if jenny.Config.Debug {
+		passesTrail := tools.Map(def.PassesTrail, func(trail string) string {
+			return fmt.Sprintf("Modified by compiler pass '%s'", trail)
+		})
+		comments = append(comments, passesTrail...)
+	}
+
+	for _, commentLine := range comments {
+		buffer.WriteString(fmt.Sprintf("/// %s\n", commentLine))
+	}
+
+	typeName := formatTypeName(def.Name)
+
+	switch def.Type.Kind {
+	case ast.KindStruct:
+		buffer.WriteString(derives + "\n")
+		buffer.WriteString(fmt.Sprintf("pub struct %s {\n", typeName))
+		buffer.WriteString(jenny.formatFields(def.SelfRef, def.Type.AsStruct().Fields, "pub "))
+		buffer.WriteString(jenny.formatAdditionalProperties(def.Type.AsStruct(), "pub "))
+		buffer.WriteString("}\n")
+	case ast.KindEnum:
+		buffer.WriteString(jenny.formatEnum(typeName, def.Type.AsEnum()))
+	case ast.KindDisjunction:
+		buffer.WriteString(jenny.formatDisjunction(typeName, def.Type))
+	case ast.KindIntersection:
+		buffer.WriteString(derives + "\n")
+		buffer.WriteString(fmt.Sprintf("pub struct %s {\n", typeName))
+		buffer.WriteString(jenny.formatIntersectionFields(def.SelfRef, def.Type.AsIntersection()))
+		buffer.WriteString("}\n")
+	case ast.KindScalar:
+		scalarType := def.Type.AsScalar()
+		if scalarType.IsConcrete() {
+			buffer.WriteString(fmt.Sprintf("pub const %s: %s = %s;\n", formatConstName(def.Name), formatConstType(scalarType.ScalarKind), formatValue(scalarType.Value)))
+			break
+		}
+
+		buffer.WriteString(fmt.Sprintf("pub type %s = %s;\n", typeName, jenny.formatAliasedType(def.Type)))
+	case ast.KindRef, ast.KindArray, ast.KindMap, ast.KindComposableSlot:
+		buffer.WriteString(fmt.Sprintf("pub type %s = %s;\n", typeName, jenny.formatAliasedType(def.Type)))
+	default:
+		return "", fmt.Errorf("unhandled type def kind: %s", def.Type.Kind)
+	}
+
+	return buffer.String(), nil
+}
+
+func (jenny RawTypes) formatAliasedType(def ast.Type) string {
+	formatted := jenny.typeFormatter.formatType(def)
+	if def.Nullable {
+		return fmt.Sprintf("Option<%s>", formatted)
+	}
+
+	return formatted
+}
+
+func (jenny RawTypes) formatFields(objectRef ast.RefType, fields []ast.StructField, visibility string) string {
+	var buffer strings.Builder
+
+	for _, field := range fields {
+		for _, commentLine := range field.Comments {
+			buffer.WriteString(fmt.Sprintf("    /// %s\n", commentLine))
+		}
+
+		fieldName := formatFieldName(field.Name)
+		fieldType := jenny.typeFormatter.formatFieldType(objectRef, field)
+
+		var serdeAttributes []string
+		if strings.TrimPrefix(fieldName, "r#") != field.Name {
+			serdeAttributes = append(serdeAttributes, fmt.Sprintf("rename = %s", formatValue(field.Name)))
+		}
+		if strings.HasPrefix(fieldType, "Option<") {
+			serdeAttributes = append(serdeAttributes, "default", `skip_serializing_if = "Option::is_none"`)
+		}
+
+		if len(serdeAttributes) != 0 {
+			buffer.WriteString(fmt.Sprintf("    #[serde(%s)]\n", strings.Join(serdeAttributes, ", ")))
+		}
+
+		buffer.WriteString(fmt.Sprintf("    %s%s: %s,\n", visibility, fieldName, fieldType))
+	}
+
+	return buffer.String()
+}
+
+// formatAdditionalProperties declares a map collecting the properties that
+// are not declared as fields, if the struct accepts any.
+func (jenny RawTypes) formatAdditionalProperties(def ast.StructType, visibility string) string {
+	if def.AdditionalProperties == nil {
+		return ""
+	}
+
+	var buffer strings.Builder
+
+	buffer.WriteString("    /// Properties that are not declared as fields.\n")
+	buffer.WriteString("    #[serde(flatten)]\n")
+	buffer.WriteString(fmt.Sprintf("    %sadditional_properties: %s,\n", visibility, jenny.typeFormatter.formatType(*def.AdditionalProperties)))
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) formatIntersectionFields(objectRef ast.RefType, intersection ast.IntersectionType) string {
+	var buffer strings.Builder
+
+	for _, branch := range intersection.Branches {
+		if branch.IsRef() {
+			buffer.WriteString("    #[serde(flatten)]\n")
+			buffer.WriteString(fmt.Sprintf("    pub %s: %s,\n", formatFieldName(branch.AsRef().ReferredType), jenny.typeFormatter.formatType(branch)))
+			continue
+		}
+
+		if branch.IsStruct() {
+			buffer.WriteString(jenny.formatFields(objectRef, branch.AsStruct().Fields, "pub "))
+		}
+	}
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) formatEnum(typeName string, enum ast.EnumType) string {
+	var buffer strings.Builder
+
+	if isIntegerEnum(enum) {
+		buffer.WriteString("#[derive(Serialize_repr, Deserialize_repr, Debug, Clone, Copy, PartialEq, Eq)]\n")
+		buffer.WriteString(fmt.Sprintf("#[repr(%s)]\n", jenny.typeFormatter.formatScalar(enum.Values[0].Type.AsScalar().ScalarKind)))
+		buffer.WriteString(fmt.Sprintf("pub enum %s {\n", typeName))
+		for _, value := range enum.Values {
+			buffer.WriteString(fmt.Sprintf("    %s = %v,\n", formatTypeName(value.Name), value.Value))
+		}
+		buffer.WriteString("}\n")
+
+		return buffer.String()
+	}
+
+	buffer.WriteString("#[derive(Serialize, Deserialize, Debug, Clone, Copy, PartialEq, Eq, Hash)]\n")
+	buffer.WriteString(fmt.Sprintf("pub enum %s {\n", typeName))
+	for _, value := range enum.Values {
+		buffer.WriteString(fmt.Sprintf("    #[serde(rename = %s)]\n", formatValue(value.Value)))
+		buffer.WriteString(fmt.Sprintf("    %s,\n", formatTypeName(value.Name)))
+	}
+	buffer.WriteString("}\n")
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) formatDisjunction(typeName string, def ast.Type) string {
+	disjunction := def.AsDisjunction()
+
+	if jenny.isTaggedDisjunction(disjunction) {
+		return jenny.formatTaggedDisjunction(typeName, disjunction)
+	}
+
+	// Disjunctions of scalars, or disjunctions without discriminator:
+	// the first branch matching the input is used.
+	var buffer strings.Builder
+
+	buffer.WriteString(derives + "\n")
+	buffer.WriteString("#[serde(untagged)]\n")
+	buffer.WriteString(fmt.Sprintf("pub enum %s {\n", typeName))
+	for _, branch := range disjunction.Branches {
+		if branch.IsNull() {
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf("    %s(%s),\n", formatTypeName(ast.TypeName(branch)), jenny.typeFormatter.formatType(branch)))
+	}
+	buffer.WriteString("}\n")
+
+	return buffer.String()
+}
+
+// formatTaggedDisjunction declares a disjunction of references to structs as
+// an internally tagged enum. The fields of each struct are copied into the
+// corresponding enum variant, minus the discriminator field that serde
+// uses as tag.
+func (jenny RawTypes) formatTaggedDisjunction(typeName string, disjunction ast.DisjunctionType) string {
+	var buffer strings.Builder
+
+	buffer.WriteString(derives + "\n")
+	buffer.WriteString(fmt.Sprintf("#[serde(tag = %s)]\n", formatValue(disjunction.Discriminator)))
+	buffer.WriteString(fmt.Sprintf("pub enum %s {\n", typeName))
+
+	for _, branch := range disjunction.Branches {
+		ref := branch.AsRef()
+		object, _ := jenny.typeFormatter.context.LocateObject(ref.ReferredPkg, ref.ReferredType)
+
+		discriminatorValues := discriminatorValuesFor(disjunction, ref.ReferredType)
+		serdeAttributes := []string{fmt.Sprintf("rename = %s", formatValue(discriminatorValues[0]))}
+		for _, alias := range discriminatorValues[1:] {
+			serdeAttributes = append(serdeAttributes, fmt.Sprintf("alias = %s", formatValue(alias)))
+		}
+
+		fields := tools.Filter(object.Type.AsStruct().Fields, func(field ast.StructField) bool {
+			return field.Name != disjunction.Discriminator
+		})
+
+		buffer.WriteString(fmt.Sprintf("    #[serde(%s)]\n", strings.Join(serdeAttributes, ", ")))
+		buffer.WriteString(fmt.Sprintf("    %s {\n", formatTypeName(ref.ReferredType)))
+		buffer.WriteString(indent(jenny.formatFields(object.SelfRef, fields, "") + jenny.formatAdditionalProperties(object.Type.AsStruct(), "")))
+		buffer.WriteString("    },\n")
+	}
+
+	buffer.WriteString("}\n")
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) isTaggedDisjunction(disjunction ast.DisjunctionType) bool {
+	if disjunction.Discriminator == "" || len(disjunction.DiscriminatorMapping) == 0 {
+		return false
+	}
+
+	for _, branch := range disjunction.Branches {
+		if !branch.IsRef() {
+			return false
+		}
+
+		object, found := jenny.typeFormatter.context.LocateObject(branch.AsRef().ReferredPkg, branch.AsRef().ReferredType)
+		if !found || !object.Type.IsStruct() {
+			return false
+		}
+
+		if len(discriminatorValuesFor(disjunction, branch.AsRef().ReferredType)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func discriminatorValuesFor(disjunction ast.DisjunctionType, typeName string) []string {
+	var values []string
+	for value, candidate := range disjunction.DiscriminatorMapping {
+		if candidate == typeName && value != ast.DiscriminatorCatchAll {
+			values = append(values, value)
+		}
+	}
+
+	sort.Strings(values)
+
+	return values
+}
+
+func isIntegerEnum(enum ast.EnumType) bool {
+	if len(enum.Values) == 0 || !enum.Values[0].Type.IsScalar() {
+		return false
+	}
+
+	switch enum.Values[0].Type.AsScalar().ScalarKind {
+	case ast.KindInt8, ast.KindInt16, ast.KindInt32, ast.KindInt64,
+		ast.KindUint8, ast.KindUint16, ast.KindUint32, ast.KindUint64:
+		return true
+	default:
+		return false
+	}
+}
+
+func formatConstType(kind ast.ScalarKind) string {
+	if kind == ast.KindString {
+		return "&str"
+	}
+
+	return newTypeFormatter(common.Context{}, "").formatScalar(kind)
+}
+
+func formatValue(val any) string {
+	if str, ok := val.(string); ok {
+		return fmt.Sprintf("%q", str)
+	}
+
+	return fmt.Sprintf("%v", val)
+}
+
+func indent(code string) string {
+	lines := strings.Split(strings.TrimSuffix(code, "\n"), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = "    " + line
+		}
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
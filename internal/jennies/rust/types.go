@@ -0,0 +1,238 @@
+package rust
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/orderedmap"
+	"github.com/grafana/cog/internal/tools"
+)
+
+type typeFormatter struct {
+	context common.Context
+	pkg     string
+
+	// `use` statements needed by the formatted types.
+	uses map[string]struct{}
+
+	// Disjunctions that need to be declared as enums, indexed by name.
+	disjunctions *orderedmap.Map[string, ast.Type]
+}
+
+func newTypeFormatter(context common.Context, pkg string) *typeFormatter {
+	return &typeFormatter{
+		context:      context,
+		pkg:          pkg,
+		uses:         make(map[string]struct{}),
+		disjunctions: orderedmap.New[string, ast.Type](),
+	}
+}
+
+// formatFieldType formats the type of a struct field: non-required and
+// nullable fields are wrapped in an `Option`.
+func (formatter *typeFormatter) formatFieldType(objectRef ast.RefType, field ast.StructField) string {
+	fieldType := field.Type
+
+	// if the field's type is a reference to a constant,
+	// we need to use the constant's type instead.
+	if fieldType.IsRef() {
+		referredObject, found := formatter.context.LocateObject(fieldType.AsRef().ReferredPkg, fieldType.AsRef().ReferredType)
+		if found && referredObject.Type.IsConcreteScalar() {
+			fieldType = referredObject.Type
+		}
+	}
+
+	formatted := formatter.formatType(fieldType)
+
+	// recursive types need an indirection to have a known size
+	if fieldType.IsRef() && formatter.isRecursive(objectRef, fieldType.AsRef(), make(map[string]struct{})) {
+		formatted = fmt.Sprintf("Box<%s>", formatted)
+	}
+
+	if !field.Required || fieldType.Nullable {
+		return fmt.Sprintf("Option<%s>", formatted)
+	}
+
+	return formatted
+}
+
+func (formatter *typeFormatter) formatType(def ast.Type) string {
+	switch def.Kind {
+	case ast.KindScalar:
+		return formatter.formatScalar(def.AsScalar().ScalarKind)
+	case ast.KindRef:
+		return formatter.formatRef(def.AsRef())
+	case ast.KindArray:
+		return fmt.Sprintf("Vec<%s>", formatter.formatType(def.AsArray().ValueType))
+	case ast.KindMap:
+		formatter.uses["std::collections::HashMap"] = struct{}{}
+
+		return fmt.Sprintf("HashMap<%s, %s>", formatter.formatType(def.AsMap().IndexType), formatter.formatType(def.AsMap().ValueType))
+	case ast.KindDisjunction:
+		name := disjunctionName(def)
+		if !formatter.disjunctions.Has(name) {
+			formatter.disjunctions.Set(name, def)
+		}
+
+		return name
+	default:
+		// anonymous structs and enums are named by compiler passes,
+		// composable slots and intersections are kept as raw JSON.
+		return "serde_json::Value"
+	}
+}
+
+func (formatter *typeFormatter) formatScalar(kind ast.ScalarKind) string {
+	switch kind {
+	case ast.KindNull:
+		return "()"
+	case ast.KindAny:
+		return "serde_json::Value"
+	case ast.KindBytes:
+		return "Vec<u8>"
+	case ast.KindString:
+		return "String"
+	case ast.KindBool:
+		return "bool"
+	case ast.KindFloat32:
+		return "f32"
+	case ast.KindFloat64:
+		return "f64"
+	case ast.KindUint8:
+		return "u8"
+	case ast.KindUint16:
+		return "u16"
+	case ast.KindUint32:
+		return "u32"
+	case ast.KindUint64:
+		return "u64"
+	case ast.KindInt8:
+		return "i8"
+	case ast.KindInt16:
+		return "i16"
+	case ast.KindInt32:
+		return "i32"
+	case ast.KindInt64:
+		return "i64"
+	default:
+		return "serde_json::Value"
+	}
+}
+
+func (formatter *typeFormatter) formatRef(ref ast.RefType) string {
+	typeName := formatTypeName(ref.ReferredType)
+	if ref.ReferredPkg == formatter.pkg {
+		return typeName
+	}
+
+	return fmt.Sprintf("crate::%s::%s", formatModuleName(ref.ReferredPkg), typeName)
+}
+
+// isRecursive tells whether the given reference leads back to the object
+// `objectRef` without going through a container (Vec, HashMap).
+func (formatter *typeFormatter) isRecursive(objectRef ast.RefType, ref ast.RefType, visited map[string]struct{}) bool {
+	if ref.ReferredPkg == objectRef.ReferredPkg && ref.ReferredType == objectRef.ReferredType {
+		return true
+	}
+
+	if _, found := visited[ref.String()]; found {
+		return false
+	}
+	visited[ref.String()] = struct{}{}
+
+	object, found := formatter.context.LocateObject(ref.ReferredPkg, ref.ReferredType)
+	if !found {
+		return false
+	}
+
+	var candidates ast.Types
+	switch {
+	case object.Type.IsRef():
+		candidates = ast.Types{object.Type}
+	case object.Type.IsStruct():
+		for _, field := range object.Type.AsStruct().Fields {
+			candidates = append(candidates, field.Type)
+		}
+	case object.Type.IsDisjunction():
+		candidates = object.Type.AsDisjunction().Branches
+	}
+
+	for _, candidate := range candidates {
+		if candidate.IsRef() && formatter.isRecursive(objectRef, candidate.AsRef(), visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func disjunctionName(def ast.Type) string {
+	names := make([]string, 0, len(def.AsDisjunction().Branches))
+	for _, branch := range def.AsDisjunction().Branches {
+		if branch.IsNull() {
+			continue
+		}
+
+		names = append(names, ast.TypeName(branch))
+	}
+
+	return strings.Join(names, "Or")
+}
+
+//nolint:gochecknoglobals
+var rustKeywords = []string{
+	"as", "async", "await", "break", "const", "continue", "dyn", "else", "enum", "extern",
+	"false", "fn", "for", "if", "impl", "in", "let", "loop", "match", "mod", "move", "mut",
+	"pub", "ref", "return", "static", "struct", "trait", "true", "type", "unsafe", "use",
+	"where", "while", "abstract", "become", "box", "do", "final", "macro", "override",
+	"priv", "try", "typeof", "unsized", "virtual", "yield",
+}
+
+func escapeIdentifier(name string) string {
+	// these keywords can not be used as raw identifiers
+	if name == "self" || name == "super" || name == "crate" {
+		return name + "_"
+	}
+
+	if tools.ItemInList(name, rustKeywords) {
+		return "r#" + name
+	}
+
+	return name
+}
+
+func formatFieldName(name string) string {
+	rgx := regexp.MustCompile("[^a-z0-9_]+")
+
+	fieldName := strings.Trim(rgx.ReplaceAllString(tools.SnakeCase(name), "_"), "_")
+	if fieldName == "" || (fieldName[0] >= '0' && fieldName[0] <= '9') {
+		fieldName = "field_" + fieldName
+	}
+
+	return escapeIdentifier(fieldName)
+}
+
+func formatTypeName(name string) string {
+	typeName := tools.UpperCamelCase(tools.CleanupNames(name))
+	if typeName == "" {
+		return "Empty"
+	}
+	if typeName[0] >= '0' && typeName[0] <= '9' {
+		return "Value" + typeName
+	}
+
+	return typeName
+}
+
+func formatModuleName(pkg string) string {
+	rgx := regexp.MustCompile("[^a-z0-9_]+")
+
+	return escapeIdentifier(rgx.ReplaceAllString(strings.ToLower(pkg), "_"))
+}
+
+func formatConstName(name string) string {
+	return tools.UpperSnakeCase(tools.CleanupNames(name))
+}
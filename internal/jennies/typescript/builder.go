@@ -13,6 +13,8 @@ import (
 )
 
 type Builder struct {
+	Config Config
+
 	imports          *common.DirectImportMap
 	typeImportMapper func(string) string
 	typeFormatter    *typeFormatter
@@ -64,7 +66,10 @@ func (jenny *Builder) generateBuilder(context common.Context, builder ast.Builde
 
 	err := templates.
 		Funcs(map[string]any{
-			"typeHasBuilder":              context.ResolveToBuilder,
+			"typeHasBuilder": context.ResolveToBuilder,
+			"accumulateErrors": func() bool {
+				return jenny.Config.AccumulateErrors
+			},
 			"typeIsDisjunctionOfBuilders": context.IsDisjunctionOfBuilders,
 			"formatType":                  jenny.typeFormatter.formatType,
 			"resolvesToComposableSlot": func(typeDef ast.Type) bool {
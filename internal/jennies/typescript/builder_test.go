@@ -24,3 +24,23 @@ func TestBuilder_Generate(t *testing.T) {
 		tc.WriteFiles(files)
 	})
 }
+
+func TestBuilder_Generate_accumulateErrors(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite{
+		TestDataRoot: "../../../testdata/jennies/builders",
+		Name:         "TypescriptBuilderAccumulateErrors",
+	}
+
+	jenny := Builder{
+		Config: Config{AccumulateErrors: true},
+	}
+
+	test.Run(t, func(tc *testutils.Test) {
+		req := require.New(tc)
+
+		files, err := jenny.Generate(tc.BuildersContext())
+		req.NoError(err)
+
+		tc.WriteFiles(files)
+	})
+}
@@ -0,0 +1,300 @@
+package typescript
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/tools"
+)
+
+var pathParamRegex = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Client generates, for each schema defining operations, an HTTP client
+// with one method per operation.
+type Client struct {
+	typeFormatter *typeFormatter
+}
+
+func (jenny Client) JennyName() string {
+	return "TypescriptClient"
+}
+
+func (jenny Client) Generate(context common.Context) (codejen.Files, error) {
+	files := codejen.Files{}
+
+	for _, schema := range context.Schemas {
+		if len(schema.Operations) == 0 {
+			continue
+		}
+
+		output, err := jenny.generateClient(context, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		filename := filepath.Join(
+			"src",
+			formatPackageName(schema.Package),
+			"client.gen.ts",
+		)
+
+		files = append(files, *codejen.NewFile(filename, output, jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny Client) generateClient(context common.Context, schema *ast.Schema) ([]byte, error) {
+	var buffer strings.Builder
+
+	imports := NewImportMap()
+	packageMapper := func(pkg string) string {
+		if imports.IsIdentical(pkg, schema.Package) {
+			return "types"
+		}
+
+		return imports.Add(pkg, fmt.Sprintf("../%s", pkg))
+	}
+	packageMapper("cog")
+
+	jenny.typeFormatter = defaultTypeFormatter(context, packageMapper)
+
+	buffer.WriteString(fmt.Sprintf(`// Client calls the operations exposed by the %[1]s API.
+export class Client {
+	private readonly baseURL: string;
+	private readonly fetchFn: typeof fetch;
+
+	constructor(baseURL: string, fetchFn: typeof fetch = fetch) {
+		this.baseURL = baseURL;
+		this.fetchFn = fetchFn;
+	}
+`, schema.Package))
+
+	for _, operation := range schema.Operations {
+		method, err := jenny.generateOperation(context, operation)
+		if err != nil {
+			return nil, fmt.Errorf("operation '%s': %w", operation.Name, err)
+		}
+
+		buffer.WriteString("\n")
+		buffer.WriteString(method)
+	}
+
+	buffer.WriteString("}\n")
+
+	return []byte(imports.String() + "import * as types from './types.gen';\n\n" + buffer.String()), nil
+}
+
+func (jenny Client) generateOperation(context common.Context, operation ast.Operation) (string, error) {
+	var buffer strings.Builder
+
+	var args []string
+	var params ast.StructType
+	if operation.Params != nil {
+		paramsObject, found := context.LocateObject(operation.Params.ReferredPkg, operation.Params.ReferredType)
+		if !found || !paramsObject.Type.IsStruct() {
+			return "", fmt.Errorf("could not locate params object '%s'", operation.Params.String())
+		}
+
+		params = paramsObject.Type.AsStruct()
+		args = append(args, "params: "+jenny.typeFormatter.formatType(ast.NewRef(operation.Params.ReferredPkg, operation.Params.ReferredType)))
+	}
+
+	if operation.RequestBody != nil {
+		optional := ""
+		if !operation.RequestBody.Required {
+			optional = "?"
+		}
+
+		args = append(args, fmt.Sprintf("body%s: %s", optional, jenny.typeFormatter.formatType(operation.RequestBody.Type)))
+	}
+
+	returnType := "void"
+	if success, ok := operation.SuccessResponse(); ok && success.Body != nil {
+		returnType = jenny.typeFormatter.formatType(success.Body.Type)
+	}
+
+	for _, comment := range operation.Comments {
+		buffer.WriteString(fmt.Sprintf("\t// %s\n", comment))
+	}
+	buffer.WriteString(fmt.Sprintf("\tasync %s(%s): Promise<%s> {\n", tools.LowerCamelCase(operation.Name), strings.Join(args, ", "), returnType))
+
+	path, err := jenny.formatPath(operation, params)
+	if err != nil {
+		return "", err
+	}
+
+	buffer.WriteString("\t\tconst request: cog.Request = {\n")
+	buffer.WriteString(fmt.Sprintf("\t\t\tmethod: %q,\n", operation.Method))
+	buffer.WriteString(fmt.Sprintf("\t\t\tpath: %s,\n", path))
+	buffer.WriteString("\t\t\tquery: new URLSearchParams(),\n")
+	buffer.WriteString("\t\t\theaders: new Headers(),\n")
+	buffer.WriteString("\t\t};\n")
+
+	for _, parameter := range operation.Parameters {
+		if parameter.In == ast.ParameterInPath {
+			continue
+		}
+
+		field, found := params.FieldByName(parameter.Name)
+		if !found {
+			return "", fmt.Errorf("could not locate parameter '%s'", parameter.Name)
+		}
+
+		buffer.WriteString(jenny.formatParameter(parameter, field))
+	}
+
+	if operation.RequestBody != nil {
+		buffer.WriteString(jenny.formatRequestBody(context, *operation.RequestBody))
+	}
+
+	buffer.WriteString("\n\t\tconst response = await cog.doRequest(this.fetchFn, this.baseURL, request);\n")
+
+	var defaultResponse *ast.OperationResponse
+	for i, response := range operation.Responses {
+		if response.Status == "default" {
+			defaultResponse = &operation.Responses[i]
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf("\t\tif (response.status === %s) {\n", response.Status))
+		buffer.WriteString(jenny.formatResponse(context, response, "\t\t\t"))
+		buffer.WriteString("\t\t}\n")
+	}
+
+	// a successful default response covers any 2XX status code
+	if defaultResponse != nil && defaultResponse.Success {
+		buffer.WriteString("\t\tif (response.ok) {\n")
+		buffer.WriteString(jenny.formatResponse(context, *defaultResponse, "\t\t\t"))
+		buffer.WriteString("\t\t}\n")
+	}
+
+	// any other status code is described by a non-successful default response
+	if defaultResponse != nil && !defaultResponse.Success {
+		buffer.WriteString(jenny.formatResponse(context, *defaultResponse, "\t\t"))
+	} else {
+		buffer.WriteString("\t\tthrow await cog.makeAPIError(response);\n")
+	}
+
+	buffer.WriteString("\t}\n")
+
+	return buffer.String(), nil
+}
+
+func (jenny Client) formatPath(operation ast.Operation, params ast.StructType) (string, error) {
+	var parts []string
+
+	last := 0
+	for _, match := range pathParamRegex.FindAllStringSubmatchIndex(operation.Path, -1) {
+		if prefix := operation.Path[last:match[0]]; prefix != "" {
+			parts = append(parts, fmt.Sprintf("%q", prefix))
+		}
+
+		name := operation.Path[match[2]:match[3]]
+		field, found := params.FieldByName(name)
+		if !found {
+			return "", fmt.Errorf("could not locate path parameter '%s'", name)
+		}
+
+		parts = append(parts, fmt.Sprintf("encodeURIComponent(String(%s))", fieldAccess("params", field.Name)))
+		last = match[1]
+	}
+
+	if suffix := operation.Path[last:]; suffix != "" || len(parts) == 0 {
+		parts = append(parts, fmt.Sprintf("%q", suffix))
+	}
+
+	return strings.Join(parts, " + "), nil
+}
+
+func (jenny Client) formatParameter(parameter ast.OperationParameter, field ast.StructField) string {
+	value := fieldAccess("params", field.Name)
+
+	add := func(value string) string {
+		switch parameter.In {
+		case ast.ParameterInQuery:
+			return fmt.Sprintf("request.query.append(%q, String(%s));", parameter.Name, value)
+		case ast.ParameterInHeader:
+			return fmt.Sprintf("request.headers.append(%q, String(%s));", parameter.Name, value)
+		default:
+			return fmt.Sprintf("request.headers.append(\"Cookie\", %q + encodeURIComponent(String(%s)));", parameter.Name+"=", value)
+		}
+	}
+
+	if field.Type.IsArray() {
+		if !field.Required {
+			value += " ?? []"
+		}
+
+		return fmt.Sprintf("\t\tfor (const item of %s) {\n\t\t\t%s\n\t\t}\n", value, add("item"))
+	}
+
+	if !field.Required {
+		return fmt.Sprintf("\t\tif (%s !== undefined) {\n\t\t\t%s\n\t\t}\n", value, add(value))
+	}
+
+	return fmt.Sprintf("\t\t%s\n", add(value))
+}
+
+func (jenny Client) formatRequestBody(context common.Context, body ast.OperationBody) string {
+	var buffer strings.Builder
+
+	indent := "\t\t"
+	if !body.Required {
+		buffer.WriteString("\t\tif (body !== undefined) {\n")
+		indent = "\t\t\t"
+	}
+
+	encoded := "JSON.stringify(body)"
+	if isRawBody(context, body) {
+		encoded = "body"
+	}
+
+	buffer.WriteString(fmt.Sprintf("%srequest.body = %s;\n", indent, encoded))
+	buffer.WriteString(fmt.Sprintf("%srequest.contentType = %q;\n", indent, body.ContentType))
+
+	if !body.Required {
+		buffer.WriteString("\t\t}\n")
+	}
+
+	return buffer.String()
+}
+
+func (jenny Client) formatResponse(context common.Context, response ast.OperationResponse, indent string) string {
+	if response.Body == nil {
+		if response.Success {
+			return indent + "return;\n"
+		}
+
+		return indent + "throw await cog.makeAPIError(response);\n"
+	}
+
+	decoded := fmt.Sprintf("await response.json() as %s", jenny.typeFormatter.formatType(response.Body.Type))
+	if isRawBody(context, *response.Body) {
+		decoded = "await response.text()"
+	}
+
+	if !response.Success {
+		return fmt.Sprintf("%sthrow new cog.APIError(response.status, %s);\n", indent, decoded)
+	}
+
+	return fmt.Sprintf("%sreturn %s;\n", indent, decoded)
+}
+
+// isRawBody tells whether a body should be sent or read as-is instead of
+// being encoded as JSON: it is the case for strings described with a non-JSON
+// content type.
+func isRawBody(context common.Context, body ast.OperationBody) bool {
+	if strings.Contains(body.ContentType, "json") {
+		return false
+	}
+
+	resolved := context.ResolveRefs(body.Type)
+
+	return resolved.IsScalar() && resolved.AsScalar().ScalarKind == ast.KindString
+}
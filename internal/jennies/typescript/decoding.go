@@ -0,0 +1,304 @@
+package typescript
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
+)
+
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*$`)
+
+/******************************************************************************
+* 					 		   JSON decoding 					 			  *
+******************************************************************************/
+
+// objectNeedsDecoder tells whether decoding an object from JSON requires more
+// than a `JSON.parse()`: composable slots are decoded using the variants
+// registered in cog's runtime. This also applies to objects referencing
+// (directly or not) such objects, including through discriminated
+// disjunctions.
+func (jenny RawTypes) objectNeedsDecoder(object ast.Object) bool {
+	key := object.SelfRef.String()
+	if needsDecoder, visited := jenny.decoders[key]; visited {
+		return needsDecoder
+	}
+
+	// guard against recursive references
+	jenny.decoders[key] = false
+
+	needsDecoder := false
+	if object.Type.IsStruct() {
+		needsDecoder = isPanelcfgVariantHost(object)
+
+		for _, field := range object.Type.AsStruct().Fields {
+			if jenny.typeNeedsDecoder(field.Type) {
+				needsDecoder = true
+			}
+		}
+	}
+
+	jenny.decoders[key] = needsDecoder
+
+	return needsDecoder
+}
+
+func (jenny RawTypes) typeNeedsDecoder(typeDef ast.Type) bool {
+	if slot, ok := jenny.context.ResolveToComposableSlot(typeDef); ok {
+		return slot.AsComposableSlot().Variant == ast.SchemaVariantDataQuery
+	}
+
+	if typeDef.IsArray() {
+		return jenny.typeNeedsDecoder(typeDef.AsArray().ValueType)
+	}
+
+	// only discriminated disjunctions can be decoded: the discriminator
+	// tells which branch's decoder to use.
+	if typeDef.IsDisjunction() {
+		disjunction := typeDef.AsDisjunction()
+		if disjunction.Discriminator == "" || len(disjunction.DiscriminatorMapping) == 0 {
+			return false
+		}
+
+		for _, branch := range disjunction.Branches {
+			if jenny.typeNeedsDecoder(branch) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if typeDef.IsRef() {
+		referredObject, found := jenny.context.LocateObject(typeDef.AsRef().ReferredPkg, typeDef.AsRef().ReferredType)
+
+		return found && jenny.objectNeedsDecoder(referredObject)
+	}
+
+	return false
+}
+
+func (jenny RawTypes) formatDecoder(object ast.Object, packageMapper pkgMapper) string {
+	var buffer strings.Builder
+
+	objectName := tools.CleanupNames(object.Name)
+	fields := object.Type.AsStruct().Fields
+
+	buffer.WriteString(fmt.Sprintf("export const %sFromJSON = (input: any): %s => {\n", tools.LowerCamelCase(objectName), objectName))
+	buffer.WriteString(fmt.Sprintf("\tconst resource: %s = { ...input };\n", objectName))
+
+	if jenny.hasDataqueryField(fields) {
+		if hint := dataqueryTypeHint(fields); hint != "" {
+			buffer.WriteString(fmt.Sprintf("\tconst dataqueryTypeHint = %s?.type ?? \"\";\n", fieldAccess("input", hint)))
+		} else {
+			buffer.WriteString("\tconst dataqueryTypeHint = \"\";\n")
+		}
+	}
+
+	if isPanelcfgVariantHost(object) {
+		buffer.WriteString(fmt.Sprintf("\tconst panelcfgConfig = %s.panelcfgConfig(input.type ?? \"\");\n", packageMapper("cog")))
+	}
+
+	for _, field := range fields {
+		input := fieldAccess("input", field.Name)
+		output := fieldAccess("resource", field.Name)
+
+		if isPanelcfgVariantHost(object) && field.Name == "options" {
+			buffer.WriteString(fmt.Sprintf(`
+	if (%[1]s && panelcfgConfig?.optionsFromJSON) {
+		%[2]s = panelcfgConfig.optionsFromJSON(%[1]s);
+	}
+`, input, output))
+			continue
+		}
+
+		if isPanelcfgVariantHost(object) && field.Name == "fieldConfig" {
+			buffer.WriteString(fmt.Sprintf(`
+	if (%[1]s?.defaults?.custom && panelcfgConfig?.fieldConfigFromJSON) {
+		%[2]s = {
+			...%[1]s,
+			defaults: {
+				...%[1]s.defaults,
+				custom: panelcfgConfig.fieldConfigFromJSON(%[1]s.defaults.custom),
+			},
+		};
+	}
+`, input, output))
+			continue
+		}
+
+		if !jenny.typeNeedsDecoder(field.Type) {
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf(`
+	if (%[1]s) {
+		%[2]s = %[3]s;
+	}
+`, input, output, jenny.decodeValue(field.Type, input, packageMapper)))
+	}
+
+	buffer.WriteString("\n\treturn resource;\n")
+	buffer.WriteString("};\n")
+
+	return buffer.String()
+}
+
+// decodeValue returns an expression decoding the given JSON value into
+// the given type.
+func (jenny RawTypes) decodeValue(typeDef ast.Type, value string, packageMapper pkgMapper) string {
+	if typeDef.IsComposableSlot() {
+		return fmt.Sprintf("%s.dataqueryFromJSON(%s, dataqueryTypeHint)", packageMapper("cog"), value)
+	}
+
+	if typeDef.IsArray() {
+		valueType := typeDef.AsArray().ValueType
+		if valueType.IsComposableSlot() {
+			return fmt.Sprintf("%s.dataqueriesFromJSON(%s, dataqueryTypeHint)", packageMapper("cog"), value)
+		}
+
+		return fmt.Sprintf("%s.map((item: any) => %s)", value, jenny.decodeValue(valueType, "item", packageMapper))
+	}
+
+	if typeDef.IsDisjunction() {
+		return jenny.decodeDisjunction(typeDef.AsDisjunction(), value, packageMapper)
+	}
+
+	ref := typeDef.AsRef()
+	referredObject, _ := jenny.context.LocateObject(ref.ReferredPkg, ref.ReferredType)
+	if referredObject.Type.IsComposableSlot() {
+		return jenny.decodeValue(referredObject.Type, value, packageMapper)
+	}
+
+	decoder := tools.LowerCamelCase(tools.CleanupNames(ref.ReferredType)) + "FromJSON"
+	if pkg := packageMapper(ref.ReferredPkg); pkg != "" {
+		decoder = pkg + "." + decoder
+	}
+
+	return fmt.Sprintf("%s(%s)", decoder, value)
+}
+
+// decodeDisjunction returns an expression decoding the given JSON value
+// using the decoder of the branch identified by the disjunction's
+// discriminator. Values matching no branch are left untouched.
+func (jenny RawTypes) decodeDisjunction(disjunction ast.DisjunctionType, value string, packageMapper pkgMapper) string {
+	decodeBranch := func(typeName string) string {
+		for _, branch := range disjunction.Branches {
+			if !branch.IsRef() || branch.AsRef().ReferredType != typeName {
+				continue
+			}
+
+			if !jenny.typeNeedsDecoder(branch) {
+				return value
+			}
+
+			return jenny.decodeValue(branch, value, packageMapper)
+		}
+
+		return value
+	}
+
+	discriminator := fieldAccess(value, disjunction.Discriminator)
+	decoded := value
+	if catchAll, ok := disjunction.DiscriminatorMapping[ast.DiscriminatorCatchAll]; ok {
+		decoded = decodeBranch(catchAll)
+	}
+
+	discriminatorValues := make([]string, 0, len(disjunction.DiscriminatorMapping))
+	for discriminatorValue := range disjunction.DiscriminatorMapping {
+		if discriminatorValue != ast.DiscriminatorCatchAll {
+			discriminatorValues = append(discriminatorValues, discriminatorValue)
+		}
+	}
+
+	// the expression is built from the inside out
+	sort.Sort(sort.Reverse(sort.StringSlice(discriminatorValues)))
+
+	for _, discriminatorValue := range discriminatorValues {
+		branchDecoder := decodeBranch(disjunction.DiscriminatorMapping[discriminatorValue])
+		if branchDecoder == decoded {
+			continue
+		}
+
+		decoded = fmt.Sprintf("%s === %q ? %s : %s", discriminator, discriminatorValue, branchDecoder, decoded)
+	}
+
+	return decoded
+}
+
+/******************************************************************************
+* 					 		   Variants 					 			  *
+******************************************************************************/
+
+func (jenny RawTypes) formatDataqueryVariantConfig(schema *ast.Schema, object ast.Object, packageMapper pkgMapper) string {
+	objectName := tools.CleanupNames(object.Name)
+
+	return fmt.Sprintf(`export const variantConfig = (): %[1]s.DataqueryConfig => {
+	return {
+		identifier: "%[2]s",
+		fromJSON: (input: any): %[3]s => ({ ...default%[4]s(), ...input }),
+	};
+};
+`, packageMapper("cog"), schema.Metadata.Identifier, objectName, tools.UpperCamelCase(objectName))
+}
+
+func (jenny RawTypes) formatPanelcfgVariantConfig(schema *ast.Schema, packageMapper pkgMapper) string {
+	var buffer strings.Builder
+
+	buffer.WriteString(fmt.Sprintf("export const variantConfig = (): %s.PanelcfgConfig => {\n", packageMapper("cog")))
+	buffer.WriteString("\treturn {\n")
+	buffer.WriteString(fmt.Sprintf("\t\tidentifier: \"%s\",\n", schema.Metadata.Identifier))
+
+	if _, hasOptions := schema.LocateObject("Options"); hasOptions {
+		buffer.WriteString("\t\toptionsFromJSON: (input: any): Options => ({ ...defaultOptions(), ...input }),\n")
+	}
+	if _, hasFieldConfig := schema.LocateObject("FieldConfig"); hasFieldConfig {
+		buffer.WriteString("\t\tfieldConfigFromJSON: (input: any): FieldConfig => ({ ...defaultFieldConfig(), ...input }),\n")
+	}
+
+	buffer.WriteString("\t};\n")
+	buffer.WriteString("};\n")
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) hasDataqueryField(fields []ast.StructField) bool {
+	for _, field := range fields {
+		if slot, ok := jenny.context.ResolveToComposableSlot(field.Type); ok && slot.AsComposableSlot().Variant == ast.SchemaVariantDataQuery {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dataqueryTypeHint locates a field that would contain the type of
+// datasource being used: a reference to the `DataSourceRef` type.
+func dataqueryTypeHint(fields []ast.StructField) string {
+	hint := ""
+	for _, candidate := range fields {
+		if candidate.Type.IsRef() && candidate.Type.AsRef().ReferredType == "DataSourceRef" {
+			hint = candidate.Name
+		}
+	}
+
+	return hint
+}
+
+// isPanelcfgVariantHost identifies objects (ie: panels) for which options
+// and field config are decoded using the panelcfg variants registered in
+// cog's runtime.
+func isPanelcfgVariantHost(object ast.Object) bool {
+	return object.Type.HasHint(ast.HintPanelcfgVariantHost)
+}
+
+func fieldAccess(root string, field string) string {
+	if identifierRegex.MatchString(field) {
+		return root + "." + field
+	}
+
+	return fmt.Sprintf("%s[%q]", root, field)
+}
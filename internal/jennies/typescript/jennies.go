@@ -9,14 +9,25 @@ import (
 
 const LanguageRef = "typescript"
 
+type Config struct {
+	// AccumulateErrors makes builders collect the errors raised by their
+	// options, instead of throwing them right away. Collected errors are
+	// thrown by `build()`.
+	AccumulateErrors bool
+}
+
 type Language struct {
+	config Config
 }
 
 func New() *Language {
-	return &Language{}
+	return &Language{
+		config: Config{},
+	}
 }
 
-func (language *Language) RegisterCliFlags(_ *cobra.Command) {
+func (language *Language) RegisterCliFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&language.config.AccumulateErrors, "typescript-accumulate-errors", false, "Collect errors raised by builder options and throw them all at once when calling build(), instead of throwing them right away.")
 }
 
 func (language *Language) Jennies(globalConfig common.Config) *codejen.JennyList[common.Context] {
@@ -25,9 +36,11 @@ func (language *Language) Jennies(globalConfig common.Config) *codejen.JennyList
 	})
 	jenny.AppendOneToMany(
 		Runtime{},
+		VariantsPlugins{},
 
 		common.If[common.Context](globalConfig.Types, RawTypes{}),
-		common.If[common.Context](globalConfig.Builders, &Builder{}),
+		common.If[common.Context](globalConfig.Types, Client{}),
+		common.If[common.Context](globalConfig.Builders, &Builder{Config: language.config}),
 
 		Index{Targets: globalConfig},
 	)
@@ -19,6 +19,10 @@ type pkgMapper func(string) string
 type RawTypes struct {
 	typeFormatter *typeFormatter
 	schemas       ast.Schemas
+	context       common.Context
+
+	// whether objects need a JSON decoder, indexed by reference.
+	decoders map[string]bool
 }
 
 func (jenny RawTypes) JennyName() string {
@@ -27,6 +31,8 @@ func (jenny RawTypes) JennyName() string {
 
 func (jenny RawTypes) Generate(context common.Context) (codejen.Files, error) {
 	jenny.schemas = context.Schemas
+	jenny.context = context
+	jenny.decoders = make(map[string]bool)
 	files := make(codejen.Files, 0, len(context.Schemas))
 
 	for _, schema := range context.Schemas {
@@ -71,11 +77,26 @@ func (jenny RawTypes) generateSchema(context common.Context, schema *ast.Schema)
 
 		buffer.Write(typeDefGen)
 		buffer.WriteString("\n")
+
+		if jenny.objectNeedsDecoder(object) {
+			buffer.WriteString(jenny.formatDecoder(object, packageMapper))
+			buffer.WriteString("\n")
+		}
+
+		if object.Type.ImplementedVariant() == string(ast.SchemaVariantDataQuery) && !object.Type.HasHint(ast.HintSkipVariantPluginRegistration) {
+			buffer.WriteString(jenny.formatDataqueryVariantConfig(schema, object, packageMapper))
+			buffer.WriteString("\n")
+		}
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	if schema.Metadata.Kind == ast.SchemaKindComposable && schema.Metadata.Variant == ast.SchemaVariantPanel {
+		buffer.WriteString(jenny.formatPanelcfgVariantConfig(schema, packageMapper))
+		buffer.WriteString("\n")
+	}
+
 	importStatements := imports.String()
 	if importStatements != "" {
 		importStatements += "\n\n"
@@ -335,30 +356,30 @@ func (jenny RawTypes) defaultValueForStructs(def ast.StructType, m *orderedmap.M
 		if m.Has(f.Name) {
 			switch x := m.Get(f.Name).(type) {
 			case map[string]any:
-				buffer.WriteString(fmt.Sprintf("%s: %v, ", f.Name, jenny.defaultValueForStructs(f.Type.AsStruct(), orderedmap.FromMap(x))))
+				buffer.WriteString(fmt.Sprintf("%s: %v, ", formatFieldName(f.Name), jenny.defaultValueForStructs(f.Type.AsStruct(), orderedmap.FromMap(x))))
 			case nil:
-				buffer.WriteString(fmt.Sprintf("%s: %v, ", f.Name, formatValue([]any{})))
+				buffer.WriteString(fmt.Sprintf("%s: %v, ", formatFieldName(f.Name), formatValue([]any{})))
 			default:
 				if f.Type.IsRef() {
 					ref := f.Type.AsRef()
 					referredType, refFound := jenny.schemas.LocateObject(ref.ReferredPkg, ref.ReferredType)
 
 					if refFound && referredType.Type.IsEnum() {
-						buffer.WriteString(fmt.Sprintf("%s: %v, ", f.Name, jenny.typeFormatter.formatEnumValue(referredType, x)))
+						buffer.WriteString(fmt.Sprintf("%s: %v, ", formatFieldName(f.Name), jenny.typeFormatter.formatEnumValue(referredType, x)))
 						continue
 					}
 				}
 
-				buffer.WriteString(fmt.Sprintf("%s: %v, ", f.Name, formatValue(x)))
+				buffer.WriteString(fmt.Sprintf("%s: %v, ", formatFieldName(f.Name), formatValue(x)))
 			}
 		} else if f.Required {
 			switch f.Type.Kind {
 			case ast.KindStruct:
-				buffer.WriteString(fmt.Sprintf("%s: { %v }, ", f.Name, defaultEmptyValuesForStructs(f.Type.AsStruct())))
+				buffer.WriteString(fmt.Sprintf("%s: { %v }, ", formatFieldName(f.Name), defaultEmptyValuesForStructs(f.Type.AsStruct())))
 			case ast.KindArray:
 				buffer.WriteString(fmt.Sprintf("%s: []", f.Name))
 			case ast.KindScalar:
-				buffer.WriteString(fmt.Sprintf("%s: %v, ", f.Name, defaultValueForScalar(f.Type.AsScalar())))
+				buffer.WriteString(fmt.Sprintf("%s: %v, ", formatFieldName(f.Name), defaultValueForScalar(f.Type.AsScalar())))
 			}
 		}
 	}
@@ -372,11 +393,11 @@ func defaultEmptyValuesForStructs(def ast.StructType) string {
 	for _, f := range def.Fields {
 		switch f.Type.Kind {
 		case ast.KindStruct:
-			buffer.WriteString(fmt.Sprintf("%s: { %v }, ", f.Name, defaultEmptyValuesForStructs(f.Type.AsStruct())))
+			buffer.WriteString(fmt.Sprintf("%s: { %v }, ", formatFieldName(f.Name), defaultEmptyValuesForStructs(f.Type.AsStruct())))
 		case ast.KindArray:
 			buffer.WriteString(fmt.Sprintf("%s: []", f.Name))
 		case ast.KindScalar:
-			buffer.WriteString(fmt.Sprintf("%s: %v, ", f.Name, defaultValueForScalar(f.Type.AsScalar())))
+			buffer.WriteString(fmt.Sprintf("%s: %v, ", formatFieldName(f.Name), defaultValueForScalar(f.Type.AsScalar())))
 		default:
 		}
 	}
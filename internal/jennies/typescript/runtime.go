@@ -12,25 +12,226 @@ func (jenny Runtime) JennyName() string {
 	return "TypescriptRuntime"
 }
 
-func (jenny Runtime) Generate(_ common.Context) (codejen.Files, error) {
-	return codejen.Files{
+func (jenny Runtime) Generate(context common.Context) (codejen.Files, error) {
+	withHTTP := hasOperations(context)
+
+	files := codejen.Files{
 		*codejen.NewFile("src/cog/variants_gen.ts", []byte(jenny.generateVariantsFile()), jenny),
 		*codejen.NewFile("src/cog/builder_gen.ts", []byte(jenny.generateOptionsBuilderFile()), jenny),
-		*codejen.NewFile("src/cog/index.ts", []byte(jenny.generateIndexFile()), jenny),
-	}, nil
+		*codejen.NewFile("src/cog/runtime_gen.ts", []byte(jenny.generateRuntimeFile()), jenny),
+		*codejen.NewFile("src/cog/errors_gen.ts", []byte(jenny.generateErrorsFile()), jenny),
+		*codejen.NewFile("src/cog/index.ts", []byte(jenny.generateIndexFile(withHTTP)), jenny),
+	}
+
+	if withHTTP {
+		files = append(files, *codejen.NewFile("src/cog/http_gen.ts", []byte(jenny.generateHTTPFile()), jenny))
+	}
+
+	return files, nil
 }
 
-func (jenny Runtime) generateIndexFile() string {
-	return `export * from './variants_gen';
+func (jenny Runtime) generateIndexFile(withHTTP bool) string {
+	index := `export * from './variants_gen';
 export * from './builder_gen';
+export * from './runtime_gen';
+export * from './errors_gen';
+`
+
+	if withHTTP {
+		index += "export * from './http_gen';\n"
+	}
+
+	return index
+}
+
+func (jenny Runtime) generateHTTPFile() string {
+	return `export interface Request {
+	method: string;
+	path: string;
+	query: URLSearchParams;
+	headers: Headers;
+	body?: string;
+	contentType?: string;
+}
+
+// APIError is thrown by API clients when an operation does not succeed.
+export class APIError extends Error {
+	readonly status: number;
+	// Decoded body of the response if its type is documented by the API,
+	// or its raw content otherwise.
+	readonly body: unknown;
+
+	constructor(status: number, body: unknown) {
+		super("unexpected response status: " + status);
+
+		this.name = "APIError";
+		this.status = status;
+		this.body = body;
+	}
+}
+
+export const makeAPIError = async (response: Response): Promise<APIError> => {
+	return new APIError(response.status, await response.text());
+};
+
+export const doRequest = async (fetchFn: typeof fetch, baseURL: string, request: Request): Promise<Response> => {
+	let url = baseURL.replace(/\/+$/, "") + request.path;
+	const query = request.query.toString();
+	if (query !== "") {
+		url += "?" + query;
+	}
+
+	if (request.contentType !== undefined) {
+		request.headers.set("Content-Type", request.contentType);
+	}
+
+	return fetchFn(url, {
+		method: request.method,
+		headers: request.headers,
+		body: request.body,
+	});
+};
 `
 }
 
+func hasOperations(context common.Context) bool {
+	for _, schema := range context.Schemas {
+		if len(schema.Operations) != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (jenny Runtime) generateVariantsFile() string {
 	return `export interface Dataquery {
 	_implementsDataqueryVariant(): void;
 }
 
+export interface Panelcfg {
+	_implementsPanelcfgVariant(): void;
+}
+
+export interface PanelcfgConfig {
+	identifier: string;
+	optionsFromJSON?: (input: any) => any;
+	fieldConfigFromJSON?: (input: any) => any;
+}
+
+export interface DataqueryConfig {
+	identifier: string;
+	fromJSON: (input: any) => Dataquery;
+}
+
+export interface UnknownDataquery extends Dataquery {
+	[key: string]: any;
+}
+
+`
+}
+
+func (jenny Runtime) generateErrorsFile() string {
+	return `export interface BuildError {
+	path: string;
+	message: string;
+}
+
+export class BuildErrors extends Error {
+	readonly errors: BuildError[];
+
+	constructor(errors: BuildError[]) {
+		super(errors.map(error => error.path + ": " + error.message).join("\n"));
+
+		this.name = "BuildErrors";
+		this.errors = errors;
+	}
+}
+
+export const makeBuildErrors = (rootPath: string, err: unknown): BuildErrors => {
+	if (err instanceof BuildErrors) {
+		return new BuildErrors(err.errors.map(error => ({
+			path: rootPath + "." + error.path,
+			message: error.message,
+		})));
+	}
+
+	const message = err instanceof Error ? err.message : String(err);
+
+	return new BuildErrors([{ path: rootPath, message: message }]);
+};
+`
+}
+
+func (jenny Runtime) generateRuntimeFile() string {
+	return `import type { Dataquery, DataqueryConfig, PanelcfgConfig, UnknownDataquery } from './variants_gen';
+
+export class Runtime {
+	private static instance: Runtime | undefined;
+
+	private readonly panelcfgVariants: Map<string, PanelcfgConfig> = new Map();
+	private readonly dataqueryVariants: Map<string, DataqueryConfig> = new Map();
+
+	static getInstance(): Runtime {
+		if (Runtime.instance === undefined) {
+			Runtime.instance = new Runtime();
+		}
+
+		return Runtime.instance;
+	}
+
+	registerPanelcfgVariant(config: PanelcfgConfig): void {
+		this.panelcfgVariants.set(config.identifier, config);
+	}
+
+	panelcfgConfig(identifier: string): PanelcfgConfig | undefined {
+		return this.panelcfgVariants.get(identifier);
+	}
+
+	registerDataqueryVariant(config: DataqueryConfig): void {
+		this.dataqueryVariants.set(config.identifier, config);
+	}
+
+	dataqueriesFromJSON(input: any[], dataqueryTypeHint: string): Dataquery[] {
+		return input.map((dataquery: any) => this.dataqueryFromJSON(dataquery, dataqueryTypeHint));
+	}
+
+	dataqueryFromJSON(input: any, dataqueryTypeHint: string): Dataquery {
+		// A hint tells us the dataquery type: let's use it.
+		const config = dataqueryTypeHint !== "" ? this.dataqueryVariants.get(dataqueryTypeHint) : undefined;
+		if (config !== undefined) {
+			return config.fromJSON(input);
+		}
+
+		// We have no idea what type the dataquery is: use our 'UnknownDataquery' bag to not lose data.
+		const dataquery: UnknownDataquery = {
+			...input,
+			_implementsDataqueryVariant: () => {},
+		};
+
+		return dataquery;
+	}
+}
+
+export const registerPanelcfgVariant = (config: PanelcfgConfig): void => {
+	Runtime.getInstance().registerPanelcfgVariant(config);
+};
+
+export const registerDataqueryVariant = (config: DataqueryConfig): void => {
+	Runtime.getInstance().registerDataqueryVariant(config);
+};
+
+export const panelcfgConfig = (identifier: string): PanelcfgConfig | undefined => {
+	return Runtime.getInstance().panelcfgConfig(identifier);
+};
+
+export const dataqueriesFromJSON = (input: any[], dataqueryTypeHint: string): Dataquery[] => {
+	return Runtime.getInstance().dataqueriesFromJSON(input, dataqueryTypeHint);
+};
+
+export const dataqueryFromJSON = (input: any, dataqueryTypeHint: string): Dataquery => {
+	return Runtime.getInstance().dataqueryFromJSON(input, dataqueryTypeHint);
+};
 `
 }
 
@@ -14,5 +14,5 @@ func TestRuntime(t *testing.T) {
 	files, err := jenny.Generate(common.Context{})
 	req.NoError(err)
 
-	req.Len(files, 3)
+	req.Len(files, 5)
 }
@@ -37,6 +37,9 @@ func init() {
 			"formatValue": func(destinationType ast.Type, value any) string {
 				panic("formatValue() needs to be overridden by a jenny")
 			},
+			"accumulateErrors": func() bool {
+				panic("accumulateErrors() needs to be overridden by a jenny")
+			},
 		})
 	templates = template.Must(cogtemplate.FindAndParseTemplates(templatesFS, base, "templates"))
 }
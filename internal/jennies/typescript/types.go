@@ -119,6 +119,10 @@ func (formatter *typeFormatter) formatStructFields(structType ast.Type) string {
 		)
 	}
 
+	if structType.AsStruct().AdditionalProperties != nil {
+		buffer.WriteString(fmt.Sprintf("\t[key: string]: %s;\n", formatter.formatAdditionalProperties(structType.AsStruct())))
+	}
+
 	if structType.ImplementsVariant() {
 		variant := tools.UpperCamelCase(structType.ImplementedVariant())
 		buffer.WriteString(fmt.Sprintf("\t_implements%sVariant(): void;\n", variant))
@@ -129,6 +133,26 @@ func (formatter *typeFormatter) formatStructFields(structType ast.Type) string {
 	return buffer.String()
 }
 
+// formatAdditionalProperties returns the type of the index signature
+// describing additional properties. Since every declared field must
+// satisfy the index signature, their types are included in it.
+func (formatter *typeFormatter) formatAdditionalProperties(def ast.StructType) string {
+	valueType := def.AdditionalProperties.AsMap().ValueType
+	types := []string{formatter.doFormatType(valueType, false)}
+	if valueType.IsAny() {
+		return types[0]
+	}
+
+	for _, field := range def.Fields {
+		fieldType := formatter.doFormatType(field.Type, false)
+		if !tools.ItemInList(fieldType, types) {
+			types = append(types, fieldType)
+		}
+	}
+
+	return strings.Join(types, " | ")
+}
+
 func (formatter *typeFormatter) formatField(def ast.StructField) string {
 	var buffer strings.Builder
 
@@ -145,7 +169,7 @@ func (formatter *typeFormatter) formatField(def ast.StructField) string {
 
 	buffer.WriteString(fmt.Sprintf(
 		"%s%s: %s;\n",
-		def.Name,
+		formatFieldName(def.Name),
 		required,
 		formattedType,
 	))
@@ -294,7 +318,7 @@ func formatValue(val any) string {
 		buffer.WriteString("{\n")
 
 		for key, value := range mapVal {
-			buffer.WriteString(fmt.Sprintf("\t%s: %s,\n", key, formatValue(value)))
+			buffer.WriteString(fmt.Sprintf("\t%s: %s,\n", formatFieldName(key), formatValue(value)))
 		}
 
 		buffer.WriteString("}")
@@ -306,7 +330,7 @@ func formatValue(val any) string {
 		buffer.WriteString("{\n")
 
 		orderedMap.Iterate(func(key string, value any) {
-			buffer.WriteString(fmt.Sprintf("\t%s: %s,\n", key, formatValue(value)))
+			buffer.WriteString(fmt.Sprintf("\t%s: %s,\n", formatFieldName(key), formatValue(value)))
 		})
 
 		buffer.WriteString("}")
@@ -317,6 +341,15 @@ func formatValue(val any) string {
 	return fmt.Sprintf("%#v", val)
 }
 
+// formatFieldName quotes field names that aren't valid identifiers.
+func formatFieldName(name string) string {
+	if identifierRegex.MatchString(name) {
+		return name
+	}
+
+	return fmt.Sprintf("%q", name)
+}
+
 func formatPackageName(pkg string) string {
 	return tools.LowerCamelCase(pkg)
 }
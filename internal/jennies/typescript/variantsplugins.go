@@ -0,0 +1,71 @@
+package typescript
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+)
+
+type VariantsPlugins struct {
+}
+
+func (jenny VariantsPlugins) JennyName() string {
+	return "TypescriptVariantsPlugins"
+}
+
+func (jenny VariantsPlugins) Generate(context common.Context) (codejen.Files, error) {
+	return codejen.Files{
+		*codejen.NewFile("src/cog/plugins_gen.ts", []byte(jenny.variantPlugins(context)), jenny),
+	}, nil
+}
+
+func (jenny VariantsPlugins) variantPlugins(context common.Context) string {
+	var buffer strings.Builder
+	var panelSchemas []*ast.Schema
+	var dataquerySchemas []*ast.Schema
+
+	imports := NewImportMap()
+	imports.Add("cog", "../cog")
+
+	for _, schema := range context.Schemas {
+		if schema.Metadata.Kind != ast.SchemaKindComposable || schema.Metadata.Identifier == "" {
+			continue
+		}
+
+		if schema.Metadata.Variant == ast.SchemaVariantPanel {
+			panelSchemas = append(panelSchemas, schema)
+		} else if schema.Metadata.Variant == ast.SchemaVariantDataQuery {
+			dataquerySchemas = append(dataquerySchemas, schema)
+		}
+	}
+
+	// to guarantee a consistent output for this jenny
+	sort.SliceStable(panelSchemas, func(i, j int) bool {
+		return panelSchemas[i].Package < panelSchemas[j].Package
+	})
+	sort.SliceStable(dataquerySchemas, func(i, j int) bool {
+		return dataquerySchemas[i].Package < dataquerySchemas[j].Package
+	})
+
+	buffer.WriteString("export const registerDefaultPlugins = (): void => {\n")
+
+	buffer.WriteString("\t// Panelcfg variants\n")
+	for _, schema := range panelSchemas {
+		pkg := imports.Add(schema.Package, fmt.Sprintf("../%s", schema.Package))
+		buffer.WriteString(fmt.Sprintf("\tcog.registerPanelcfgVariant(%s.variantConfig());\n", pkg))
+	}
+
+	buffer.WriteString("\n\t// Dataquery variants\n")
+	for _, schema := range dataquerySchemas {
+		pkg := imports.Add(schema.Package, fmt.Sprintf("../%s", schema.Package))
+		buffer.WriteString(fmt.Sprintf("\tcog.registerDataqueryVariant(%s.variantConfig());\n", pkg))
+	}
+
+	buffer.WriteString("};\n")
+
+	return imports.String() + "\n" + buffer.String()
+}
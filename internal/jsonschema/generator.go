@@ -5,6 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	neturl "net/url"
+	"path"
+	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -30,77 +34,199 @@ type Config struct {
 	// Package name used to generate code into.
 	Package string
 
+	// URL of the schema being parsed: a file path or URI.
+	// Relative references to external schemas are resolved against it.
+	// Optional, defaults to "schema".
+	URL string
+
+	// PackageMapping associates external schemas, identified by their
+	// file path or URI, to the package their definitions will be generated into.
+	// External schemas that aren't mapped are given a package name derived
+	// from their filename.
+	PackageMapping map[string]string
+
 	SchemaMetadata ast.SchemaMeta
 }
 
+func (c Config) schemaURL() string {
+	if c.URL == "" {
+		return "schema"
+	}
+
+	return c.URL
+}
+
 type generator struct {
+	config Config
+
+	// schema for the document being parsed.
+	schema *ast.Schema
+	// URL of the document being parsed.
+	schemaURL string
+
+	// schemas for the external documents that are referenced by the
+	// document being parsed, indexed by URL.
+	externalSchemas map[string]*ast.Schema
+	// external schemas URLs, in the order in which they were discovered.
+	externalURLs []string
+
+	// seen definitions, identified by package and name.
+	seen map[string]struct{}
+
+	// definitions currently being walked, innermost last.
+	definitions []definitionContext
+}
+
+type definitionContext struct {
 	schema *ast.Schema
-	seen   map[string]struct{}
+	name   string
+	// properties being walked within the definition, outermost first.
+	path []string
 }
 
-func GenerateAST(schemaReader io.Reader, c Config) (*ast.Schema, error) {
+// GenerateAST parses the given JSON schema. The returned list always contains
+// the schema for the parsed document first, followed by one schema per
+// external document that it references.
+func GenerateAST(schemaReader io.Reader, c Config) (ast.Schemas, error) {
 	g := &generator{
-		seen:   make(map[string]struct{}),
-		schema: ast.NewSchema(c.Package, c.SchemaMetadata),
+		config:          c,
+		seen:            make(map[string]struct{}),
+		schema:          ast.NewSchema(c.Package, c.SchemaMetadata),
+		externalSchemas: make(map[string]*ast.Schema),
+	}
+
+	schemaReader, err := dependentRequiredAsDependencies(schemaReader)
+	if err != nil {
+		return nil, fmt.Errorf("[%s] %w", c.Package, err)
 	}
 
 	compiler := schemaparser.NewCompiler()
 	compiler.ExtractAnnotations = true
-	if err := compiler.AddResource("schema", schemaReader); err != nil {
+	if err := compiler.AddResource(c.schemaURL(), schemaReader); err != nil {
 		return nil, fmt.Errorf("[%s] %w", c.Package, err)
 	}
 
-	schema, err := compiler.Compile("schema")
+	schema, err := compiler.Compile(c.schemaURL())
 	if err != nil {
 		return nil, fmt.Errorf("[%s] %w", c.Package, err)
 	}
 
+	g.schemaURL = schema.URL
+
 	rootObjectName := c.Package
 
 	// The root of the schema is an actual type/object
 	if schema.Ref == nil {
-		if err := g.declareDefinition(rootObjectName, schema); err != nil {
+		if err := g.declareDefinition(g.schema, rootObjectName, schema); err != nil {
 			return nil, fmt.Errorf("[%s] %w", c.Package, err)
 		}
 	} else {
 		rootObjectName = g.definitionNameFromRef(schema)
 
 		// The root of the schema contains definitions, and a reference to the "main" object
-		if err := g.declareDefinition(rootObjectName, schema.Ref); err != nil {
+		if err := g.declareDefinition(g.schemaForURL(schema.Ref.URL), rootObjectName, schema.Ref); err != nil {
 			return nil, fmt.Errorf("[%s] %w", c.Package, err)
 		}
 	}
 
 	if c.SchemaMetadata.Variant == ast.SchemaVariantDataQuery || c.SchemaMetadata.Variant == ast.SchemaVariantPanel {
-		g.schema.Objects.Get(rootObjectName).Type.Hints[ast.HintImplementsVariant] = string(c.SchemaMetadata.Variant)
+		if g.schema.Objects.Has(rootObjectName) {
+			g.schema.Objects.Get(rootObjectName).Type.Hints[ast.HintImplementsVariant] = string(c.SchemaMetadata.Variant)
+		}
 	}
 
 	g.schema.EntryPoint = rootObjectName
 
+	schemas := make(ast.Schemas, 0, len(g.externalURLs)+1)
+	schemas = append(schemas, g.schema)
+	for _, url := range g.externalURLs {
+		schemas = append(schemas, g.externalSchemas[url])
+	}
+
 	// To ensure a consistent output, since github.com/santhosh-tekuri/jsonschema
 	// doesn't guarantee the order of the definitions it parses.
-	g.schema.Objects.Sort(orderedmap.SortStrings)
+	for _, s := range schemas {
+		s.Objects.Sort(orderedmap.SortStrings)
+	}
+
+	return schemas, nil
+}
+
+// schemaForURL returns the schema in which definitions coming from
+// the document identified by the given URL should be declared.
+func (g *generator) schemaForURL(url string) *ast.Schema {
+	if url == g.schemaURL {
+		return g.schema
+	}
+
+	if schema, found := g.externalSchemas[url]; found {
+		return schema
+	}
+
+	schema := ast.NewSchema(g.packageForURL(url), ast.SchemaMeta{})
+	g.externalSchemas[url] = schema
+	g.externalURLs = append(g.externalURLs, url)
+
+	return schema
+}
+
+func (g *generator) packageForURL(url string) string {
+	normalizedURL := normalizeLocation(url)
+	for location, pkg := range g.config.PackageMapping {
+		if normalizeLocation(location) == normalizedURL {
+			return pkg
+		}
+	}
+
+	filename := path.Base(filepath.ToSlash(strings.TrimPrefix(url, "file://")))
+	if parsedURL, err := neturl.Parse(url); err == nil && parsedURL.IsAbs() {
+		filename = path.Base(parsedURL.Path)
+	}
+
+	return tools.CleanupNames(strings.TrimSuffix(filename, path.Ext(filename)))
+}
+
+// normalizeLocation turns file paths into absolute paths, so that schemas
+// can be identified regardless of how their location was written.
+func normalizeLocation(location string) string {
+	location = strings.TrimSuffix(location, "#")
+
+	if parsedURL, err := neturl.Parse(location); err == nil && parsedURL.IsAbs() {
+		if parsedURL.Scheme != "file" {
+			return location
+		}
+
+		location = parsedURL.Path
+	}
+
+	absolutePath, err := filepath.Abs(location)
+	if err != nil {
+		return filepath.Clean(location)
+	}
 
-	return g.schema, nil
+	return absolutePath
 }
 
-func (g *generator) declareDefinition(definitionName string, schema *schemaparser.Schema) error {
-	if _, found := g.seen[definitionName]; found {
+func (g *generator) declareDefinition(targetSchema *ast.Schema, definitionName string, schema *schemaparser.Schema) error {
+	definitionID := targetSchema.Package + "." + definitionName
+	if _, found := g.seen[definitionID]; found {
 		return nil
 	}
 
-	g.seen[definitionName] = struct{}{}
+	g.seen[definitionID] = struct{}{}
 
+	g.definitions = append(g.definitions, definitionContext{schema: targetSchema, name: definitionName})
 	def, err := g.walkDefinition(schema)
+	g.definitions = g.definitions[:len(g.definitions)-1]
 	if err != nil {
 		return fmt.Errorf("%s: %w", definitionName, err)
 	}
 
-	g.schema.AddObject(ast.Object{
+	targetSchema.AddObject(ast.Object{
 		Name: definitionName,
 		Type: def,
 		SelfRef: ast.RefType{
-			ReferredPkg:  g.schema.Package,
+			ReferredPkg:  targetSchema.Package,
 			ReferredType: definitionName,
 		},
 	})
@@ -129,7 +255,7 @@ func (g *generator) walkDefinition(schema *schemaparser.Schema) (ast.Type, error
 			return g.walkAllOf(schema)
 		}
 
-		if schema.Properties != nil || schema.PatternProperties != nil || schema.AdditionalProperties != nil {
+		if schema.Properties != nil || schema.PatternProperties != nil || schema.AdditionalProperties != nil || schema.If != nil {
 			return g.walkObject(schema)
 		}
 
@@ -281,12 +407,13 @@ func (g *generator) definitionNameFromRef(schema *schemaparser.Schema) string {
 func (g *generator) walkRef(schema *schemaparser.Schema) (ast.Type, error) {
 	referredKindName := g.definitionNameFromRef(schema)
 
-	if err := g.declareDefinition(referredKindName, schema.Ref); err != nil {
+	targetSchema := g.schemaForURL(schema.Ref.URL)
+
+	if err := g.declareDefinition(targetSchema, referredKindName, schema.Ref); err != nil {
 		return ast.Type{}, err
 	}
 
-	// TODO: get the correct package for the referred type
-	return ast.NewRef(g.schema.Package, referredKindName), nil
+	return ast.NewRef(targetSchema.Package, referredKindName), nil
 }
 
 func (g *generator) walkString(schema *schemaparser.Schema) (ast.Type, error) {
@@ -383,7 +510,28 @@ func (g *generator) walkList(schema *schemaparser.Schema) (ast.Type, error) {
 		}
 	}
 
-	return ast.NewArray(itemsDef, ast.Default(schema.Default)), nil
+	def := ast.NewArray(itemsDef, ast.Default(schema.Default))
+
+	if schema.MinItems != -1 {
+		def.Array.Constraints = append(def.Array.Constraints, ast.TypeConstraint{
+			Op:   ast.MinItemsOp,
+			Args: []any{schema.MinItems},
+		})
+	}
+	if schema.MaxItems != -1 {
+		def.Array.Constraints = append(def.Array.Constraints, ast.TypeConstraint{
+			Op:   ast.MaxItemsOp,
+			Args: []any{schema.MaxItems},
+		})
+	}
+	if schema.UniqueItems {
+		def.Array.Constraints = append(def.Array.Constraints, ast.TypeConstraint{
+			Op:   ast.UniqueItemsOp,
+			Args: []any{true},
+		})
+	}
+
+	return def, nil
 }
 
 func (g *generator) walkEnum(schema *schemaparser.Schema) (ast.Type, error) {
@@ -410,27 +558,55 @@ func (g *generator) walkEnum(schema *schemaparser.Schema) (ast.Type, error) {
 }
 
 func (g *generator) walkObject(schema *schemaparser.Schema) (ast.Type, error) {
-	if len(schema.Properties) == 0 {
-		// `schema.AdditionalProperties` is nil or false or *schemaparser.Schema
-		_, ok := schema.AdditionalProperties.(bool)
-		if schema.AdditionalProperties == nil || ok {
+	additionalProperties, err := g.walkAdditionalProperties(schema)
+	if err != nil {
+		return ast.Type{}, err
+	}
+
+	if len(schema.Properties) == 0 && schema.If == nil {
+		if additionalProperties == nil {
 			return ast.Any(), nil
 		}
 
-		valueType, err := g.walkDefinition(schema.AdditionalProperties.(*schemaparser.Schema))
-		if err != nil {
-			return ast.Type{}, err
+		def := *additionalProperties
+
+		if schema.MinProperties != -1 {
+			def.Map.Constraints = append(def.Map.Constraints, ast.TypeConstraint{
+				Op:   ast.MinPropertiesOp,
+				Args: []any{schema.MinProperties},
+			})
+		}
+		if schema.MaxProperties != -1 {
+			def.Map.Constraints = append(def.Map.Constraints, ast.TypeConstraint{
+				Op:   ast.MaxPropertiesOp,
+				Args: []any{schema.MaxProperties},
+			})
 		}
 
-		return ast.NewMap(ast.String(), valueType), nil
+		return def, nil
+	}
+
+	fields, err := g.walkProperties(schema)
+	if err != nil {
+		return ast.Type{}, err
+	}
+
+	def := ast.NewStruct(fields...)
+	def.Struct.AdditionalProperties = additionalProperties
+
+	if schema.If != nil {
+		return g.walkConditional(schema, def)
 	}
 
-	// TODO: finish implementation
+	return def, nil
+}
+
+func (g *generator) walkProperties(schema *schemaparser.Schema) ([]ast.StructField, error) {
 	fields := make([]ast.StructField, 0, len(schema.Properties))
 	for name, property := range schema.Properties {
-		fieldDef, err := g.walkDefinition(property)
+		fieldDef, err := g.walkProperty(name, property)
 		if err != nil {
-			return ast.Type{}, fmt.Errorf("%s: %w", name, err)
+			return nil, fmt.Errorf("%s: %w", name, err)
 		}
 
 		fields = append(fields, ast.StructField{
@@ -441,10 +617,246 @@ func (g *generator) walkObject(schema *schemaparser.Schema) (ast.Type, error) {
 		})
 	}
 
+	addDependentRequiredComments(fields, schema.Dependencies)
+
 	// To ensure consistent outputs
 	sort.Slice(fields, func(i, j int) bool {
 		return fields[i].Name < fields[j].Name
 	})
 
-	return ast.NewStruct(fields...), nil
+	return fields, nil
+}
+
+func (g *generator) walkProperty(name string, property *schemaparser.Schema) (ast.Type, error) {
+	if len(g.definitions) == 0 {
+		return g.walkDefinition(property)
+	}
+
+	current := len(g.definitions) - 1
+	g.definitions[current].path = append(g.definitions[current].path, name)
+	defer func() {
+		g.definitions[current].path = g.definitions[current].path[:len(g.definitions[current].path)-1]
+	}()
+
+	return g.walkDefinition(property)
+}
+
+// walkAdditionalProperties returns a map describing the properties of an
+// object that aren't explicitly listed in its `properties`: the ones matching
+// `patternProperties` and the ones allowed by `additionalProperties`.
+// If the object doesn't accept such properties, nil is returned.
+func (g *generator) walkAdditionalProperties(schema *schemaparser.Schema) (*ast.Type, error) {
+	patterns := make([]string, 0, len(schema.PatternProperties))
+	schemasByPattern := make(map[string]*schemaparser.Schema, len(schema.PatternProperties))
+	for pattern, patternSchema := range schema.PatternProperties {
+		patterns = append(patterns, pattern.String())
+		schemasByPattern[pattern.String()] = patternSchema
+	}
+
+	// To ensure consistent outputs
+	sort.Strings(patterns)
+
+	valueTypes := make([]ast.Type, 0, len(patterns)+1)
+	for _, pattern := range patterns {
+		valueType, err := g.walkDefinition(schemasByPattern[pattern])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", pattern, err)
+		}
+
+		valueTypes = appendDistinctType(valueTypes, valueType)
+	}
+
+	// `schema.AdditionalProperties` is nil or false or *schemaparser.Schema
+	additionalSchema, hasAdditionalSchema := schema.AdditionalProperties.(*schemaparser.Schema)
+	if hasAdditionalSchema {
+		valueType, err := g.walkDefinition(additionalSchema)
+		if err != nil {
+			return nil, err
+		}
+
+		valueTypes = appendDistinctType(valueTypes, valueType)
+	}
+
+	if len(valueTypes) == 0 {
+		return nil, nil
+	}
+
+	indexType := ast.String()
+	if len(patterns) == 1 && !hasAdditionalSchema {
+		indexType.Scalar.Constraints = append(indexType.Scalar.Constraints, ast.TypeConstraint{
+			Op:   ast.PatternOp,
+			Args: []any{patterns[0]},
+		})
+	}
+
+	valueType := valueTypes[0]
+	if len(valueTypes) > 1 {
+		valueType = ast.NewDisjunction(valueTypes)
+	}
+
+	def := ast.NewMap(indexType, valueType)
+
+	return &def, nil
+}
+
+// walkConditional turns an object with `if`/`then`/`else` subschemas into a
+// disjunction of the shapes this object can take.
+// If the `if` subschema checks a property against a constant value, this
+// property is used as discriminator and the branches are declared as objects
+// named after the definition and the property being walked.
+func (g *generator) walkConditional(schema *schemaparser.Schema, base ast.Type) (ast.Type, error) {
+	thenDef, err := g.mergeProperties(base, schema.Then)
+	if err != nil {
+		return ast.Type{}, fmt.Errorf("then: %w", err)
+	}
+
+	elseDef, err := g.mergeProperties(base, schema.Else)
+	if err != nil {
+		return ast.Type{}, fmt.Errorf("else: %w", err)
+	}
+
+	discriminator, discriminatorSchema, found := conditionalDiscriminator(schema.If)
+	if !found || len(g.definitions) == 0 {
+		return ast.NewDisjunction(ast.Types{thenDef, elseDef}), nil
+	}
+
+	value, err := g.walkUntypedConstant(discriminatorSchema)
+	if err != nil {
+		return ast.Type{}, fmt.Errorf("if: %w", err)
+	}
+
+	thenDef = withDiscriminatorField(thenDef, discriminator, value)
+
+	definition := g.definitions[len(g.definitions)-1]
+	prefix := definition.name
+	for _, property := range definition.path {
+		prefix += tools.UpperCamelCase(property)
+	}
+
+	thenName := uniqueObjectName(definition.schema, prefix+tools.CleanupNames(tools.UpperCamelCase(fmt.Sprintf("%v", value.AsScalar().Value))))
+	definition.schema.AddObject(ast.NewObject(definition.schema.Package, thenName, thenDef))
+
+	elseName := uniqueObjectName(definition.schema, prefix+"Else")
+	definition.schema.AddObject(ast.NewObject(definition.schema.Package, elseName, elseDef))
+
+	disjunction := ast.NewDisjunction(ast.Types{
+		ast.NewRef(definition.schema.Package, thenName),
+		ast.NewRef(definition.schema.Package, elseName),
+	})
+	disjunction.Disjunction.Discriminator = discriminator
+	disjunction.Disjunction.DiscriminatorMapping = map[string]string{
+		fmt.Sprintf("%v", value.AsScalar().Value): thenName,
+		ast.DiscriminatorCatchAll:                 elseName,
+	}
+
+	return disjunction, nil
+}
+
+// uniqueObjectName returns the given name if no object of the schema uses it
+// yet, or the name followed by the first free numeric suffix.
+// Several conditionals within the same definition would otherwise
+// declare their branches under the same names.
+func uniqueObjectName(schema *ast.Schema, name string) string {
+	candidate := name
+	for i := 2; schema.Objects.Has(candidate); i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+
+	return candidate
+}
+
+// mergeProperties returns a copy of the given struct, extended by the
+// properties described in the given subschema.
+func (g *generator) mergeProperties(base ast.Type, schema *schemaparser.Schema) (ast.Type, error) {
+	def := base.DeepCopy()
+	if schema == nil {
+		return def, nil
+	}
+
+	fields, err := g.walkProperties(schema)
+	if err != nil {
+		return ast.Type{}, err
+	}
+
+	for _, field := range fields {
+		if index := fieldIndex(def.Struct.Fields, field.Name); index != -1 {
+			field.Required = field.Required || def.Struct.Fields[index].Required
+			def.Struct.Fields[index] = field
+			continue
+		}
+
+		def.Struct.Fields = append(def.Struct.Fields, field)
+	}
+
+	for i, field := range def.Struct.Fields {
+		if tools.ItemInList(field.Name, schema.Required) {
+			def.Struct.Fields[i].Required = true
+		}
+	}
+
+	return def, nil
+}
+
+// conditionalDiscriminator locates a property checked against a constant
+// value in the given `if` subschema.
+func conditionalDiscriminator(schema *schemaparser.Schema) (string, *schemaparser.Schema, bool) {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+
+	// To ensure consistent outputs
+	sort.Strings(names)
+
+	for _, name := range names {
+		property := schema.Properties[name]
+		if len(property.Constant) != 0 {
+			return name, property, true
+		}
+
+		if len(property.Enum) == 1 {
+			return name, &schemaparser.Schema{Constant: property.Enum}, true
+		}
+	}
+
+	return "", nil, false
+}
+
+func withDiscriminatorField(def ast.Type, discriminator string, value ast.Type) ast.Type {
+	field := ast.StructField{
+		Name:     discriminator,
+		Type:     value,
+		Required: true,
+	}
+
+	if index := fieldIndex(def.Struct.Fields, discriminator); index != -1 {
+		field.Comments = def.Struct.Fields[index].Comments
+		def.Struct.Fields[index] = field
+
+		return def
+	}
+
+	def.Struct.Fields = append(def.Struct.Fields, field)
+
+	return def
+}
+
+func fieldIndex(fields []ast.StructField, name string) int {
+	for i, field := range fields {
+		if field.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func appendDistinctType(types []ast.Type, def ast.Type) []ast.Type {
+	for _, existing := range types {
+		if reflect.DeepEqual(existing, def) {
+			return types
+		}
+	}
+
+	return append(types, def)
 }
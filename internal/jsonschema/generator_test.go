@@ -2,6 +2,7 @@ package jsonschema
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -23,11 +24,19 @@ func TestGenerateAST(t *testing.T) {
 	test.Run(t, func(tc *testutils.Test) {
 		req := require.New(tc)
 
-		schemaAst, err := GenerateAST(getSchemaAsReader(tc), Config{Package: "grafanatest"})
+		schemas, err := GenerateAST(getSchemaAsReader(tc), Config{
+			Package: "grafanatest",
+			URL:     filepath.Join(tc.RootDir, "schema.json"),
+		})
 		req.NoError(err)
-		req.NotNil(schemaAst)
+		req.NotEmpty(schemas)
 
-		writeIR(schemaAst, tc)
+		writeIR(schemas[0], "ir.json", tc)
+
+		// schemas for referenced external documents
+		for _, schema := range schemas[1:] {
+			writeIR(schema, fmt.Sprintf("ir_%s.json", schema.Package), tc)
+		}
 	})
 }
 
@@ -51,15 +60,45 @@ func TestGenerateAST_parsesEnumValues(t *testing.T) {
   "$schema": "http://json-schema.org/draft-07/schema#"
 }`)
 
-	schemaAst, err := GenerateAST(input, Config{Package: "grafanatest"})
+	schemas, err := GenerateAST(input, Config{Package: "grafanatest"})
 	req.NoError(err)
-	req.NotNil(t, schemaAst)
+	req.Len(schemas, 1)
 
-	enumType := schemaAst.Objects.At(0).Type.Enum
+	enumType := schemas[0].Objects.At(0).Type.Enum
 
 	req.Equal(int64(1), enumType.Values[0].Value)
 }
 
+func TestGenerateAST_externalRefsWithPackageMapping(t *testing.T) {
+	req := require.New(t)
+
+	rootDir := "../../testdata/jsonschema/external_refs"
+	schemaPath := filepath.Join(rootDir, "schema.json")
+
+	schemaReader, err := os.Open(schemaPath)
+	req.NoError(err)
+	defer schemaReader.Close()
+
+	schemas, err := GenerateAST(schemaReader, Config{
+		Package: "grafanatest",
+		URL:     schemaPath,
+		PackageMapping: map[string]string{
+			"./" + filepath.Join(rootDir, "common.json"): "shared",
+		},
+	})
+	req.NoError(err)
+	req.Len(schemas, 2)
+
+	req.Equal("grafanatest", schemas[0].Package)
+	req.Equal("shared", schemas[1].Package)
+	req.True(schemas[1].Objects.Has("Address"))
+
+	addressField, found := schemas[0].Objects.Get("Person").Type.Struct.FieldByName("address")
+	req.True(found)
+	req.Equal("shared", addressField.Type.Ref.ReferredPkg)
+	req.Equal("Address", addressField.Type.Ref.ReferredType)
+}
+
 func getSchemaAsReader(tc *testutils.Test) io.Reader {
 	tc.Helper()
 
@@ -71,14 +110,55 @@ func getSchemaAsReader(tc *testutils.Test) io.Reader {
 	return file
 }
 
-func writeIR(irFile *ast.Schema, tc *testutils.Test) {
+func writeIR(irFile *ast.Schema, filename string, tc *testutils.Test) {
 	tc.Helper()
 
 	marshaledIR, err := json.MarshalIndent(irFile, "", "  ")
 	require.NoError(tc, err)
 
 	tc.WriteFile(&codejen.File{
-		RelativePath: "ir.json",
+		RelativePath: filename,
 		Data:         marshaledIR,
 	})
 }
+
+func TestGenerateAST_conditionalBranchesGetUniqueNames(t *testing.T) {
+	req := require.New(t)
+
+	input := strings.NewReader(`{
+  "$ref": "#/definitions/Datasource",
+  "definitions": {
+    "Datasource": {
+      "anyOf": [
+        {
+          "type": "object",
+          "properties": {"type": {"type": "string"}},
+          "if": {"properties": {"type": {"const": "prometheus"}}},
+          "then": {"properties": {"url": {"type": "string"}}}
+        },
+        {
+          "type": "object",
+          "properties": {"type": {"type": "string"}},
+          "if": {"properties": {"type": {"const": "loki"}}},
+          "then": {"properties": {"maxLines": {"type": "integer"}}}
+        }
+      ]
+    }
+  },
+  "$schema": "http://json-schema.org/draft-07/schema#"
+}`)
+
+	schemas, err := GenerateAST(input, Config{Package: "grafanatest"})
+	req.NoError(err)
+	req.Len(schemas, 1)
+
+	req.True(schemas[0].Objects.Has("DatasourcePrometheus"))
+	req.True(schemas[0].Objects.Has("DatasourceLoki"))
+	req.True(schemas[0].Objects.Has("DatasourceElse"))
+	req.True(schemas[0].Objects.Has("DatasourceElse2"))
+
+	_, found := schemas[0].Objects.Get("DatasourceElse2").Type.Struct.FieldByName("maxLines")
+	req.False(found)
+	_, found = schemas[0].Objects.Get("DatasourceLoki").Type.Struct.FieldByName("maxLines")
+	req.True(found)
+}
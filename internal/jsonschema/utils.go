@@ -1,9 +1,15 @@
 package jsonschema
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
 	"strings"
 
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
 	schemaparser "github.com/santhosh-tekuri/jsonschema"
 )
 
@@ -41,3 +47,114 @@ func unwrapJSONNumber(input any) any {
 
 	return input
 }
+
+// addDependentRequiredComments documents the fields that become required
+// when another property is set, as described by `dependencies` (or
+// `dependentRequired`) entries listing property names.
+func addDependentRequiredComments(fields []ast.StructField, dependencies map[string]any) {
+	names := make([]string, 0, len(dependencies))
+	for name := range dependencies {
+		names = append(names, name)
+	}
+
+	// To ensure consistent outputs
+	sort.Strings(names)
+
+	for _, name := range names {
+		dependents, ok := dependencies[name].([]string)
+		if !ok {
+			continue
+		}
+
+		for i, field := range fields {
+			if field.Required || !tools.ItemInList(field.Name, dependents) {
+				continue
+			}
+
+			fields[i].Comments = append(fields[i].Comments, fmt.Sprintf("Required when `%s` is set.", name))
+		}
+	}
+}
+
+// dependentRequiredAsDependencies rewrites the `dependentRequired` keyword
+// introduced by draft 2019-09 into its draft 7 equivalent: `dependencies`
+// entries listing property names. The schema parser would otherwise ignore it.
+func dependentRequiredAsDependencies(reader io.Reader) (io.Reader, error) {
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		// let the schema parser report invalid documents
+		return bytes.NewReader(raw), nil //nolint:nilerr
+	}
+
+	if !rewriteDependentRequired(document) {
+		return bytes.NewReader(raw), nil
+	}
+
+	rewritten, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.NewReader(rewritten), nil
+}
+
+// rewriteDependentRequired walks the given (sub)schema and reports whether
+// it was modified.
+func rewriteDependentRequired(schema any) bool {
+	switch value := schema.(type) {
+	case []any:
+		rewritten := false
+		for _, item := range value {
+			rewritten = rewriteDependentRequired(item) || rewritten
+		}
+
+		return rewritten
+	case map[string]any:
+		rewritten := false
+
+		if dependentRequired, ok := value["dependentRequired"].(map[string]any); ok {
+			dependencies, ok := value["dependencies"].(map[string]any)
+			if !ok {
+				dependencies = make(map[string]any, len(dependentRequired))
+			}
+
+			for name, dependents := range dependentRequired {
+				if _, exists := dependencies[name]; !exists {
+					dependencies[name] = dependents
+				}
+			}
+
+			value["dependencies"] = dependencies
+			delete(value, "dependentRequired")
+			rewritten = true
+		}
+
+		for keyword, subschema := range value {
+			switch keyword {
+			// keywords whose values map names to subschemas
+			case "properties", "patternProperties", "definitions", "$defs", "dependencies":
+				if subschemas, ok := subschema.(map[string]any); ok {
+					for _, item := range subschemas {
+						rewritten = rewriteDependentRequired(item) || rewritten
+					}
+				}
+			// keywords whose values are not subschemas
+			case "enum", "const", "default", "examples", "required":
+			default:
+				rewritten = rewriteDependentRequired(subschema) || rewritten
+			}
+		}
+
+		return rewritten
+	}
+
+	return false
+}
@@ -49,12 +49,16 @@ func GenerateAST(filePath string, cfg Config) (*ast.Schema, error) {
 		schema: ast.NewSchema(cfg.Package, cfg.SchemaMetadata),
 	}
 
-	if oapi.Components == nil {
-		return g.schema, nil
+	if oapi.Components != nil {
+		if err := g.declareDefinition(oapi.Components.Schemas); err != nil {
+			return nil, fmt.Errorf("[%s] %w", cfg.Package, err)
+		}
 	}
 
-	if err := g.declareDefinition(oapi.Components.Schemas); err != nil {
-		return nil, fmt.Errorf("[%s] %w", cfg.Package, err)
+	if oapi.Paths != nil {
+		if err := g.declarePaths(oapi.Paths); err != nil {
+			return nil, fmt.Errorf("[%s] %w", cfg.Package, err)
+		}
 	}
 
 	// To ensure a consistent output, since github.com/getkin/kin-openapi/openapi3
@@ -134,6 +138,11 @@ func (g *generator) walkRef(schema *openapi3.SchemaRef) (ast.Type, error) {
 }
 
 func (g *generator) walkObject(schema *openapi3.Schema) (ast.Type, error) {
+	// objects without properties but with an `additionalProperties` schema are maps
+	if len(schema.Properties) == 0 && schema.AdditionalProperties.Schema != nil {
+		return g.walkMap(schema)
+	}
+
 	fields := make([]ast.StructField, 0, len(schema.Properties))
 	for name, schemaRef := range schema.Properties {
 		def, err := g.walkSchemaRef(schemaRef)
@@ -155,13 +164,27 @@ func (g *generator) walkObject(schema *openapi3.Schema) (ast.Type, error) {
 	return ast.NewStruct(fields...), nil
 }
 
+func (g *generator) walkMap(schema *openapi3.Schema) (ast.Type, error) {
+	valueDef, err := g.walkSchemaRef(schema.AdditionalProperties.Schema)
+	if err != nil {
+		return ast.Type{}, err
+	}
+
+	t := ast.NewMap(ast.String(), valueDef, ast.Default(schema.Default))
+	t.Map.Constraints = getMapConstraints(schema)
+	t.Nullable = schema.Nullable
+	return t, nil
+}
+
 func (g *generator) walkArray(schema *openapi3.Schema) (ast.Type, error) {
 	def, err := g.walkSchemaRef(schema.Items)
 	if err != nil {
 		return ast.Type{}, err
 	}
 
-	return ast.NewArray(def, ast.Default(schema.Default)), nil
+	t := ast.NewArray(def, ast.Default(schema.Default))
+	t.Array.Constraints = getArrayConstraints(schema)
+	return t, nil
 }
 
 func (g *generator) walkString(schema *openapi3.Schema) (ast.Type, error) {
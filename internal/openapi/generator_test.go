@@ -2,6 +2,7 @@ package openapi
 
 import (
 	"encoding/json"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -28,6 +29,34 @@ func TestGenerateAST(t *testing.T) {
 	})
 }
 
+func TestGenerateAST_rejectsParametersSharingAName(t *testing.T) {
+	req := require.New(t)
+
+	schema := `{
+  "openapi": "3.0.0",
+  "info": {"title": "duplicate_parameters", "version": "0.0.0"},
+  "paths": {
+    "/things/{id}": {
+      "parameters": [
+        {"name": "id", "in": "path", "required": true, "schema": {"type": "string"}}
+      ],
+      "get": {
+        "operationId": "getThing",
+        "parameters": [
+          {"name": "id", "in": "query", "schema": {"type": "integer"}}
+        ],
+        "responses": {"200": {"description": "The thing."}}
+      }
+    }
+  }
+}`
+	schemaFile := filepath.Join(t.TempDir(), "schema.json")
+	req.NoError(os.WriteFile(schemaFile, []byte(schema), 0600))
+
+	_, err := GenerateAST(schemaFile, Config{Package: "grafanatest"})
+	req.ErrorContains(err, "GET /things/{id}: parameter 'id' is defined both in path and in query")
+}
+
 func getFilePath(tc *testutils.Test) string {
 	tc.Helper()
 
@@ -0,0 +1,291 @@
+package openapi
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
+)
+
+const jsonMediaType = "application/json"
+
+var nonAlphanumericRegex = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// declarePaths declares the operations defined in the given paths, and
+// derives objects describing them:
+//   - `<OperationId>Params` describes the operation's parameters,
+//   - `<OperationId>Request` describes its request body,
+//   - `<OperationId>Response` describes the body of its first successful
+//     response. Bodies of other responses are described by `<OperationId>Response<Status>`.
+func (g *generator) declarePaths(paths *openapi3.Paths) error {
+	pathsMap := paths.Map()
+
+	for _, path := range sortedKeys(pathsMap) {
+		pathItem := pathsMap[path]
+		operations := pathItem.Operations()
+
+		for _, method := range sortedKeys(operations) {
+			if err := g.declareOperation(path, method, pathItem, operations[method]); err != nil {
+				return fmt.Errorf("%s %s: %w", method, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) declareOperation(path string, method string, pathItem *openapi3.PathItem, operation *openapi3.Operation) error {
+	name := operationName(path, method, operation)
+	comments := operationComments(path, method, operation)
+
+	op := ast.Operation{
+		Name:     name,
+		Comments: comments,
+		Method:   strings.ToUpper(method),
+		Path:     path,
+	}
+
+	parameters := mergeParameters(pathItem.Parameters, operation.Parameters)
+
+	params, err := g.walkParameters(parameters)
+	if err != nil {
+		return err
+	}
+	if params != nil {
+		if err := g.addDerivedObject(name+"Params", comments, *params); err != nil {
+			return err
+		}
+
+		paramsRef := ast.RefType{ReferredPkg: g.schema.Package, ReferredType: name + "Params"}
+		op.Params = &paramsRef
+
+		for _, field := range params.AsStruct().Fields {
+			op.Parameters = append(op.Parameters, ast.OperationParameter{
+				Name: field.Name,
+				In:   ast.ParameterLocation(parameterLocation(parameters, field.Name)),
+			})
+		}
+	}
+
+	if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+		body, err := g.declareBody(name+"Request", comments, operation.RequestBody.Value.Content)
+		if err != nil {
+			return err
+		}
+
+		if body != nil {
+			body.Required = operation.RequestBody.Value.Required
+			op.RequestBody = body
+		}
+	}
+
+	responses := operation.Responses.Map()
+	successStatus := successfulStatus(responses)
+	for _, status := range sortedKeys(responses) {
+		response := responses[status]
+		if response.Value == nil {
+			continue
+		}
+
+		objectName := name + "Response"
+		if status != successStatus {
+			objectName += tools.UpperCamelCase(status)
+		}
+
+		body, err := g.declareBody(objectName, comments, response.Value.Content)
+		if err != nil {
+			return err
+		}
+
+		op.Responses = append(op.Responses, ast.OperationResponse{
+			Status:  status,
+			Success: status == successStatus,
+			Body:    body,
+		})
+	}
+
+	g.schema.Operations = append(g.schema.Operations, op)
+
+	return nil
+}
+
+// declareBody derives an object from the schema describing the given
+// content, if any.
+func (g *generator) declareBody(name string, comments []string, content openapi3.Content) (*ast.OperationBody, error) {
+	contentType, schemaRef := contentSchema(content)
+	if schemaRef == nil {
+		return nil, nil
+	}
+
+	def, err := g.walkSchemaRef(schemaRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := g.addDerivedObject(name, comments, def); err != nil {
+		return nil, err
+	}
+
+	return &ast.OperationBody{
+		ContentType: contentType,
+		Type:        ast.NewRef(g.schema.Package, name),
+	}, nil
+}
+
+func (g *generator) walkParameters(parameters openapi3.Parameters) (*ast.Type, error) {
+	fields := make([]ast.StructField, 0, len(parameters))
+	locations := make(map[string]string, len(parameters))
+
+	for _, parameterRef := range parameters {
+		parameter := parameterRef.Value
+		if parameter == nil {
+			continue
+		}
+
+		// Parameters become fields of the same object: their names must be
+		// unique, regardless of their location.
+		if location, found := locations[parameter.Name]; found {
+			return nil, fmt.Errorf("parameter '%s' is defined both in %s and in %s", parameter.Name, location, parameter.In)
+		}
+		locations[parameter.Name] = parameter.In
+
+		schemaRef := parameter.Schema
+		if schemaRef == nil {
+			_, schemaRef = contentSchema(parameter.Content)
+		}
+
+		def := ast.Any()
+		if schemaRef != nil {
+			var err error
+			def, err = g.walkSchemaRef(schemaRef)
+			if err != nil {
+				return nil, fmt.Errorf("parameter '%s': %w", parameter.Name, err)
+			}
+		}
+
+		field := ast.NewStructField(parameter.Name, def)
+		field.Required = parameter.Required
+		field.Comments = tools.Filter(strings.Split(parameter.Description, "\n"), func(line string) bool {
+			return line != ""
+		})
+
+		fields = append(fields, field)
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	params := ast.NewStruct(fields...)
+
+	return &params, nil
+}
+
+func (g *generator) addDerivedObject(name string, comments []string, def ast.Type) error {
+	if g.schema.Objects.Has(name) {
+		return fmt.Errorf("derived object '%s' conflicts with an existing object", name)
+	}
+
+	object := ast.NewObject(g.schema.Package, name, def)
+	object.Comments = comments
+
+	g.schema.AddObject(object)
+
+	return nil
+}
+
+// operationName returns the name used as a prefix for objects derived from
+// the given operation: its ID if set, or a name based on its method and path.
+func operationName(path string, method string, operation *openapi3.Operation) string {
+	if operation.OperationID != "" {
+		return tools.UpperCamelCase(operation.OperationID)
+	}
+
+	return tools.UpperCamelCase(strings.ToLower(method) + "_" + strings.Trim(nonAlphanumericRegex.ReplaceAllString(path, "_"), "_"))
+}
+
+func operationComments(path string, method string, operation *openapi3.Operation) []string {
+	var comments []string
+	if operation.Summary != "" {
+		comments = append(comments, operation.Summary)
+	}
+
+	comments = append(comments, fmt.Sprintf("Derived from the `%s %s` operation.", strings.ToUpper(method), path))
+
+	return comments
+}
+
+// contentSchema returns the media type and schema describing the given
+// content, favoring its JSON representation.
+func contentSchema(content openapi3.Content) (string, *openapi3.SchemaRef) {
+	if mediaType := content.Get(jsonMediaType); mediaType != nil && mediaType.Schema != nil {
+		return jsonMediaType, mediaType.Schema
+	}
+
+	for _, mime := range sortedKeys(content) {
+		if content[mime].Schema != nil {
+			return mime, content[mime].Schema
+		}
+	}
+
+	return "", nil
+}
+
+// mergeParameters merges parameters defined at the path level with the ones
+// defined by an operation. Operations can override path-level parameters.
+func mergeParameters(pathParameters openapi3.Parameters, operationParameters openapi3.Parameters) openapi3.Parameters {
+	merged := make(openapi3.Parameters, 0, len(pathParameters)+len(operationParameters))
+
+	for _, parameterRef := range pathParameters {
+		if parameterRef.Value != nil && operationParameters.GetByInAndName(parameterRef.Value.In, parameterRef.Value.Name) != nil {
+			continue
+		}
+
+		merged = append(merged, parameterRef)
+	}
+
+	return append(merged, operationParameters...)
+}
+
+// parameterLocation returns the location of the parameter with the given name.
+// Parameter names are unique within an operation (see walkParameters).
+func parameterLocation(parameters openapi3.Parameters, name string) string {
+	for _, parameterRef := range parameters {
+		if parameterRef.Value != nil && parameterRef.Value.Name == name {
+			return parameterRef.Value.In
+		}
+	}
+
+	return ""
+}
+
+// successfulStatus returns the first 2XX status code defined in the given
+// responses. If there isn't any, the "default" response is used.
+func successfulStatus(responses map[string]*openapi3.ResponseRef) string {
+	for _, status := range sortedKeys(responses) {
+		if strings.HasPrefix(status, "2") {
+			return status
+		}
+	}
+
+	if _, found := responses["default"]; found {
+		return "default"
+	}
+
+	return ""
+}
+
+func sortedKeys[V any](input map[string]V) []string {
+	keys := make([]string, 0, len(input))
+	for key := range input {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -85,6 +85,50 @@ func getConstraints(schema *openapi3.Schema) []ast.TypeConstraint {
 	return constraints
 }
 
+func getArrayConstraints(schema *openapi3.Schema) []ast.TypeConstraint {
+	constraints := make([]ast.TypeConstraint, 0)
+
+	if schema.MinItems > 0 {
+		constraints = append(constraints, ast.TypeConstraint{
+			Op:   ast.MinItemsOp,
+			Args: []any{schema.MinItems},
+		})
+	}
+	if schema.MaxItems != nil {
+		constraints = append(constraints, ast.TypeConstraint{
+			Op:   ast.MaxItemsOp,
+			Args: []any{*schema.MaxItems},
+		})
+	}
+	if schema.UniqueItems {
+		constraints = append(constraints, ast.TypeConstraint{
+			Op:   ast.UniqueItemsOp,
+			Args: []any{true},
+		})
+	}
+
+	return constraints
+}
+
+func getMapConstraints(schema *openapi3.Schema) []ast.TypeConstraint {
+	constraints := make([]ast.TypeConstraint, 0)
+
+	if schema.MinProps > 0 {
+		constraints = append(constraints, ast.TypeConstraint{
+			Op:   ast.MinPropertiesOp,
+			Args: []any{schema.MinProps},
+		})
+	}
+	if schema.MaxProps != nil {
+		constraints = append(constraints, ast.TypeConstraint{
+			Op:   ast.MaxPropertiesOp,
+			Args: []any{*schema.MaxProps},
+		})
+	}
+
+	return constraints
+}
+
 func getArgs(v *float64, t string) []any {
 	args := []any{*v}
 	if t == openapi3.TypeInteger {
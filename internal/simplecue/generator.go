@@ -3,10 +3,10 @@ package simplecue
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"cuelang.org/go/cue"
 	"cuelang.org/go/cue/format"
-	"cuelang.org/go/pkg/strconv"
 	"github.com/grafana/cog/internal/ast"
 )
 
@@ -287,7 +287,7 @@ func (g *generator) declareNode(v cue.Value) (ast.Type, error) {
 		return g.declareDisjunction(v, hints, defVal)
 	}
 
-	switch v.IncompleteKind() {
+	switch incompleteKind(v) {
 	case cue.TopKind:
 		return ast.Any(), nil
 	case cue.NullKind:
@@ -314,15 +314,23 @@ func (g *generator) declareNode(v cue.Value) (ast.Type, error) {
 		return g.declareList(v, defVal, hints)
 	case cue.StructKind:
 		// in cue: {...}, {[string]: type}, or inline struct
-		if op, _ := v.Expr(); op == cue.NoOp {
-			t := v.LookupPath(cue.MakePath(cue.AnyString))
+		structValue, constraints, err := g.declareValidatorConstraints(v)
+		if err != nil {
+			return ast.Type{}, err
+		}
+
+		if op, _ := structValue.Expr(); op == cue.NoOp {
+			t := structValue.LookupPath(cue.MakePath(cue.AnyString))
 			if t.Exists() && t.IncompleteKind() != cue.TopKind {
 				typeDef, err := g.declareNode(t)
 				if err != nil {
 					return ast.Type{}, err
 				}
 
-				return ast.NewMap(ast.String(), typeDef, ast.Hints(hints), ast.Default(defVal)), nil
+				mapDef := ast.NewMap(ast.String(), typeDef, ast.Hints(hints), ast.Default(defVal))
+				mapDef.Map.Constraints = constraints
+
+				return mapDef, nil
 			}
 		}
 
@@ -341,7 +349,7 @@ func (g *generator) declareNode(v cue.Value) (ast.Type, error) {
 
 		return def, nil
 	default:
-		return ast.Type{}, errorWithCueRef(v, "unexpected node with kind '%s'", v.IncompleteKind().String())
+		return ast.Type{}, errorWithCueRef(v, "unexpected node with kind '%s'", incompleteKind(v).String())
 	}
 }
 
@@ -548,46 +556,89 @@ func (g *generator) declareStringConstraints(v cue.Value) ([]ast.TypeConstraint,
 	for _, andExpr := range typeAndConstraints {
 		op, args := andExpr.Expr()
 
-		// TODO: support more OPs?
-		if op != cue.CallOp {
-			continue
-		}
-
-		// TODO: support more constraints?
-		switch fmt.Sprint(args[0]) {
-		case "strings.MinRunes":
-			scalar, err := cueConcreteToScalar(args[1])
+		switch op {
+		case cue.RegexMatchOp, cue.NotRegexMatchOp:
+			pattern, err := args[0].String()
 			if err != nil {
-				return nil, err
+				return nil, errorWithCueRef(andExpr, "could not convert regular expression to string")
+			}
+
+			constraintOp := ast.PatternOp
+			if op == cue.NotRegexMatchOp {
+				constraintOp = ast.NotPatternOp
 			}
 
 			constraints = append(constraints, ast.TypeConstraint{
-				Op:   ast.MinLengthOp,
-				Args: []any{scalar},
+				Op:   constraintOp,
+				Args: []any{pattern},
 			})
-
-		case "strings.MaxRunes":
-			scalar, err := cueConcreteToScalar(args[1])
+		case cue.CallOp:
+			constraint, found, err := g.declareCallConstraint(args)
 			if err != nil {
 				return nil, err
 			}
-
-			constraints = append(constraints, ast.TypeConstraint{
-				Op:   ast.MaxLengthOp,
-				Args: []any{scalar},
-			})
+			if found {
+				constraints = append(constraints, constraint)
+			}
 		}
 	}
 
 	return constraints, nil
 }
 
+// declareCallConstraint converts a call to one of CUE's builtin validators
+// into a constraint. The boolean result is false for unsupported validators.
+// Ex: `strings.MinRunes(1)`, `list.UniqueItems()`, `struct.MaxFields(2)`
+func (g *generator) declareCallConstraint(args []cue.Value) (ast.TypeConstraint, bool, error) {
+	var op ast.Op
+
+	// validators without arguments are formatted with their parentheses
+	switch strings.TrimSuffix(fmt.Sprint(args[0]), "()") {
+	case "strings.MinRunes":
+		op = ast.MinLengthOp
+	case "strings.MaxRunes":
+		op = ast.MaxLengthOp
+	case "strings.HasPrefix":
+		op = ast.HasPrefixOp
+	case "list.MinItems":
+		op = ast.MinItemsOp
+	case "list.MaxItems":
+		op = ast.MaxItemsOp
+	case "list.UniqueItems":
+		return ast.TypeConstraint{Op: ast.UniqueItemsOp, Args: []any{true}}, true, nil
+	case "math.MultipleOf":
+		op = ast.MultipleOfOp
+	case "struct.MinFields":
+		op = ast.MinPropertiesOp
+	case "struct.MaxFields":
+		op = ast.MaxPropertiesOp
+	default:
+		return ast.TypeConstraint{}, false, nil
+	}
+
+	if len(args) != 2 {
+		return ast.TypeConstraint{}, false, errorWithCueRef(args[0], "expected exactly one argument for %s", op)
+	}
+
+	scalar, err := cueConcreteToScalar(args[1])
+	if err != nil {
+		return ast.TypeConstraint{}, false, err
+	}
+
+	return ast.TypeConstraint{
+		Op:   op,
+		Args: []any{scalar},
+	}, true, nil
+}
+
 func (g *generator) declareNumber(v cue.Value, defVal any, hints ast.JenniesHints) (ast.Type, error) {
 	numberTypeWithConstraintsAsString, err := format.Node(v.Syntax())
 	if err != nil {
 		return ast.Type{}, err
 	}
-	parts := strings.Split(string(numberTypeWithConstraintsAsString), " ")
+	parts := strings.FieldsFunc(string(numberTypeWithConstraintsAsString), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
 	if len(parts) == 0 {
 		return ast.Type{}, errorWithCueRef(v, "something went very wrong while formatting a number expression into a string")
 	}
@@ -657,7 +708,6 @@ func (g *generator) declareNumber(v cue.Value, defVal any, hints ast.JenniesHint
 	return typeDef, nil
 }
 
-// having written this makes my soul hurt.
 func (g *generator) declareNumberConstraints(v cue.Value) ([]ast.TypeConstraint, error) {
 	// if the number has a default value, strip it from `v` before trying to extract constraints.
 	_, hasDefault := v.Default()
@@ -666,71 +716,49 @@ func (g *generator) declareNumberConstraints(v cue.Value) ([]ast.TypeConstraint,
 		v = dvals[0]
 	}
 
-	numberTypeWithConstraintsAsString, err := format.Node(v.Syntax())
-	if err != nil {
-		return nil, err
+	boundOps := map[cue.Op]ast.Op{
+		cue.LessThanOp:         ast.LessThanOp,
+		cue.LessThanEqualOp:    ast.LessThanEqualOp,
+		cue.GreaterThanOp:      ast.GreaterThanOp,
+		cue.GreaterThanEqualOp: ast.GreaterThanEqualOp,
 	}
 
-	parts := strings.Split(string(numberTypeWithConstraintsAsString), " & ")
-
-	extractOperatorAndArg := func(input string, numberKind cue.Kind) (ast.Op, any, error) {
-		argStartIndex := -1
-
-		var op ast.Op
-
-		if input[0] == '>' {
-			op = ast.GreaterThanOp
-			argStartIndex = 1
+	var constraints []ast.TypeConstraint
+	for _, andExpr := range appendSplit(nil, cue.AndOp, v) {
+		op, args := andExpr.Expr()
 
-			if input[1] == '=' {
-				op = ast.GreaterThanEqualOp
-				argStartIndex = 2
+		if op == cue.CallOp {
+			constraint, found, err := g.declareCallConstraint(args)
+			if err != nil {
+				return nil, err
 			}
-		}
-		if input[0] == '<' {
-			op = ast.LessThanOp
-			argStartIndex = 1
-
-			if input[1] == '=' {
-				op = ast.LessThanEqualOp
-				argStartIndex = 2
+			if found {
+				constraints = append(constraints, constraint)
 			}
-		}
-		if input[0] == '=' && input[1] == '=' {
-			op = ast.EqualOp
-			argStartIndex = 2
-		}
-		if input[0] == '!' && input[1] == '=' {
-			op = ast.NotEqualOp
-			argStartIndex = 2
-		}
-
-		if op == "" {
-			return op, nil, fmt.Errorf("could not infer operator from '%s'", input)
-		}
 
-		if numberKind.IsAnyOf(cue.FloatKind) {
-			arg, err := strconv.ParseFloat(input[argStartIndex:], 64)
-			return op, arg, err
+			continue
 		}
 
-		arg, err := strconv.ParseInt(input[argStartIndex:], 10, 64)
-		return op, arg, err
-	}
+		boundOp, isBound := boundOps[op]
 
-	var constraints []ast.TypeConstraint
-	for _, part := range parts {
-		if part[0] != '<' && part[0] != '>' {
+		// predefined types like uint8 are expanded into bounds without position:
+		// they are described by the type itself, not by constraints.
+		if !isBound || !andExpr.Pos().IsValid() {
 			continue
 		}
 
-		op, arg, err := extractOperatorAndArg(part, v.IncompleteKind())
+		arg, err := cueConcreteToScalar(args[0])
 		if err != nil {
-			return nil, errorWithCueRef(v, err.Error())
+			return nil, err
+		}
+
+		// bounds of float numbers are floats, even when written as integers
+		if intArg, ok := arg.(int64); ok && v.IncompleteKind().IsAnyOf(cue.FloatKind) {
+			arg = float64(intArg)
 		}
 
 		constraints = append(constraints, ast.TypeConstraint{
-			Op:   op,
+			Op:   boundOp,
 			Args: []any{arg},
 		})
 	}
@@ -772,6 +800,13 @@ func (g *generator) declareList(v cue.Value, defVal any, hints ast.JenniesHints)
 		v = dvals[0]
 	}
 
+	v, constraints, err := g.declareValidatorConstraints(v)
+	if err != nil {
+		return ast.Type{}, err
+	}
+
+	typeDef.Array.Constraints = constraints
+
 	e := v.LookupPath(cue.MakePath(cue.AnyIndex))
 	if !e.Exists() {
 		// unreachable?
@@ -788,6 +823,41 @@ func (g *generator) declareList(v cue.Value, defVal any, hints ast.JenniesHints)
 	return typeDef, nil
 }
 
+// declareValidatorConstraints extracts the constraints set by builtin
+// validators unified with a list or a struct, and returns them along with
+// the list or struct type itself.
+// Ex: [...string] & list.MinItems(1) & list.UniqueItems()
+// Ex: {[string]: string} & struct.MaxFields(10)
+func (g *generator) declareValidatorConstraints(v cue.Value) (cue.Value, []ast.TypeConstraint, error) {
+	if op, _ := v.Expr(); op != cue.AndOp {
+		return v, nil, nil
+	}
+
+	var constraints []ast.TypeConstraint
+	var types []cue.Value
+	for _, andExpr := range appendSplit(nil, cue.AndOp, v) {
+		op, args := andExpr.Expr()
+		if op != cue.CallOp {
+			types = append(types, andExpr)
+			continue
+		}
+
+		constraint, found, err := g.declareCallConstraint(args)
+		if err != nil {
+			return v, nil, err
+		}
+		if found {
+			constraints = append(constraints, constraint)
+		}
+	}
+
+	if len(constraints) == 0 || len(types) != 1 {
+		return v, constraints, nil
+	}
+
+	return types[0], constraints, nil
+}
+
 // removeTautologicalUnification simplifies CUE unifications
 // that unify identical branches.
 // Ex: SomeType & SomeType → SomeType
@@ -89,6 +89,29 @@ outer:
 	return a
 }
 
+// incompleteKind returns the kind of the given value. Unifications evaluating
+// to an error get the kind shared by their branches instead: it happens with
+// lists rejecting their default value (the empty list) because of validators
+// like `list.MinItems(1)`.
+func incompleteKind(v cue.Value) cue.Kind {
+	kind := v.IncompleteKind()
+	if kind != cue.BottomKind {
+		return kind
+	}
+
+	op, args := v.Expr()
+	if op != cue.AndOp {
+		return kind
+	}
+
+	kind = cue.TopKind
+	for _, arg := range args {
+		kind &= incompleteKind(arg)
+	}
+
+	return kind
+}
+
 func hintsFromCueValue(v cue.Value) ast.JenniesHints {
 	hints := make(ast.JenniesHints)
 
@@ -24,6 +24,18 @@ func StringInListEqualFold(needle string, haystack []string) bool {
 	return false
 }
 
+// GlobInListEqualFold reports whether the needle matches one of the glob
+// patterns in the haystack. See GlobMatchEqualFold.
+func GlobInListEqualFold(needle string, haystack []string) bool {
+	for _, pattern := range haystack {
+		if GlobMatchEqualFold(pattern, needle) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func Map[T any, O any](input []T, mapper func(T) O) []O {
 	output := make([]O, len(input))
 
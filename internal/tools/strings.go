@@ -1,6 +1,7 @@
 package tools
 
 import (
+	"path"
 	"regexp"
 	"strings"
 
@@ -52,3 +53,19 @@ func LowerCamelCase(s string) string {
 func CleanupNames(s string) string {
 	return nonAlphaNumRegex.ReplaceAllString(s, "")
 }
+
+// GlobMatch reports whether name matches the shell pattern.
+// The pattern syntax is the one of path.Match: `*` matches any sequence
+// of characters, `?` matches a single character.
+// Malformed patterns never match.
+func GlobMatch(pattern string, name string) bool {
+	matched, err := path.Match(pattern, name)
+
+	return err == nil && matched
+}
+
+// GlobMatchEqualFold reports whether name matches the shell pattern, ignoring
+// case. See GlobMatch.
+func GlobMatchEqualFold(pattern string, name string) bool {
+	return GlobMatch(strings.ToLower(pattern), strings.ToLower(name))
+}
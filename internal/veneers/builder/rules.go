@@ -311,3 +311,154 @@ func Initialize(selector Selector, statements []Initialization) RewriteRule {
 		return builders, nil
 	}
 }
+
+// CustomOption describes an option added to builders by the AddOption rule.
+type CustomOption struct {
+	Name        string
+	Comments    []string
+	Args        []ast.Argument
+	Assignments []CustomAssignment
+}
+
+// CustomAssignment describes an assignment performed by a CustomOption.
+// PropertyPath is a dot-separated list of fields, relative to the object
+// built by the builder.
+type CustomAssignment struct {
+	PropertyPath string
+	Method       ast.AssignmentMethod
+	Value        CustomAssignmentValue
+}
+
+// CustomAssignmentValue describes what is assigned. Exactly one of its fields
+// is expected to be set:
+//   - Argument: name of one of the option's arguments
+//   - Constant: a constant value
+//   - Envelope: values assigned to the fields of the struct (or reference to
+//     one) being assigned. Their paths are relative to that struct.
+type CustomAssignmentValue struct {
+	Argument string
+	Constant any
+	Envelope []CustomEnvelopeValue
+}
+
+// CustomEnvelopeValue describes the value assigned to one of the fields of an
+// envelope.
+type CustomEnvelopeValue struct {
+	PropertyPath string
+	Value        CustomAssignmentValue
+}
+
+// AddOption adds a new option to the selected builders.
+func AddOption(selector Selector, option CustomOption) RewriteRule {
+	return mapToSelected(selector, func(builders ast.Builders, builder ast.Builder) (ast.Builder, error) {
+		for _, existing := range builder.Options {
+			if strings.EqualFold(existing.Name, option.Name) {
+				return builder, fmt.Errorf("could not add option '%s' to builder '%s.%s': an option with the same name already exists", option.Name, builder.Package, builder.Name)
+			}
+		}
+
+		newOption, err := option.asOption(builders, builder)
+		if err != nil {
+			return builder, fmt.Errorf("could not add option '%s' to builder '%s.%s': %w", option.Name, builder.Package, builder.Name, err)
+		}
+
+		builder.Options = append(builder.Options, newOption)
+		builder.AddToVeneerTrail(fmt.Sprintf("AddOption[%s]", option.Name))
+
+		return builder, nil
+	})
+}
+
+func (option CustomOption) asOption(builders ast.Builders, builder ast.Builder) (ast.Option, error) {
+	newOption := ast.Option{
+		Name:     option.Name,
+		Comments: option.Comments,
+		Args:     make([]ast.Argument, 0, len(option.Args)),
+	}
+
+	for _, arg := range option.Args {
+		newOption.Args = append(newOption.Args, arg.DeepCopy())
+	}
+
+	for _, assignment := range option.Assignments {
+		path, err := builder.MakePath(builders, assignment.PropertyPath)
+		if err != nil {
+			return ast.Option{}, err
+		}
+
+		value, err := option.assignmentValue(builders, path, assignment.Value)
+		if err != nil {
+			return ast.Option{}, err
+		}
+
+		method := assignment.Method
+		if method == "" {
+			method = ast.DirectAssignment
+		}
+
+		if method == ast.AppendAssignment && !path.Last().Type.IsArray() {
+			return ast.Option{}, fmt.Errorf("can not append to '%s': not an array", assignment.PropertyPath)
+		}
+
+		newOption.Assignments = append(newOption.Assignments, ast.Assignment{
+			Path:   path,
+			Value:  value,
+			Method: method,
+		})
+	}
+
+	newOption.AddToVeneerTrail("AddOption")
+
+	return newOption, nil
+}
+
+func (option CustomOption) assignmentValue(builders ast.Builders, path ast.Path, value CustomAssignmentValue) (ast.AssignmentValue, error) {
+	if value.Argument != "" {
+		for _, arg := range option.Args {
+			if arg.Name == value.Argument {
+				argCopy := arg.DeepCopy()
+				return ast.AssignmentValue{Argument: &argCopy}, nil
+			}
+		}
+
+		return ast.AssignmentValue{}, fmt.Errorf("argument '%s' not found", value.Argument)
+	}
+
+	if len(value.Envelope) != 0 {
+		envelopeType := path.Last().Type
+		if envelopeType.IsArray() {
+			envelopeType = envelopeType.AsArray().ValueType
+		}
+		envelopeType.Nullable = false
+
+		envelope := &ast.AssignmentEnvelope{
+			Type:   envelopeType,
+			Values: make([]ast.EnvelopeFieldValue, 0, len(value.Envelope)),
+		}
+
+		for _, envelopeValue := range value.Envelope {
+			valuePath, err := builders.MakePathFromType(envelopeType, envelopeValue.PropertyPath)
+			if err != nil {
+				return ast.AssignmentValue{}, err
+			}
+
+			fieldValue, err := option.assignmentValue(builders, valuePath, envelopeValue.Value)
+			if err != nil {
+				return ast.AssignmentValue{}, err
+			}
+
+			envelope.Values = append(envelope.Values, ast.EnvelopeFieldValue{
+				Path:  valuePath,
+				Value: fieldValue,
+			})
+		}
+
+		return ast.AssignmentValue{Envelope: envelope}, nil
+	}
+
+	if value.Constant == nil {
+		return ast.AssignmentValue{}, fmt.Errorf("no value given: expected an argument, a constant or an envelope")
+	}
+
+	return ast.AssignmentValue{Constant: value.Constant}, nil
+}
@@ -95,3 +95,128 @@ func TestInitialize(t *testing.T) {
 	req.Len(updatedBuilders, 1)
 	req.Equal(expectedAssignments, updatedBuilders[0].Initializations)
 }
+
+func addOptionTestBuilders() ast.Builders {
+	styleObject := ast.NewObject("pkg", "Style", ast.NewStruct(
+		ast.NewStructField("mode", ast.String()),
+		ast.NewStructField("width", ast.NewScalar(ast.KindInt64, ast.Nullable())),
+	))
+	dashboardObject := ast.NewObject("pkg", "Dashboard", ast.NewStruct(
+		ast.NewStructField("tags", ast.NewArray(ast.String())),
+		ast.NewStructField("style", ast.NewRef("pkg", "Style", ast.Nullable())),
+	))
+	schema := &ast.Schema{
+		Package: "pkg",
+		Objects: testutils.ObjectsMap(styleObject, dashboardObject),
+	}
+
+	return ast.Builders{
+		{
+			Schema:  schema,
+			For:     dashboardObject,
+			Package: "pkg",
+			Name:    "Dashboard",
+			Options: []ast.Option{
+				{Name: "tags"},
+			},
+		},
+		{
+			Schema:  schema,
+			For:     styleObject,
+			Package: "pkg",
+			Name:    "Style",
+		},
+	}
+}
+
+func TestAddOption_withArgument(t *testing.T) {
+	req := require.New(t)
+
+	argument := ast.Argument{Name: "tag", Type: ast.String()}
+	rule := AddOption(ByName("pkg", "Dashboard"), CustomOption{
+		Name: "tag",
+		Args: []ast.Argument{argument},
+		Assignments: []CustomAssignment{
+			{PropertyPath: "tags", Method: ast.AppendAssignment, Value: CustomAssignmentValue{Argument: "tag"}},
+		},
+	})
+	updatedBuilders, err := rule(addOptionTestBuilders())
+	req.NoError(err)
+
+	req.Len(updatedBuilders[0].Options, 2)
+	req.Equal([]string{"AddOption[tag]"}, updatedBuilders[0].VeneerTrail)
+
+	option := updatedBuilders[0].Options[1]
+	req.Equal("tag", option.Name)
+	req.Equal([]string{"AddOption"}, option.VeneerTrail)
+	req.Equal([]ast.Assignment{
+		{
+			Path:   ast.Path{{Identifier: "tags", Type: ast.NewArray(ast.String())}},
+			Value:  ast.AssignmentValue{Argument: &argument},
+			Method: ast.AppendAssignment,
+		},
+	}, option.Assignments)
+}
+
+func TestAddOption_withEnvelope(t *testing.T) {
+	req := require.New(t)
+
+	argument := ast.Argument{Name: "mode", Type: ast.String()}
+	rule := AddOption(ByName("pkg", "Dashboard"), CustomOption{
+		Name: "style",
+		Args: []ast.Argument{argument},
+		Assignments: []CustomAssignment{
+			{
+				PropertyPath: "style",
+				Value: CustomAssignmentValue{
+					Envelope: []CustomEnvelopeValue{
+						{PropertyPath: "mode", Value: CustomAssignmentValue{Argument: "mode"}},
+						{PropertyPath: "width", Value: CustomAssignmentValue{Constant: 2}},
+					},
+				},
+			},
+		},
+	})
+	updatedBuilders, err := rule(addOptionTestBuilders())
+	req.NoError(err)
+
+	option := updatedBuilders[0].Options[1]
+	req.Len(option.Assignments, 1)
+
+	assignment := option.Assignments[0]
+	req.Equal(ast.DirectAssignment, assignment.Method)
+	req.Equal("style", assignment.Path.String())
+	req.NotNil(assignment.Value.Envelope)
+
+	envelope := assignment.Value.Envelope
+	req.Equal(ast.NewRef("pkg", "Style"), envelope.Type)
+	req.Equal([]ast.EnvelopeFieldValue{
+		{
+			Path:  ast.Path{{Identifier: "mode", Type: ast.String()}},
+			Value: ast.AssignmentValue{Argument: &argument},
+		},
+		{
+			Path:  ast.Path{{Identifier: "width", Type: ast.NewScalar(ast.KindInt64, ast.Nullable())}},
+			Value: ast.AssignmentValue{Constant: 2},
+		},
+	}, envelope.Values)
+}
+
+func TestAddOption_withExistingOption(t *testing.T) {
+	rule := AddOption(ByName("pkg", "Dashboard"), CustomOption{Name: "Tags"})
+	_, err := rule(addOptionTestBuilders())
+
+	require.ErrorContains(t, err, "an option with the same name already exists")
+}
+
+func TestAddOption_withUnknownArgument(t *testing.T) {
+	rule := AddOption(ByName("pkg", "Dashboard"), CustomOption{
+		Name: "tag",
+		Assignments: []CustomAssignment{
+			{PropertyPath: "tags", Method: ast.AppendAssignment, Value: CustomAssignmentValue{Argument: "tag"}},
+		},
+	})
+	_, err := rule(addOptionTestBuilders())
+
+	require.ErrorContains(t, err, "argument 'tag' not found")
+}
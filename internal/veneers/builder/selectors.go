@@ -1,9 +1,8 @@
 package builder
 
 import (
-	"strings"
-
 	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
 )
 
 type Selector func(builder ast.Builder) bool
@@ -17,20 +16,32 @@ func EveryBuilder() Selector {
 
 // ByObjectName matches builders for the given the object (referred to by its
 // package and name).
-// Note: the comparison on object name is case-insensitive.
+// Note: package and object names support globs. The comparison on object
+// name is case-insensitive.
 func ByObjectName(pkg string, objectName string) Selector {
 	return func(builder ast.Builder) bool {
-		return builder.For.SelfRef.ReferredPkg == pkg &&
-			strings.EqualFold(builder.For.SelfRef.ReferredType, objectName)
+		return tools.GlobMatch(pkg, builder.For.SelfRef.ReferredPkg) &&
+			tools.GlobMatchEqualFold(objectName, builder.For.SelfRef.ReferredType)
 	}
 }
 
 // ByName matches builders for the given name.
-// Note: the comparison on builder name is case-insensitive.
+// Note: package and builder names support globs. The comparison on builder
+// name is case-insensitive.
 func ByName(pkg string, builderName string) Selector {
 	return func(builder ast.Builder) bool {
-		return builder.For.SelfRef.ReferredPkg == pkg &&
-			strings.EqualFold(builder.Name, builderName)
+		return tools.GlobMatch(pkg, builder.For.SelfRef.ReferredPkg) &&
+			tools.GlobMatchEqualFold(builderName, builder.Name)
+	}
+}
+
+// Where narrows down the builders matched by the given selector with
+// predicates on their schema and on the type of the object they build.
+func Where(selector Selector, predicates ast.Predicates) Selector {
+	return func(builder ast.Builder) bool {
+		return selector(builder) &&
+			predicates.MatchesSchema(builder.Schema) &&
+			predicates.MatchesType(builder.For.Type)
 	}
 }
 
@@ -32,3 +32,36 @@ func TestByBuilder(t *testing.T) {
 	req.True(ByName("dashboard", "emptydashboard")(dashboardBuilder))
 	req.False(ByName("dashboard", "Dashboard")(dashboardBuilder))
 }
+
+func TestByObjectName_withGlobs(t *testing.T) {
+	req := require.New(t)
+
+	dashboardBuilder := ast.Builder{
+		Name: "EmptyDashboard",
+		For:  ast.NewObject("dashboard", "Dashboard", ast.NewStruct()),
+	}
+
+	req.True(ByObjectName("*", "Dashboard")(dashboardBuilder))
+	req.True(ByObjectName("dash*", "*board")(dashboardBuilder))
+	req.False(ByObjectName("*", "Panel*")(dashboardBuilder))
+	req.True(ByObjectName("dashboard", "DASH*")(dashboardBuilder))
+	req.False(ByObjectName("Dash*", "Dashboard")(dashboardBuilder))
+}
+
+func TestWhere(t *testing.T) {
+	req := require.New(t)
+
+	panelBuilder := ast.Builder{
+		Schema: &ast.Schema{
+			Package:  "timeseries",
+			Metadata: ast.SchemaMeta{Variant: ast.SchemaVariantPanel, Identifier: "timeseries"},
+		},
+		Name: "Panel",
+		For:  ast.NewObject("timeseries", "Options", ast.NewStruct()),
+	}
+
+	req.True(Where(ByName("*", "Panel"), ast.Predicates{Variant: ast.SchemaVariantPanel})(panelBuilder))
+	req.True(Where(ByName("*", "Panel"), ast.Predicates{Identifier: "time*", Kind: ast.KindStruct})(panelBuilder))
+	req.False(Where(ByName("*", "Panel"), ast.Predicates{Variant: ast.SchemaVariantDataQuery})(panelBuilder))
+	req.False(Where(ByName("*", "Panel"), ast.Predicates{Kind: ast.KindArray})(panelBuilder))
+}
@@ -1,17 +1,20 @@
 package option
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
+	"text/template"
 
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/tools"
 )
 
-type RewriteAction func(builder ast.Builder, option ast.Option) []ast.Option
+type RewriteAction func(schemas ast.Schemas, builder ast.Builder, option ast.Option) []ast.Option
 
 // RenameAction renames an option.
 func RenameAction(newName string) RewriteAction {
-	return func(_ ast.Builder, option ast.Option) []ast.Option {
+	return func(_ ast.Schemas, _ ast.Builder, option ast.Option) []ast.Option {
 		oldName := option.Name
 		option.Name = newName
 		option.AddToVeneerTrail(fmt.Sprintf("Rename[%s → %s]", oldName, newName))
@@ -44,7 +47,7 @@ func RenameAction(newName string) RewriteAction {
 //
 // FIXME: considers the first arg only.
 func ArrayToAppendAction() RewriteAction {
-	return func(_ ast.Builder, option ast.Option) []ast.Option {
+	return func(_ ast.Schemas, _ ast.Builder, option ast.Option) []ast.Option {
 		if len(option.Args) < 1 || !option.Args[0].Type.IsArray() {
 			return []ast.Option{option}
 		}
@@ -60,6 +63,8 @@ func ArrayToAppendAction() RewriteAction {
 
 		newFirstAssignment := option.Assignments[0]
 		newFirstAssignment.Method = ast.AppendAssignment
+		// constraints on the list itself can't be checked against a single item
+		newFirstAssignment.Constraints = nil
 		// TODO: what if there is an envelope in the value assignment?
 		if newFirstAssignment.Value.Argument != nil {
 			newFirstAssignment.Value.Argument.Type = newFirstArg.Type
@@ -83,14 +88,14 @@ func ArrayToAppendAction() RewriteAction {
 
 // OmitAction removes an option.
 func OmitAction() RewriteAction {
-	return func(_ ast.Builder, _ ast.Option) []ast.Option {
+	return func(_ ast.Schemas, _ ast.Builder, _ ast.Option) []ast.Option {
 		return nil
 	}
 }
 
 // VeneerTrailAsCommentsAction removes an option.
 func VeneerTrailAsCommentsAction() RewriteAction {
-	return func(_ ast.Builder, opt ast.Option) []ast.Option {
+	return func(_ ast.Schemas, _ ast.Builder, opt ast.Option) []ast.Option {
 		veneerTrail := tools.Map(opt.VeneerTrail, func(veneer string) string {
 			return fmt.Sprintf("Modified by veneer '%s'", veneer)
 		})
@@ -105,7 +110,7 @@ func VeneerTrailAsCommentsAction() RewriteAction {
 // This flag indicates builder jennies that the arguments and assignments described by this option
 // should be exposed by the builder's constructor.
 func PromoteToConstructorAction() RewriteAction {
-	return func(_ ast.Builder, option ast.Option) []ast.Option {
+	return func(_ ast.Schemas, _ ast.Builder, option ast.Option) []ast.Option {
 		option.IsConstructorArg = true
 		option.AddToVeneerTrail("PromoteToConstructor")
 
@@ -141,7 +146,7 @@ func PromoteToConstructorAction() RewriteAction {
 //
 // FIXME: considers the first argument only.
 func StructFieldsAsArgumentsAction(explicitFields ...string) RewriteAction {
-	return func(builder ast.Builder, option ast.Option) []ast.Option {
+	return func(_ ast.Schemas, builder ast.Builder, option ast.Option) []ast.Option {
 		if len(option.Args) < 1 {
 			return []ast.Option{option}
 		}
@@ -177,11 +182,6 @@ func StructFieldsAsArgumentsAction(explicitFields ...string) RewriteAction {
 				continue
 			}
 
-			var constraints []ast.TypeConstraint
-			if field.Type.IsScalar() {
-				constraints = field.Type.AsScalar().Constraints
-			}
-
 			newArg := ast.Argument{
 				Name: field.Name,
 				Type: field.Type,
@@ -204,7 +204,7 @@ func StructFieldsAsArgumentsAction(explicitFields ...string) RewriteAction {
 					newAssignment = ast.ArgumentAssignment(
 						assignmentPathPrefix.Append(ast.PathFromStructField(field)),
 						newArg,
-						ast.Constraints(constraints),
+						ast.Constraints(field.Type.Constraints()),
 						ast.Method(oldAssignments[0].Method),
 					)
 				}
@@ -281,7 +281,7 @@ func StructFieldsAsArgumentsAction(explicitFields ...string) RewriteAction {
 //
 // FIXME: considers the first argument only.
 func StructFieldsAsOptionsAction(explicitFields ...string) RewriteAction {
-	return func(builder ast.Builder, option ast.Option) []ast.Option {
+	return func(_ ast.Schemas, builder ast.Builder, option ast.Option) []ast.Option {
 		if len(option.Args) < 1 {
 			return []ast.Option{option}
 		}
@@ -365,7 +365,7 @@ func StructFieldsAsOptionsAction(explicitFields ...string) RewriteAction {
 //
 // FIXME: considers the first argument only.
 func DisjunctionAsOptionsAction() RewriteAction {
-	return func(builder ast.Builder, option ast.Option) []ast.Option {
+	return func(_ ast.Schemas, builder ast.Builder, option ast.Option) []ast.Option {
 		if len(option.Args) < 1 {
 			return []ast.Option{option}
 		}
@@ -499,7 +499,7 @@ type BooleanUnfold struct {
 //	}
 //	```
 func UnfoldBooleanAction(unfoldOpts BooleanUnfold) RewriteAction {
-	return func(_ ast.Builder, option ast.Option) []ast.Option {
+	return func(_ ast.Schemas, _ ast.Builder, option ast.Option) []ast.Option {
 		intoType := option.Assignments[0].Path.Last().Type
 
 		if !intoType.IsScalar() || intoType.Scalar.ScalarKind != ast.KindBool {
@@ -540,3 +540,156 @@ func UnfoldBooleanAction(unfoldOpts BooleanUnfold) RewriteAction {
 		return newOpts
 	}
 }
+
+// DefaultEnumUnfoldTemplate names options after the original option and the
+// enum value: `displayMode` and `Table` give `displayModeTable`.
+const DefaultEnumUnfoldTemplate = "{{ .Option | lowerCamelCase }}{{ .Name | upperCamelCase }}"
+
+// EnumUnfoldName describes the data available to the template used to name
+// the options created by UnfoldEnumAction.
+type EnumUnfoldName struct {
+	// Option is the name of the original option.
+	Option string
+	// Name is the name of the enum value, without the enum name prefix that
+	// some languages add (see the PrefixEnumValues compiler pass).
+	Name string
+	// Value is the enum value itself.
+	Value any
+}
+
+type EnumUnfold struct {
+	nameTemplate *template.Template
+}
+
+// NewEnumUnfold parses the template used to name the options created by
+// UnfoldEnumAction. See EnumUnfoldName for the data it is executed with.
+// An empty template defaults to DefaultEnumUnfoldTemplate.
+func NewEnumUnfold(nameTemplate string) (EnumUnfold, error) {
+	if nameTemplate == "" {
+		nameTemplate = DefaultEnumUnfoldTemplate
+	}
+
+	tmpl, err := template.New("unfold_enum").
+		Option("missingkey=error").
+		Funcs(template.FuncMap{
+			"upperCamelCase": tools.UpperCamelCase,
+			"lowerCamelCase": tools.LowerCamelCase,
+		}).
+		Parse(nameTemplate)
+	if err != nil {
+		return EnumUnfold{}, fmt.Errorf("invalid option name template '%s': %w", nameTemplate, err)
+	}
+
+	return EnumUnfold{nameTemplate: tmpl}, nil
+}
+
+func (unfold EnumUnfold) optionName(data EnumUnfoldName) (string, error) {
+	buffer := bytes.Buffer{}
+	if err := unfold.nameTemplate.Execute(&buffer, data); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// UnfoldEnumAction transforms an option accepting an enum argument into
+// argument-less options: one per enum value.
+//
+// Example:
+//
+//	```
+//	func DisplayMode(displayMode DisplayMode) {
+//		this.resource.displayMode = displayMode
+//	}
+//	```
+//
+// With a "displayAs{{ .Name | upperCamelCase }}" template, will become:
+//
+//	```
+//	func DisplayAsList() {
+//		this.resource.displayMode = DisplayModeList
+//	}
+//
+//	func DisplayAsTable() {
+//		this.resource.displayMode = DisplayModeTable
+//	}
+//	```
+//
+// This action returns the option unchanged if:
+//   - it doesn't have exactly one assignment
+//   - the assigned value is not an enum or a reference to one
+func UnfoldEnumAction(unfold EnumUnfold) RewriteAction {
+	return func(schemas ast.Schemas, builder ast.Builder, option ast.Option) []ast.Option {
+		if len(option.Assignments) != 1 {
+			return []ast.Option{option}
+		}
+
+		assignmentPath := option.Assignments[0].Path
+
+		enum, enumName, found := resolveEnum(schemas, builder, assignmentPath.Last().Type)
+		if !found {
+			return []ast.Option{option}
+		}
+
+		newOpts := make([]ast.Option, 0, len(enum.Values))
+		for _, enumValue := range enum.Values {
+			name, err := unfold.optionName(EnumUnfoldName{
+				Option: option.Name,
+				Name:   trimEnumPrefix(enumName, enumValue.Name),
+				Value:  enumValue.Value,
+			})
+			if err != nil {
+				return []ast.Option{option}
+			}
+
+			newOpt := ast.Option{
+				Name:     name,
+				Comments: option.Comments,
+				Assignments: []ast.Assignment{
+					ast.ConstantAssignment(assignmentPath, enumValue.Value),
+				},
+				VeneerTrail: append([]string{}, option.VeneerTrail...),
+			}
+
+			if option.Default != nil && len(option.Default.ArgsValues) != 0 && fmt.Sprintf("%v", option.Default.ArgsValues[0]) == fmt.Sprintf("%v", enumValue.Value) {
+				newOpt.Default = &ast.OptionDefault{}
+			}
+
+			newOpt.AddToVeneerTrail("UnfoldEnum")
+
+			newOpts = append(newOpts, newOpt)
+		}
+
+		return newOpts
+	}
+}
+
+func resolveEnum(schemas ast.Schemas, builder ast.Builder, typeDef ast.Type) (ast.EnumType, string, bool) {
+	if typeDef.IsEnum() {
+		return typeDef.AsEnum(), "", true
+	}
+
+	if !typeDef.IsRef() {
+		return ast.EnumType{}, "", false
+	}
+
+	if builder.Schema != nil {
+		schemas = append(ast.Schemas{builder.Schema}, schemas...)
+	}
+
+	referredObj, found := schemas.LocateObject(typeDef.AsRef().ReferredPkg, typeDef.AsRef().ReferredType)
+	if !found || !referredObj.Type.IsEnum() {
+		return ast.EnumType{}, "", false
+	}
+
+	return referredObj.Type.AsEnum(), referredObj.Name, true
+}
+
+func trimEnumPrefix(enumName string, valueName string) string {
+	trimmed := strings.TrimPrefix(valueName, tools.UpperCamelCase(enumName))
+	if enumName == "" || trimmed == "" {
+		return valueName
+	}
+
+	return trimmed
+}
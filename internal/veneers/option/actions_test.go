@@ -12,7 +12,7 @@ func TestRenameAction(t *testing.T) {
 	req := require.New(t)
 
 	option := ast.Option{Name: "Name"}
-	modifiedOpts := RenameAction("NewName")(ast.Builder{}, option)
+	modifiedOpts := RenameAction("NewName")(nil, ast.Builder{}, option)
 
 	req.Len(modifiedOpts, 1)
 	req.Equal("NewName", modifiedOpts[0].Name)
@@ -22,7 +22,7 @@ func TestOmitAction(t *testing.T) {
 	req := require.New(t)
 
 	option := ast.Option{Name: "Name"}
-	modifiedOpts := OmitAction()(ast.Builder{}, option)
+	modifiedOpts := OmitAction()(nil, ast.Builder{}, option)
 
 	req.Empty(modifiedOpts)
 }
@@ -31,7 +31,7 @@ func TestPromoteToConstructorAction(t *testing.T) {
 	req := require.New(t)
 
 	option := ast.Option{Name: "Name", IsConstructorArg: false}
-	modifiedOpts := PromoteToConstructorAction()(ast.Builder{}, option)
+	modifiedOpts := PromoteToConstructorAction()(nil, ast.Builder{}, option)
 
 	req.Len(modifiedOpts, 1)
 	req.True(modifiedOpts[0].IsConstructorArg)
@@ -53,7 +53,7 @@ func TestUnfoldBooleanAction(t *testing.T) {
 	modifiedOpts := UnfoldBooleanAction(BooleanUnfold{
 		OptionTrue:  "Editable",
 		OptionFalse: "ReadOnly",
-	})(ast.Builder{}, option)
+	})(nil, ast.Builder{}, option)
 
 	req.Len(modifiedOpts, 2)
 
@@ -89,7 +89,7 @@ func TestUnfoldBooleanAction_onNonBooleanDoesNothing(t *testing.T) {
 	modifiedOpts := UnfoldBooleanAction(BooleanUnfold{
 		OptionTrue:  "TrueOpt",
 		OptionFalse: "FalseOpt",
-	})(ast.Builder{}, option)
+	})(nil, ast.Builder{}, option)
 
 	req.Len(modifiedOpts, 1)
 	req.Equal(option, modifiedOpts[0])
@@ -114,7 +114,7 @@ func TestDisjunctionAsOptionsAction_withDisjunction(t *testing.T) {
 			}, ast.Argument{Name: "tags", Type: disjunctionType}),
 		},
 	}
-	modifiedOpts := DisjunctionAsOptionsAction()(ast.Builder{}, option)
+	modifiedOpts := DisjunctionAsOptionsAction()(nil, ast.Builder{}, option)
 
 	req.Len(modifiedOpts, 2)
 
@@ -161,7 +161,7 @@ func TestDisjunctionAsOptionsAction_withDisjunctionStruct(t *testing.T) {
 			}, ast.Argument{Name: "tags", Type: ref}),
 		},
 	}
-	modifiedOpts := DisjunctionAsOptionsAction()(builder, option)
+	modifiedOpts := DisjunctionAsOptionsAction()(nil, builder, option)
 
 	req.Len(modifiedOpts, 2)
 
@@ -204,7 +204,7 @@ func TestStructFieldsAsOptionsAction_withRefArg(t *testing.T) {
 			}, ast.Argument{Name: "editable", Type: ref}),
 		},
 	}
-	modifiedOpts := StructFieldsAsOptionsAction("from", "to")(builder, option)
+	modifiedOpts := StructFieldsAsOptionsAction("from", "to")(nil, builder, option)
 
 	req.Len(modifiedOpts, 2)
 
@@ -233,7 +233,7 @@ func TestArrayToAppendAction_withNoArgument(t *testing.T) {
 			}, true),
 		},
 	}
-	modifiedOpts := ArrayToAppendAction()(ast.Builder{}, option)
+	modifiedOpts := ArrayToAppendAction()(nil, ast.Builder{}, option)
 
 	req.Equal([]ast.Option{option}, modifiedOpts)
 }
@@ -251,7 +251,7 @@ func TestArrayToAppendAction_withNonArrayArgument(t *testing.T) {
 			}, ast.Argument{Name: "editable", Type: ast.Bool()}),
 		},
 	}
-	modifiedOpts := ArrayToAppendAction()(ast.Builder{}, option)
+	modifiedOpts := ArrayToAppendAction()(nil, ast.Builder{}, option)
 
 	req.Equal([]ast.Option{option}, modifiedOpts)
 }
@@ -288,7 +288,7 @@ func TestArrayToAppendAction_withArrayArgument(t *testing.T) {
 		VeneerTrail: []string{"ArrayToAppend"},
 	}
 
-	modifiedOpts := ArrayToAppendAction()(ast.Builder{}, option)
+	modifiedOpts := ArrayToAppendAction()(nil, ast.Builder{}, option)
 
 	req.Equal([]ast.Option{expectedOption}, modifiedOpts)
 }
@@ -303,7 +303,7 @@ func TestStructFieldsAsArgumentsAction_withNoArgument(t *testing.T) {
 			}, true),
 		},
 	}
-	modifiedOpts := StructFieldsAsArgumentsAction()(ast.Builder{}, option)
+	modifiedOpts := StructFieldsAsArgumentsAction()(nil, ast.Builder{}, option)
 
 	req.Equal([]ast.Option{option}, modifiedOpts)
 }
@@ -321,7 +321,7 @@ func TestStructFieldsAsArgumentsAction_withNonStructArgument(t *testing.T) {
 			}, ast.Argument{Name: "tags", Type: ast.NewArray(ast.String())}),
 		},
 	}
-	modifiedOpts := StructFieldsAsArgumentsAction()(ast.Builder{}, option)
+	modifiedOpts := StructFieldsAsArgumentsAction()(nil, ast.Builder{}, option)
 
 	req.Equal([]ast.Option{option}, modifiedOpts)
 }
@@ -370,7 +370,7 @@ func TestStructFieldsAsArgumentsAction_withStructArgument(t *testing.T) {
 		VeneerTrail: []string{"StructFieldsAsArguments"},
 	}
 
-	modifiedOpts := StructFieldsAsArgumentsAction()(ast.Builder{}, option)
+	modifiedOpts := StructFieldsAsArgumentsAction()(nil, ast.Builder{}, option)
 
 	req.Equal([]ast.Option{expectedOption}, modifiedOpts)
 }
@@ -436,7 +436,146 @@ func TestStructFieldsAsArgumentsAction_withArrayOfStructArgument(t *testing.T) {
 		VeneerTrail: []string{"StructFieldsAsArguments"},
 	}
 
-	modifiedOpts := StructFieldsAsArgumentsAction()(ast.Builder{}, option)
+	modifiedOpts := StructFieldsAsArgumentsAction()(nil, ast.Builder{}, option)
 
 	req.Equal([]ast.Option{expectedOption}, modifiedOpts)
 }
+
+func TestUnfoldEnumAction_withEnumRef(t *testing.T) {
+	req := require.New(t)
+
+	schema := ast.NewSchema("timeseries", ast.SchemaMeta{})
+	schema.AddObject(ast.NewObject("timeseries", "DisplayMode", ast.NewEnum([]ast.EnumValue{
+		// as prefixed by the PrefixEnumValues compiler pass
+		{Name: "DisplayModeList", Type: ast.String(), Value: "list"},
+		{Name: "DisplayModeTable", Type: ast.String(), Value: "table"},
+	})))
+
+	displayModeRef := ast.NewRef("timeseries", "DisplayMode")
+	option := ast.Option{
+		Name: "displayMode",
+		Args: []ast.Argument{
+			{Name: "displayMode", Type: displayModeRef},
+		},
+		Assignments: []ast.Assignment{
+			ast.ArgumentAssignment(ast.Path{
+				{Identifier: "displayMode", Type: displayModeRef},
+			}, ast.Argument{Name: "displayMode", Type: displayModeRef}),
+		},
+		Default: &ast.OptionDefault{ArgsValues: []any{"table"}},
+	}
+
+	unfold, err := NewEnumUnfold("displayAs{{ .Name | upperCamelCase }}")
+	req.NoError(err)
+
+	modifiedOpts := UnfoldEnumAction(unfold)(nil, ast.Builder{Schema: schema}, option)
+
+	req.Len(modifiedOpts, 2)
+
+	listOpt := modifiedOpts[0]
+	req.Equal("displayAsList", listOpt.Name)
+	req.Empty(listOpt.Args)
+	req.Len(listOpt.Assignments, 1)
+	req.Equal("list", listOpt.Assignments[0].Value.Constant)
+	req.Nil(listOpt.Default)
+
+	tableOpt := modifiedOpts[1]
+	req.Equal("displayAsTable", tableOpt.Name)
+	req.Equal("table", tableOpt.Assignments[0].Value.Constant)
+	req.NotNil(tableOpt.Default)
+}
+
+func TestUnfoldEnumAction_withEnumRefFromAnotherPackage(t *testing.T) {
+	req := require.New(t)
+
+	commonSchema := ast.NewSchema("common", ast.SchemaMeta{})
+	commonSchema.AddObject(ast.NewObject("common", "LegendPlacement", ast.NewEnum([]ast.EnumValue{
+		{Name: "Bottom", Type: ast.String(), Value: "bottom"},
+		{Name: "Right", Type: ast.String(), Value: "right"},
+	})))
+
+	placementRef := ast.NewRef("common", "LegendPlacement", ast.Nullable())
+	option := ast.Option{
+		Name: "placement",
+		Args: []ast.Argument{
+			{Name: "placement", Type: placementRef},
+		},
+		Assignments: []ast.Assignment{
+			ast.ArgumentAssignment(ast.Path{
+				{Identifier: "placement", Type: placementRef},
+			}, ast.Argument{Name: "placement", Type: placementRef}),
+		},
+	}
+
+	unfold, err := NewEnumUnfold("")
+	req.NoError(err)
+
+	builder := ast.Builder{Schema: ast.NewSchema("timeseries", ast.SchemaMeta{})}
+	modifiedOpts := UnfoldEnumAction(unfold)(ast.Schemas{builder.Schema, commonSchema}, builder, option)
+
+	req.Len(modifiedOpts, 2)
+	req.Equal("placementBottom", modifiedOpts[0].Name)
+	req.Equal("bottom", modifiedOpts[0].Assignments[0].Value.Constant)
+	req.Equal("placementRight", modifiedOpts[1].Name)
+	req.Equal("right", modifiedOpts[1].Assignments[0].Value.Constant)
+}
+
+func TestUnfoldEnumAction_withAnonymousEnumAndDefaultTemplate(t *testing.T) {
+	req := require.New(t)
+
+	enumType := ast.NewEnum([]ast.EnumValue{
+		{Name: "Default", Type: ast.NewScalar(ast.KindInt64), Value: 0},
+		{Name: "SharedCrosshair", Type: ast.NewScalar(ast.KindInt64), Value: 1},
+	})
+	option := ast.Option{
+		Name: "graphTooltip",
+		Args: []ast.Argument{
+			{Name: "graphTooltip", Type: enumType},
+		},
+		Assignments: []ast.Assignment{
+			ast.ArgumentAssignment(ast.Path{
+				{Identifier: "graphTooltip", Type: enumType},
+			}, ast.Argument{Name: "graphTooltip", Type: enumType}),
+		},
+	}
+
+	unfold, err := NewEnumUnfold("")
+	req.NoError(err)
+
+	modifiedOpts := UnfoldEnumAction(unfold)(nil, ast.Builder{}, option)
+
+	req.Len(modifiedOpts, 2)
+	req.Equal("graphTooltipDefault", modifiedOpts[0].Name)
+	req.Equal(0, modifiedOpts[0].Assignments[0].Value.Constant)
+	req.Equal("graphTooltipSharedCrosshair", modifiedOpts[1].Name)
+	req.Equal(1, modifiedOpts[1].Assignments[0].Value.Constant)
+}
+
+func TestUnfoldEnumAction_onNonEnumDoesNothing(t *testing.T) {
+	req := require.New(t)
+
+	option := ast.Option{
+		Name: "title",
+		Args: []ast.Argument{
+			{Name: "title", Type: ast.String()},
+		},
+		Assignments: []ast.Assignment{
+			ast.ArgumentAssignment(ast.Path{
+				{Identifier: "title", Type: ast.String()},
+			}, ast.Argument{Name: "title", Type: ast.String()}),
+		},
+	}
+
+	unfold, err := NewEnumUnfold("")
+	req.NoError(err)
+
+	modifiedOpts := UnfoldEnumAction(unfold)(nil, ast.Builder{}, option)
+
+	req.Len(modifiedOpts, 1)
+	req.Equal(option, modifiedOpts[0])
+}
+
+func TestNewEnumUnfold_withInvalidTemplate(t *testing.T) {
+	_, err := NewEnumUnfold("{{ .Name | unknownFunc }}")
+	require.ErrorContains(t, err, "invalid option name template")
+}
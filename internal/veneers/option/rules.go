@@ -40,6 +40,13 @@ func UnfoldBoolean(selector Selector, unfoldOpts BooleanUnfold) RewriteRule {
 	}
 }
 
+func UnfoldEnum(selector Selector, unfold EnumUnfold) RewriteRule {
+	return RewriteRule{
+		Selector: selector,
+		Action:   UnfoldEnumAction(unfold),
+	}
+}
+
 func PromoteToConstructor(selector Selector) RewriteRule {
 	return RewriteRule{
 		Selector: selector,
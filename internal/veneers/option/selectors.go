@@ -1,8 +1,6 @@
 package option
 
 import (
-	"strings"
-
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/tools"
 )
@@ -18,21 +16,40 @@ func EveryOption() Selector {
 
 // ByName matches options by their name, defined for builders for the given
 // object (referred to by its package and name).
-// Note: the comparison on object and options names is case-insensitive.
+// Note: package, object and options names support globs. The comparison on
+// object and options names is case-insensitive.
 func ByName(pkg string, objectName string, optionNames ...string) Selector {
 	return func(builder ast.Builder, option ast.Option) bool {
-		return builder.For.SelfRef.ReferredPkg == pkg &&
-			strings.EqualFold(builder.For.Name, objectName) &&
-			tools.StringInListEqualFold(option.Name, optionNames)
+		return tools.GlobMatch(pkg, builder.For.SelfRef.ReferredPkg) &&
+			tools.GlobMatchEqualFold(objectName, builder.For.Name) &&
+			tools.GlobInListEqualFold(option.Name, optionNames)
 	}
 }
 
-// ByBuilder matches options by their name and the name of the builder containing them..
-// Note: the comparison on builder and options names is case-insensitive.
+// ByBuilder matches options by their name and the name of the builder containing them.
+// Note: package, builder and options names support globs. The comparison on
+// builder and options names is case-insensitive.
 func ByBuilder(pkg string, builderName string, optionNames ...string) Selector {
 	return func(builder ast.Builder, option ast.Option) bool {
-		return builder.Package == pkg &&
-			strings.EqualFold(builder.Name, builderName) &&
-			tools.StringInListEqualFold(option.Name, optionNames)
+		return tools.GlobMatch(pkg, builder.Package) &&
+			tools.GlobMatchEqualFold(builderName, builder.Name) &&
+			tools.GlobInListEqualFold(option.Name, optionNames)
+	}
+}
+
+// Where narrows down the options matched by the given selector with
+// predicates on the schema of their builder and on the type of their
+// first argument.
+func Where(selector Selector, predicates ast.Predicates) Selector {
+	return func(builder ast.Builder, option ast.Option) bool {
+		if !selector(builder, option) || !predicates.MatchesSchema(builder.Schema) {
+			return false
+		}
+
+		if predicates.Kind == "" && predicates.ScalarKind == "" {
+			return true
+		}
+
+		return len(option.Args) != 0 && predicates.MatchesType(option.Args[0].Type)
 	}
 }
@@ -103,3 +103,53 @@ func filter(selector Selector, builder ast.Builder, opts []ast.Option) []ast.Opt
 
 	return selected
 }
+
+func TestByName_withGlobs(t *testing.T) {
+	req := require.New(t)
+
+	panelBuilder := ast.Builder{
+		For: ast.NewObject("timeseries", "Options", ast.NewStruct()),
+	}
+	options := []ast.Option{
+		{Name: "LegendShow"},
+		{Name: "LegendMode"},
+		{Name: "Tooltip"},
+	}
+
+	selected := filter(ByName("*", "options", "legend*"), panelBuilder, options)
+
+	req.Len(selected, 2)
+	req.Equal("LegendShow", selected[0].Name)
+	req.Equal("LegendMode", selected[1].Name)
+
+	req.Len(filter(ByName("time*", "Opt??ns", "tooltip"), panelBuilder, options), 1)
+	req.Len(filter(ByName("dashboard", "*", "*"), panelBuilder, options), 0)
+}
+
+func TestWhere(t *testing.T) {
+	req := require.New(t)
+
+	panelBuilder := ast.Builder{
+		Schema: &ast.Schema{
+			Package:  "timeseries",
+			Metadata: ast.SchemaMeta{Variant: ast.SchemaVariantPanel},
+		},
+		For: ast.NewObject("timeseries", "Options", ast.NewStruct()),
+	}
+	options := []ast.Option{
+		{Name: "LegendShow", Args: []ast.Argument{{Name: "show", Type: ast.Bool()}}},
+		{Name: "LegendMode", Args: []ast.Argument{{Name: "mode", Type: ast.String()}}},
+		{Name: "LegendReset"},
+	}
+
+	boolSelector := Where(ByName("*", "Options", "*"), ast.Predicates{ScalarKind: ast.KindBool})
+	selected := filter(boolSelector, panelBuilder, options)
+	req.Len(selected, 1)
+	req.Equal("LegendShow", selected[0].Name)
+
+	panelSelector := Where(ByName("*", "Options", "*"), ast.Predicates{Variant: ast.SchemaVariantPanel})
+	req.Len(filter(panelSelector, panelBuilder, options), 3)
+
+	dataquerySelector := Where(ByName("*", "Options", "*"), ast.Predicates{Variant: ast.SchemaVariantDataQuery})
+	req.Len(filter(dataquerySelector, panelBuilder, options), 0)
+}
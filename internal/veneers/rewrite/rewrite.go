@@ -44,7 +44,7 @@ func NewRewrite(languageRules []LanguageRules, config Config) *Rewriter {
 	}
 }
 
-func (engine *Rewriter) ApplyTo(builders []ast.Builder, language string) ([]ast.Builder, error) {
+func (engine *Rewriter) ApplyTo(schemas ast.Schemas, builders []ast.Builder, language string) ([]ast.Builder, error) {
 	var err error
 	// TODO: should we deepCopy the builders instead?
 	newBuilders := make([]ast.Builder, 0, len(builders))
@@ -58,7 +58,7 @@ func (engine *Rewriter) ApplyTo(builders []ast.Builder, language string) ([]ast.
 			return nil, err
 		}
 
-		newBuilders = engine.applyOptionRules(newBuilders, engine.optionRules[l])
+		newBuilders = engine.applyOptionRules(schemas, newBuilders, engine.optionRules[l])
 	}
 
 	// and optionally, apply "debug" veneers
@@ -68,7 +68,7 @@ func (engine *Rewriter) ApplyTo(builders []ast.Builder, language string) ([]ast.
 			return nil, err
 		}
 
-		newBuilders = engine.applyOptionRules(newBuilders, engine.debugOptionRules())
+		newBuilders = engine.applyOptionRules(schemas, newBuilders, engine.debugOptionRules())
 	}
 
 	return newBuilders, nil
@@ -87,7 +87,7 @@ func (engine *Rewriter) applyBuilderRules(builders []ast.Builder, rules []builde
 	return builders, nil
 }
 
-func (engine *Rewriter) applyOptionRules(builders []ast.Builder, rules []option.RewriteRule) []ast.Builder {
+func (engine *Rewriter) applyOptionRules(schemas ast.Schemas, builders []ast.Builder, rules []option.RewriteRule) []ast.Builder {
 	for _, rule := range rules {
 		for i, b := range builders {
 			processedOptions := make([]ast.Option, 0, len(b.Options))
@@ -98,7 +98,7 @@ func (engine *Rewriter) applyOptionRules(builders []ast.Builder, rules []option.
 					continue
 				}
 
-				processedOptions = append(processedOptions, rule.Action(b, opt)...)
+				processedOptions = append(processedOptions, rule.Action(schemas, b, opt)...)
 			}
 
 			builders[i].Options = processedOptions
@@ -20,6 +20,7 @@ type BuilderRule struct {
 	Properties            *Properties            `yaml:"properties"`
 	Duplicate             *Duplicate             `yaml:"duplicate"`
 	Initialize            *Initialize            `yaml:"initialize"`
+	AddOption             *AddOption             `yaml:"add_option"`
 }
 
 func (rule BuilderRule) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
@@ -56,6 +57,10 @@ func (rule BuilderRule) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
 		return rule.Initialize.AsRewriteRule(pkg)
 	}
 
+	if rule.AddOption != nil {
+		return rule.AddOption.AsRewriteRule(pkg)
+	}
+
 	return nil, fmt.Errorf("empty rule")
 }
 
@@ -163,6 +168,117 @@ func (rule Initialize) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
 	), nil
 }
 
+type AddOption struct {
+	BuilderSelector `yaml:",inline"`
+	Option          CustomOption `yaml:"option"`
+}
+
+func (rule AddOption) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
+	selector, err := rule.AsSelector(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule.Option.Name == "" {
+		return nil, fmt.Errorf("add_option: option name is required")
+	}
+
+	assignments := make([]builder.CustomAssignment, 0, len(rule.Option.Assignments))
+	for _, assignment := range rule.Option.Assignments {
+		customAssignment, err := assignment.asCustomAssignment()
+		if err != nil {
+			return nil, fmt.Errorf("add_option '%s': %w", rule.Option.Name, err)
+		}
+
+		assignments = append(assignments, customAssignment)
+	}
+
+	return builder.AddOption(selector, builder.CustomOption{
+		Name:        rule.Option.Name,
+		Comments:    rule.Option.Comments,
+		Args:        rule.Option.Args,
+		Assignments: assignments,
+	}), nil
+}
+
+type CustomOption struct {
+	Name        string             `yaml:"name"`
+	Comments    []string           `yaml:"comments"`
+	Args        []ast.Argument     `yaml:"args"`
+	Assignments []CustomAssignment `yaml:"assignments"`
+}
+
+type CustomAssignment struct {
+	Path   string                `yaml:"path"`   // dot-separated list of fields
+	Method string                `yaml:"method"` // direct (default) or append
+	Value  CustomAssignmentValue `yaml:"value"`
+}
+
+func (assignment CustomAssignment) asCustomAssignment() (builder.CustomAssignment, error) {
+	method := ast.AssignmentMethod(assignment.Method)
+	if method != "" && method != ast.DirectAssignment && method != ast.AppendAssignment {
+		return builder.CustomAssignment{}, fmt.Errorf("unknown assignment method '%s'", assignment.Method)
+	}
+
+	value, err := assignment.Value.asCustomAssignmentValue()
+	if err != nil {
+		return builder.CustomAssignment{}, err
+	}
+
+	return builder.CustomAssignment{
+		PropertyPath: assignment.Path,
+		Method:       method,
+		Value:        value,
+	}, nil
+}
+
+type CustomAssignmentValue struct {
+	Argument string                `yaml:"argument"` // name of one of the option's arguments
+	Constant any                   `yaml:"constant"`
+	Envelope []CustomEnvelopeValue `yaml:"envelope"`
+}
+
+func (value CustomAssignmentValue) asCustomAssignmentValue() (builder.CustomAssignmentValue, error) {
+	valuesCount := 0
+	if value.Argument != "" {
+		valuesCount++
+	}
+	if value.Constant != nil {
+		valuesCount++
+	}
+	if len(value.Envelope) != 0 {
+		valuesCount++
+	}
+
+	if valuesCount != 1 {
+		return builder.CustomAssignmentValue{}, fmt.Errorf("exactly one of 'argument', 'constant' or 'envelope' is expected")
+	}
+
+	envelope := make([]builder.CustomEnvelopeValue, 0, len(value.Envelope))
+	for _, envelopeValue := range value.Envelope {
+		fieldValue, err := envelopeValue.Value.asCustomAssignmentValue()
+		if err != nil {
+			return builder.CustomAssignmentValue{}, err
+		}
+
+		envelope = append(envelope, builder.CustomEnvelopeValue{
+			PropertyPath: envelopeValue.Path,
+			Value:        fieldValue,
+		})
+	}
+
+	return builder.CustomAssignmentValue{
+		Argument: value.Argument,
+		Constant: value.Constant,
+		Envelope: envelope,
+	}, nil
+}
+
+type CustomEnvelopeValue struct {
+	Path  string                `yaml:"path"`
+	Value CustomAssignmentValue `yaml:"value"`
+}
+
 /******************************************************************************
  * Selectors
  *****************************************************************************/
@@ -172,9 +288,29 @@ type BuilderSelector struct {
 	ByName   *string `yaml:"by_name"`
 
 	GeneratedFromDisjunction *bool `yaml:"generated_from_disjunction"` // noop?
+
+	Predicates `yaml:",inline"`
 }
 
 func (selector BuilderSelector) AsSelector(pkg string) (builder.Selector, error) {
+	baseSelector, err := selector.baseSelector(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	predicates, err := selector.AsPredicates()
+	if err != nil {
+		return nil, err
+	}
+
+	if predicates.IsEmpty() {
+		return baseSelector, nil
+	}
+
+	return builder.Where(baseSelector, predicates), nil
+}
+
+func (selector BuilderSelector) baseSelector(pkg string) (builder.Selector, error) {
 	if selector.ByObject != nil {
 		return builder.ByObjectName(pkg, *selector.ByObject), nil
 	}
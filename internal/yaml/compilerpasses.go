@@ -2,6 +2,7 @@ package yaml
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/ast/compiler"
@@ -20,11 +21,15 @@ type CompilerPass struct {
 	RetypeField             *RetypeField             `yaml:"retype_field"`
 	SchemaSetIdentifier     *SchemaSetIdentifier     `yaml:"schema_set_identifier"`
 
+	DisjunctionSetDiscriminator *DisjunctionSetDiscriminator `yaml:"disjunction_set_discriminator"`
+	DisjunctionBranches         *DisjunctionBranches         `yaml:"disjunction_branches"`
+	ReplaceObject               *ReplaceObject               `yaml:"replace_object"`
+	MoveObject                  *MoveObject                  `yaml:"move_object"`
+
 	DashboardPanels *DashboardPanels `yaml:"dashboard_panels"`
 
-	Cloudwatch            *Cloudwatch            `yaml:"cloudwatch"`
-	GoogleCloudMonitoring *GoogleCloudMonitoring `yaml:"google_cloud_monitoring"`
-	LibraryPanels         *LibraryPanels         `yaml:"library_panels"`
+	Cloudwatch    *Cloudwatch    `yaml:"cloudwatch"`
+	LibraryPanels *LibraryPanels `yaml:"library_panels"`
 }
 
 func (pass CompilerPass) AsCompilerPass() (compiler.Pass, error) {
@@ -63,6 +68,19 @@ func (pass CompilerPass) AsCompilerPass() (compiler.Pass, error) {
 		return pass.SchemaSetIdentifier.AsCompilerPass()
 	}
 
+	if pass.DisjunctionSetDiscriminator != nil {
+		return pass.DisjunctionSetDiscriminator.AsCompilerPass()
+	}
+	if pass.DisjunctionBranches != nil {
+		return pass.DisjunctionBranches.AsCompilerPass()
+	}
+	if pass.ReplaceObject != nil {
+		return pass.ReplaceObject.AsCompilerPass()
+	}
+	if pass.MoveObject != nil {
+		return pass.MoveObject.AsCompilerPass()
+	}
+
 	if pass.DashboardPanels != nil {
 		return pass.DashboardPanels.AsCompilerPass(), nil
 	}
@@ -70,9 +88,6 @@ func (pass CompilerPass) AsCompilerPass() (compiler.Pass, error) {
 	if pass.Cloudwatch != nil {
 		return pass.Cloudwatch.AsCompilerPass(), nil
 	}
-	if pass.GoogleCloudMonitoring != nil {
-		return pass.GoogleCloudMonitoring.AsCompilerPass(), nil
-	}
 	if pass.LibraryPanels != nil {
 		return pass.LibraryPanels.AsCompilerPass(), nil
 	}
@@ -96,13 +111,15 @@ func (pass Unspec) AsCompilerPass() compiler.Pass {
 
 type FieldsSetDefault struct {
 	Defaults map[string]any // Expected format: [package].[object].[field] → value
+
+	Predicates `yaml:",inline"`
 }
 
 func (pass FieldsSetDefault) AsCompilerPass() (compiler.Pass, error) {
 	defaults := make(map[compiler.FieldReference]any, len(pass.Defaults))
 
 	for ref, value := range pass.Defaults {
-		fieldRef, err := compiler.FieldReferenceFromString(ref)
+		fieldRef, err := pass.fieldReference(ref)
 		if err != nil {
 			return nil, err
 		}
@@ -115,13 +132,15 @@ func (pass FieldsSetDefault) AsCompilerPass() (compiler.Pass, error) {
 
 type FieldsSetRequired struct {
 	Fields []string // Expected format: [package].[object].[field]
+
+	Predicates `yaml:",inline"`
 }
 
 func (pass FieldsSetRequired) AsCompilerPass() (compiler.Pass, error) {
 	fieldRefs := make([]compiler.FieldReference, 0, len(pass.Fields))
 
 	for _, ref := range pass.Fields {
-		fieldRef, err := compiler.FieldReferenceFromString(ref)
+		fieldRef, err := pass.fieldReference(ref)
 		if err != nil {
 			return nil, err
 		}
@@ -134,13 +153,15 @@ func (pass FieldsSetRequired) AsCompilerPass() (compiler.Pass, error) {
 
 type FieldsSetNotRequired struct {
 	Fields []string // Expected format: [package].[object].[field]
+
+	Predicates `yaml:",inline"`
 }
 
 func (pass FieldsSetNotRequired) AsCompilerPass() (compiler.Pass, error) {
 	fieldRefs := make([]compiler.FieldReference, 0, len(pass.Fields))
 
 	for _, ref := range pass.Fields {
-		fieldRef, err := compiler.FieldReferenceFromString(ref)
+		fieldRef, err := pass.fieldReference(ref)
 		if err != nil {
 			return nil, err
 		}
@@ -153,13 +174,15 @@ func (pass FieldsSetNotRequired) AsCompilerPass() (compiler.Pass, error) {
 
 type Omit struct {
 	Objects []string // Expected format: [package].[object]
+
+	Predicates `yaml:",inline"`
 }
 
 func (pass Omit) AsCompilerPass() (compiler.Pass, error) {
 	objectRefs := make([]compiler.ObjectReference, 0, len(pass.Objects))
 
 	for _, ref := range pass.Objects {
-		objectRef, err := compiler.ObjectReferenceFromString(ref)
+		objectRef, err := pass.objectReference(ref)
 		if err != nil {
 			return nil, err
 		}
@@ -173,10 +196,12 @@ func (pass Omit) AsCompilerPass() (compiler.Pass, error) {
 type AddFields struct {
 	To     string // Expected format: [package].[object]
 	Fields []ast.StructField
+
+	Predicates `yaml:",inline"`
 }
 
 func (pass AddFields) AsCompilerPass() (compiler.Pass, error) {
-	objectRef, err := compiler.ObjectReferenceFromString(pass.To)
+	objectRef, err := pass.objectReference(pass.To)
 	if err != nil {
 		return nil, err
 	}
@@ -190,10 +215,12 @@ func (pass AddFields) AsCompilerPass() (compiler.Pass, error) {
 type NameAnonymousStruct struct {
 	Field string // Expected format: [package].[object].[field]
 	As    string
+
+	Predicates `yaml:",inline"`
 }
 
 func (pass NameAnonymousStruct) AsCompilerPass() (compiler.Pass, error) {
-	fieldRef, err := compiler.FieldReferenceFromString(pass.Field)
+	fieldRef, err := pass.fieldReference(pass.Field)
 	if err != nil {
 		return nil, err
 	}
@@ -224,10 +251,12 @@ func (pass RenameObject) AsCompilerPass() (compiler.Pass, error) {
 type RetypeField struct {
 	Field string // Expected format: [package].[object].[field]
 	As    ast.Type
+
+	Predicates `yaml:",inline"`
 }
 
 func (pass RetypeField) AsCompilerPass() (compiler.Pass, error) {
-	fieldRef, err := compiler.FieldReferenceFromString(pass.Field)
+	fieldRef, err := pass.fieldReference(pass.Field)
 	if err != nil {
 		return nil, err
 	}
@@ -250,6 +279,108 @@ func (pass SchemaSetIdentifier) AsCompilerPass() (compiler.Pass, error) {
 	}, nil
 }
 
+type DisjunctionSetDiscriminator struct {
+	Target        string            `yaml:"target"` // Expected format: [package].[object] or [package].[object].[field]
+	Discriminator string            `yaml:"discriminator"`
+	Mapping       map[string]string `yaml:"mapping"`     // discriminator value → referred type
+	KeepBranch    *int              `yaml:"keep_branch"` // simplifies the disjunction to the branch at this index
+}
+
+func (pass DisjunctionSetDiscriminator) AsCompilerPass() (compiler.Pass, error) {
+	if pass.KeepBranch == nil && pass.Discriminator == "" {
+		return nil, fmt.Errorf("disjunction_set_discriminator: one of 'discriminator' or 'keep_branch' is required")
+	}
+	if pass.KeepBranch != nil && pass.Discriminator != "" {
+		return nil, fmt.Errorf("disjunction_set_discriminator: 'discriminator' and 'keep_branch' are mutually exclusive")
+	}
+
+	objectRef, field, err := pass.target()
+	if err != nil {
+		return nil, err
+	}
+
+	return &compiler.DisjunctionSetDiscriminator{
+		Object:               objectRef,
+		Field:                field,
+		Discriminator:        pass.Discriminator,
+		DiscriminatorMapping: pass.Mapping,
+		KeepBranch:           pass.KeepBranch,
+	}, nil
+}
+
+func (pass DisjunctionSetDiscriminator) target() (compiler.ObjectReference, string, error) {
+	if strings.Count(pass.Target, ".") == 1 {
+		objectRef, err := compiler.ObjectReferenceFromString(pass.Target)
+		return objectRef, "", err
+	}
+
+	fieldRef, err := compiler.FieldReferenceFromString(pass.Target)
+	if err != nil {
+		return compiler.ObjectReference{}, "", err
+	}
+
+	return compiler.ObjectReference{Package: fieldRef.Package, Object: fieldRef.Object}, fieldRef.Field, nil
+}
+
+type DisjunctionBranches struct {
+	Field   string            // Expected format: [package].[object].[field]
+	Remove  []string          // Type names of the branches to remove
+	Add     []ast.Type        // Branches to add
+	Mapping map[string]string // Discriminator values of the added branches. Expected format: [value]: [type name]
+
+	Predicates `yaml:",inline"`
+}
+
+func (pass DisjunctionBranches) AsCompilerPass() (compiler.Pass, error) {
+	fieldRef, err := pass.fieldReference(pass.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compiler.DisjunctionBranches{
+		Field:   fieldRef,
+		Remove:  pass.Remove,
+		Add:     pass.Add,
+		Mapping: pass.Mapping,
+	}, nil
+}
+
+type ReplaceObject struct {
+	Object string // Expected format: [package].[object]
+	With   ast.Type
+
+	Predicates `yaml:",inline"`
+}
+
+func (pass ReplaceObject) AsCompilerPass() (compiler.Pass, error) {
+	objectRef, err := pass.objectReference(pass.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compiler.ReplaceObject{
+		Object: objectRef,
+		With:   pass.With,
+	}, nil
+}
+
+type MoveObject struct {
+	Object string // Expected format: [package].[object]
+	To     string // Destination package
+}
+
+func (pass MoveObject) AsCompilerPass() (compiler.Pass, error) {
+	objectRef, err := compiler.ObjectReferenceFromString(pass.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	return &compiler.MoveObject{
+		Object: objectRef,
+		To:     pass.To,
+	}, nil
+}
+
 type DashboardPanels struct {
 }
 
@@ -264,13 +395,6 @@ func (pass Cloudwatch) AsCompilerPass() compiler.Pass {
 	return &compiler.Cloudwatch{}
 }
 
-type GoogleCloudMonitoring struct {
-}
-
-func (pass GoogleCloudMonitoring) AsCompilerPass() compiler.Pass {
-	return &compiler.GoogleCloudMonitoring{}
-}
-
 type LibraryPanels struct {
 }
 
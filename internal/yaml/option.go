@@ -16,6 +16,7 @@ type OptionRule struct {
 	PromoteToConstructor    *OptionSelector          `yaml:"promote_to_constructor"`
 	Rename                  *RenameOption            `yaml:"rename"`
 	UnfoldBoolean           *UnfoldBoolean           `yaml:"unfold_boolean"`
+	UnfoldEnum              *UnfoldEnum              `yaml:"unfold_enum"`
 	StructFieldsAsArguments *StructFieldsAsArguments `yaml:"struct_fields_as_arguments"`
 	StructFieldsAsOptions   *StructFieldsAsOptions   `yaml:"struct_fields_as_options"`
 	ArrayToAppend           *ArrayToAppend           `yaml:"array_to_append"`
@@ -49,6 +50,10 @@ func (rule OptionRule) AsRewriteRule(pkg string) (option.RewriteRule, error) {
 		return rule.UnfoldBoolean.AsRewriteRule(pkg)
 	}
 
+	if rule.UnfoldEnum != nil {
+		return rule.UnfoldEnum.AsRewriteRule(pkg)
+	}
+
 	if rule.StructFieldsAsArguments != nil {
 		return rule.StructFieldsAsArguments.AsRewriteRule(pkg)
 	}
@@ -102,6 +107,29 @@ func (rule UnfoldBoolean) AsRewriteRule(pkg string) (option.RewriteRule, error)
 	}), nil
 }
 
+type UnfoldEnum struct {
+	OptionSelector `yaml:",inline"`
+
+	// Template used to name the options created for each enum value.
+	// Available data: .Option, .Name and .Value
+	// Available functions: upperCamelCase, lowerCamelCase
+	As string `yaml:"as"`
+}
+
+func (rule UnfoldEnum) AsRewriteRule(pkg string) (option.RewriteRule, error) {
+	selector, err := rule.AsSelector(pkg)
+	if err != nil {
+		return option.RewriteRule{}, err
+	}
+
+	unfold, err := option.NewEnumUnfold(rule.As)
+	if err != nil {
+		return option.RewriteRule{}, err
+	}
+
+	return option.UnfoldEnum(selector, unfold), nil
+}
+
 type StructFieldsAsArguments struct {
 	OptionSelector `yaml:",inline"`
 	Fields         []string `yaml:"fields"`
@@ -170,9 +198,29 @@ type OptionSelector struct {
 	ByBuilder *string `yaml:"by_builder"`
 
 	ByNames *ByNamesSelector `yaml:"by_names"`
+
+	Predicates `yaml:",inline"`
 }
 
 func (selector OptionSelector) AsSelector(pkg string) (option.Selector, error) {
+	baseSelector, err := selector.baseSelector(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	predicates, err := selector.AsPredicates()
+	if err != nil {
+		return nil, err
+	}
+
+	if predicates.IsEmpty() {
+		return baseSelector, nil
+	}
+
+	return option.Where(baseSelector, predicates), nil
+}
+
+func (selector OptionSelector) baseSelector(pkg string) (option.Selector, error) {
 	if selector.ByName != nil {
 		objectName, optionName, found := strings.Cut(*selector.ByName, ".")
 		if !found {
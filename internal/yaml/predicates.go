@@ -0,0 +1,97 @@
+package yaml
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// Predicates are shared by veneers and compiler passes selectors, to narrow
+// down what they match.
+// They are meant to be inlined in selectors:
+//
+//	```
+//	- omit:
+//	    by_name: "Options.legend*"
+//	    variant: panelcfg
+//	    type: bool
+//	```
+type Predicates struct {
+	// Variant of the schema: panelcfg, dataquery.
+	Variant string `yaml:"variant"`
+	// Identifier of the schema. Globs are supported.
+	Identifier string `yaml:"identifier"`
+
+	// Kind of the type: struct, array, map, ...
+	Kind string `yaml:"kind"`
+	// Scalar kind of the type: string, bool, int64, ...
+	Type string `yaml:"type"`
+}
+
+func (predicates Predicates) AsPredicates() (ast.Predicates, error) {
+	variants := []ast.SchemaVariant{ast.SchemaVariantPanel, ast.SchemaVariantDataQuery}
+	if predicates.Variant != "" && !tools.ItemInList(ast.SchemaVariant(predicates.Variant), variants) {
+		return ast.Predicates{}, fmt.Errorf("unknown schema variant '%s'", predicates.Variant)
+	}
+
+	if _, err := path.Match(predicates.Identifier, ""); err != nil {
+		return ast.Predicates{}, fmt.Errorf("invalid identifier pattern '%s': %w", predicates.Identifier, err)
+	}
+
+	kinds := []ast.Kind{
+		ast.KindDisjunction, ast.KindRef, ast.KindStruct, ast.KindEnum, ast.KindMap,
+		ast.KindArray, ast.KindScalar, ast.KindIntersection, ast.KindComposableSlot,
+	}
+	if predicates.Kind != "" && !tools.ItemInList(ast.Kind(predicates.Kind), kinds) {
+		return ast.Predicates{}, fmt.Errorf("unknown kind '%s'", predicates.Kind)
+	}
+
+	scalarKinds := []ast.ScalarKind{
+		ast.KindNull, ast.KindAny, ast.KindBytes, ast.KindString,
+		ast.KindFloat32, ast.KindFloat64,
+		ast.KindUint8, ast.KindUint16, ast.KindUint32, ast.KindUint64,
+		ast.KindInt8, ast.KindInt16, ast.KindInt32, ast.KindInt64,
+		ast.KindBool,
+	}
+	if predicates.Type != "" && !tools.ItemInList(ast.ScalarKind(predicates.Type), scalarKinds) {
+		return ast.Predicates{}, fmt.Errorf("unknown scalar type '%s'", predicates.Type)
+	}
+
+	return ast.Predicates{
+		Variant:    ast.SchemaVariant(predicates.Variant),
+		Identifier: predicates.Identifier,
+		Kind:       ast.Kind(predicates.Kind),
+		ScalarKind: ast.ScalarKind(predicates.Type),
+	}, nil
+}
+
+func (predicates Predicates) objectReference(ref string) (compiler.ObjectReference, error) {
+	objectRef, err := compiler.ObjectReferenceFromString(ref)
+	if err != nil {
+		return compiler.ObjectReference{}, err
+	}
+
+	objectRef.Predicates, err = predicates.AsPredicates()
+	if err != nil {
+		return compiler.ObjectReference{}, err
+	}
+
+	return objectRef, nil
+}
+
+func (predicates Predicates) fieldReference(ref string) (compiler.FieldReference, error) {
+	fieldRef, err := compiler.FieldReferenceFromString(ref)
+	if err != nil {
+		return compiler.FieldReference{}, err
+	}
+
+	fieldRef.Predicates, err = predicates.AsPredicates()
+	if err != nil {
+		return compiler.FieldReference{}, err
+	}
+
+	return fieldRef, nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/grafana/cog/internal/veneers/builder"
 	"github.com/grafana/cog/internal/veneers/option"
@@ -25,6 +26,25 @@ func NewVeneersLoader() *VeneersLoader {
 	return &VeneersLoader{}
 }
 
+// VeneerFiles lists the given veneer files, followed by the veneer files
+// found in the given directories.
+func VeneerFiles(files []string, directories []string) ([]string, error) {
+	veneers := make([]string, 0, len(files))
+	veneers = append(veneers, files...)
+
+	for _, dir := range directories {
+		globPattern := filepath.Join(filepath.Clean(dir), "*.yaml")
+		matches, err := filepath.Glob(globPattern)
+		if err != nil {
+			return nil, err
+		}
+
+		veneers = append(veneers, matches...)
+	}
+
+	return veneers, nil
+}
+
 func (loader *VeneersLoader) RewriterFrom(filenames []string, config rewrite.Config) (*rewrite.Rewriter, error) {
 	readers := make([]io.Reader, 0, len(filenames))
 	for _, filename := range filenames {
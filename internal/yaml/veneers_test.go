@@ -1,6 +1,8 @@
 package yaml
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -52,6 +54,38 @@ options:
 				req.Len(rules.OptionRules, 1)
 			},
 		},
+		{
+			desc: "option rule with globs and predicates",
+			input: `language: all
+package: "*"
+builders: ~
+options: 
+  - omit: { by_name: "Options.legend*", variant: panelcfg, type: bool }`,
+			check: func(req *require.Assertions, rules rewrite.LanguageRules) {
+				req.Len(rules.OptionRules, 1)
+			},
+		},
+		{
+			desc: "add_option builder rule",
+			input: `language: all
+package: dashboard
+builders:
+  - add_option:
+      by_object: Dashboard
+      option:
+        name: tag
+        args:
+          - name: tag
+            type: { kind: scalar, scalar: { scalar_kind: string } }
+        assignments:
+          - path: tags
+            method: append
+            value: { argument: tag }
+options: ~`,
+			check: func(req *require.Assertions, rules rewrite.LanguageRules) {
+				req.Len(rules.BuilderRules, 1)
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -78,3 +112,48 @@ options: ~`
 	req.Error(err)
 	req.ErrorContains(err, "missing 'package'")
 }
+
+func TestLoader_Load_withInvalidPredicates(t *testing.T) {
+	req := require.New(t)
+	input := `language: all
+package: dashboard
+builders: ~
+options:
+  - omit: { by_name: Dashboard.editable, kind: boolean }`
+
+	_, err := NewVeneersLoader().Load(strings.NewReader(input))
+	req.Error(err)
+	req.ErrorContains(err, "unknown kind 'boolean'")
+}
+
+func TestLoader_Load_withAmbiguousAddOptionValue(t *testing.T) {
+	req := require.New(t)
+	input := `language: all
+package: dashboard
+builders:
+  - add_option:
+      by_object: Dashboard
+      option:
+        name: editable
+        assignments:
+          - path: editable
+            value: { argument: editable, constant: true }
+options: ~`
+
+	_, err := NewVeneersLoader().Load(strings.NewReader(input))
+	req.Error(err)
+	req.ErrorContains(err, "exactly one of 'argument', 'constant' or 'envelope' is expected")
+}
+
+func TestVeneerFiles(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	req.NoError(os.WriteFile(filepath.Join(dir, "dashboard.yaml"), nil, 0600))
+	req.NoError(os.WriteFile(filepath.Join(dir, "README.md"), nil, 0600))
+
+	files, err := VeneerFiles([]string{"custom.yaml"}, []string{dir})
+	req.NoError(err)
+
+	req.Equal([]string{"custom.yaml", filepath.Join(dir, "dashboard.yaml")}, files)
+}
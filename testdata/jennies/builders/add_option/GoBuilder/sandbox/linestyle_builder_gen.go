@@ -0,0 +1,65 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[LineStyle] = (*LineStyleBuilder)(nil)
+
+type LineStyleBuilder struct {
+    internal *LineStyle
+    errors map[string]cog.BuildErrors
+}
+
+func NewLineStyleBuilder() *LineStyleBuilder {
+	resource := &LineStyle{}
+	builder := &LineStyleBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewLineStyleBuilderFrom creates a builder wrapping an existing LineStyle.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewLineStyleBuilderFrom(resource LineStyle) *LineStyleBuilder {
+	builder := &LineStyleBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *LineStyleBuilder) Build() (LineStyle, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("LineStyle", err)...)
+	}
+
+	if len(errs) != 0 {
+		return LineStyle{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *LineStyleBuilder) Mode(mode string) *LineStyleBuilder {
+    builder.internal.Mode = mode
+
+    return builder
+}
+
+func (builder *LineStyleBuilder) Fill(fill string) *LineStyleBuilder {
+    builder.internal.Fill = &fill
+
+    return builder
+}
+
+func (builder *LineStyleBuilder) applyDefaults() {
+}
@@ -0,0 +1,105 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[Resource] = (*ResourceBuilder)(nil)
+
+type ResourceBuilder struct {
+    internal *Resource
+    errors map[string]cog.BuildErrors
+}
+
+func NewResourceBuilder() *ResourceBuilder {
+	resource := &Resource{}
+	builder := &ResourceBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewResourceBuilderFrom creates a builder wrapping an existing Resource.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewResourceBuilderFrom(resource Resource) *ResourceBuilder {
+	builder := &ResourceBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *ResourceBuilder) Build() (Resource, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("Resource", err)...)
+	}
+
+	if len(errs) != 0 {
+		return Resource{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *ResourceBuilder) Title(title string) *ResourceBuilder {
+    builder.internal.Title = title
+
+    return builder
+}
+
+func (builder *ResourceBuilder) Tags(tags []cog.Builder[Tag]) *ResourceBuilder {
+        tagsResources := make([]Tag, 0, len(tags))
+        for _, r1 := range tags {
+                tagsDepth1, err := r1.Build()
+                if err != nil {
+                    builder.errors["tags"] = err.(cog.BuildErrors)
+                    return builder
+                }
+                tagsResources = append(tagsResources, tagsDepth1)
+        }
+    builder.internal.Tags = tagsResources
+
+    return builder
+}
+
+func (builder *ResourceBuilder) LineStyle(lineStyle cog.Builder[LineStyle]) *ResourceBuilder {
+    lineStyleResource, err := lineStyle.Build()
+    if err != nil {
+        builder.errors["lineStyle"] = err.(cog.BuildErrors)
+        return builder
+    }
+    builder.internal.LineStyle = &lineStyleResource
+
+    return builder
+}
+
+// Adds a tag, using the default color.
+func (builder *ResourceBuilder) Tag(name string) *ResourceBuilder {
+    builder.internal.Tags = append(builder.internal.Tags, Tag{
+        Name: name,
+        Color: cog.ToPtr[string]("blue"),
+    })
+
+    return builder
+}
+
+// Sets a dashed line style.
+func (builder *ResourceBuilder) DashedLineStyle(fill string) *ResourceBuilder {
+    builder.internal.LineStyle = &LineStyle{
+        Mode: "dashed",
+        Fill: &fill,
+    }
+
+    return builder
+}
+
+func (builder *ResourceBuilder) applyDefaults() {
+}
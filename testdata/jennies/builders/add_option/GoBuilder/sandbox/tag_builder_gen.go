@@ -0,0 +1,65 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[Tag] = (*TagBuilder)(nil)
+
+type TagBuilder struct {
+    internal *Tag
+    errors map[string]cog.BuildErrors
+}
+
+func NewTagBuilder() *TagBuilder {
+	resource := &Tag{}
+	builder := &TagBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewTagBuilderFrom creates a builder wrapping an existing Tag.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewTagBuilderFrom(resource Tag) *TagBuilder {
+	builder := &TagBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *TagBuilder) Build() (Tag, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("Tag", err)...)
+	}
+
+	if len(errs) != 0 {
+		return Tag{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *TagBuilder) Name(name string) *TagBuilder {
+    builder.internal.Name = name
+
+    return builder
+}
+
+func (builder *TagBuilder) Color(color string) *TagBuilder {
+    builder.internal.Color = &color
+
+    return builder
+}
+
+func (builder *TagBuilder) applyDefaults() {
+}
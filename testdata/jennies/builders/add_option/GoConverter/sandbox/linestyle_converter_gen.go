@@ -0,0 +1,35 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// LineStyleConverter accepts a `LineStyle` object and generates the Go code to build this object using builders.
+func LineStyleConverter(input LineStyle) string {
+	calls := []string{
+		`sandbox.NewLineStyleBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Mode) {
+		arg0 := input.Mode
+		buffer.WriteString(`Mode(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.Fill != nil {
+		arg0 := *input.Fill
+		buffer.WriteString(`Fill(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -0,0 +1,45 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// ResourceConverter accepts a `Resource` object and generates the Go code to build this object using builders.
+func ResourceConverter(input Resource) string {
+	calls := []string{
+		`sandbox.NewResourceBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Title) {
+		arg0 := input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if len(input.Tags) != 0 {
+		arg0 := input.Tags
+		buffer.WriteString(`Tags(`)
+		buffer.WriteString(cog.ConvertArray(arg0, "cog.Builder[sandbox.Tag]", TagConverter))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.LineStyle != nil {
+		arg0 := *input.LineStyle
+		buffer.WriteString(`LineStyle(`)
+		buffer.WriteString(LineStyleConverter(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
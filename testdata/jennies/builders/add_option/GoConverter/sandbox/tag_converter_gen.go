@@ -0,0 +1,35 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// TagConverter accepts a `Tag` object and generates the Go code to build this object using builders.
+func TagConverter(input Tag) string {
+	calls := []string{
+		`sandbox.NewTagBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Name) {
+		arg0 := input.Name
+		buffer.WriteString(`Name(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.Color != nil {
+		arg0 := *input.Color
+		buffer.WriteString(`Color(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -0,0 +1,28 @@
+package sandbox;
+
+
+public class LineStyleBuilder implements cog.Builder<LineStyle> {
+    protected final LineStyle internal;
+
+    public LineStyleBuilder() {
+        this.internal = new LineStyle();
+        this.applyDefaults();
+    }
+
+    public LineStyleBuilder mode(String mode) {
+        this.internal.mode = mode;
+        return this;
+    }
+
+    public LineStyleBuilder fill(String fill) {
+        this.internal.fill = fill;
+        return this;
+    }
+
+    public LineStyle build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
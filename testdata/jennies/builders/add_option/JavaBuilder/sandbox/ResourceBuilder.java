@@ -0,0 +1,61 @@
+package sandbox;
+
+import java.util.LinkedList;
+import java.util.List;
+
+public class ResourceBuilder implements cog.Builder<Resource> {
+    protected final Resource internal;
+
+    public ResourceBuilder() {
+        this.internal = new Resource();
+        this.applyDefaults();
+    }
+
+    public ResourceBuilder title(String title) {
+        this.internal.title = title;
+        return this;
+    }
+
+    public ResourceBuilder tags(List<cog.Builder<Tag>> tags) {
+        List<Tag> tagsResources = new LinkedList<>();
+        for (cog.Builder<Tag> r1 : tags) {
+            tagsResources.add(r1.build());
+        }
+        this.internal.tags = tagsResources;
+        return this;
+    }
+
+    public ResourceBuilder lineStyle(cog.Builder<LineStyle> lineStyle) {
+        LineStyle lineStyleResource = lineStyle.build();
+        this.internal.lineStyle = lineStyleResource;
+        return this;
+    }
+
+    // Adds a tag, using the default color.
+    public ResourceBuilder tag(String name) {
+        if (this.internal.tags == null) {
+            this.internal.tags = new LinkedList<>();
+        }
+        Tag tagEnvelope = new Tag();
+        tagEnvelope.name = name;
+        tagEnvelope.color = "blue";
+        this.internal.tags.add(tagEnvelope);
+        return this;
+    }
+
+    // Sets a dashed line style.
+    public ResourceBuilder dashedLineStyle(String fill) {
+        LineStyle lineStyleEnvelope = new LineStyle();
+        lineStyleEnvelope.mode = "dashed";
+        lineStyleEnvelope.fill = fill;
+        this.internal.lineStyle = lineStyleEnvelope;
+        return this;
+    }
+
+    public Resource build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
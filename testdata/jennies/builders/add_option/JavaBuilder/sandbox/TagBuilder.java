@@ -0,0 +1,28 @@
+package sandbox;
+
+
+public class TagBuilder implements cog.Builder<Tag> {
+    protected final Tag internal;
+
+    public TagBuilder() {
+        this.internal = new Tag();
+        this.applyDefaults();
+    }
+
+    public TagBuilder name(String name) {
+        this.internal.name = name;
+        return this;
+    }
+
+    public TagBuilder color(String color) {
+        this.internal.color = color;
+        return this;
+    }
+
+    public Tag build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -0,0 +1,137 @@
+import typing
+from ..cog import builder as cogbuilder
+from ..models import sandbox
+
+
+class Tag(cogbuilder.Builder[sandbox.Tag]):    
+    _internal: sandbox.Tag
+
+    def __init__(self):
+        self._internal = sandbox.Tag()
+
+    @classmethod
+    def from_object(cls, resource: sandbox.Tag) -> typing.Self:
+        """
+        Creates a builder wrapping an existing sandbox.Tag.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> sandbox.Tag:
+        return self._internal    
+    
+    def name(self, name: str) -> typing.Self:        
+        self._internal.name = name
+    
+        return self
+    
+    def color(self, color: str) -> typing.Self:        
+        self._internal.color = color
+    
+        return self
+    
+
+class LineStyle(cogbuilder.Builder[sandbox.LineStyle]):    
+    _internal: sandbox.LineStyle
+
+    def __init__(self):
+        self._internal = sandbox.LineStyle()
+
+    @classmethod
+    def from_object(cls, resource: sandbox.LineStyle) -> typing.Self:
+        """
+        Creates a builder wrapping an existing sandbox.LineStyle.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> sandbox.LineStyle:
+        return self._internal    
+    
+    def mode(self, mode: str) -> typing.Self:        
+        self._internal.mode = mode
+    
+        return self
+    
+    def fill(self, fill: str) -> typing.Self:        
+        self._internal.fill = fill
+    
+        return self
+    
+
+class Resource(cogbuilder.Builder[sandbox.Resource]):    
+    _internal: sandbox.Resource
+
+    def __init__(self):
+        self._internal = sandbox.Resource()
+
+    @classmethod
+    def from_object(cls, resource: sandbox.Resource) -> typing.Self:
+        """
+        Creates a builder wrapping an existing sandbox.Resource.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> sandbox.Resource:
+        return self._internal    
+    
+    def title(self, title: str) -> typing.Self:        
+        self._internal.title = title
+    
+        return self
+    
+    def tags(self, tags: list[cogbuilder.Builder[sandbox.Tag]]) -> typing.Self:        
+        tags_resources = [r1.build() for r1 in tags]
+        self._internal.tags = tags_resources
+    
+        return self
+    
+    def line_style(self, line_style: cogbuilder.Builder[sandbox.LineStyle]) -> typing.Self:        
+        line_style_resource = line_style.build()
+        self._internal.line_style = line_style_resource
+    
+        return self
+    
+    def tag(self, name: str) -> typing.Self:    
+        """
+        Adds a tag, using the default color.
+        """
+            
+        if self._internal.tags is None:
+            self._internal.tags = []
+        
+        self._internal.tags.append(sandbox.Tag(
+            name=name,
+            color="blue",
+        ))
+    
+        return self
+    
+    def dashed_line_style(self, fill: str) -> typing.Self:    
+        """
+        Sets a dashed line style.
+        """
+            
+        self._internal.line_style = sandbox.LineStyle(
+            mode="dashed",
+            fill=fill,
+        )
+    
+        return self
+    
\ No newline at end of file
@@ -0,0 +1,64 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class ResourceBuilder implements cog.Builder<sandbox.Resource> {
+    protected readonly internal: sandbox.Resource;
+
+    constructor() {
+        this.internal = sandbox.defaultResource();
+    }
+
+    // Creates a builder wrapping an existing Resource.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: sandbox.Resource): ResourceBuilder {
+        const builder: ResourceBuilder = Object.create(ResourceBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.Resource {
+        return this.internal;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    tags(tags: cog.Builder<sandbox.Tag>[]): this {
+        const tagsResources = tags.map(builder1 => builder1.build());
+        this.internal.tags = tagsResources;
+        return this;
+    }
+
+    lineStyle(lineStyle: cog.Builder<sandbox.LineStyle>): this {
+        const lineStyleResource = lineStyle.build();
+        this.internal.lineStyle = lineStyleResource;
+        return this;
+    }
+
+    // Adds a tag, using the default color.
+    tag(name: string): this {
+        if (!this.internal.tags) {
+            this.internal.tags = [];
+        }
+        this.internal.tags.push({
+        name: name,
+        color: "blue",
+    });
+        return this;
+    }
+
+    // Sets a dashed line style.
+    dashedLineStyle(fill: string): this {
+        this.internal.lineStyle = {
+        mode: "dashed",
+        fill: fill,
+    };
+        return this;
+    }
+}
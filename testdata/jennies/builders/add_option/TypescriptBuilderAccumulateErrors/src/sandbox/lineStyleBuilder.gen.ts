@@ -0,0 +1,47 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class LineStyleBuilder implements cog.Builder<sandbox.LineStyle> {
+    protected readonly internal: sandbox.LineStyle;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = sandbox.defaultLineStyle();
+    }
+
+    // Creates a builder wrapping an existing LineStyle.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: sandbox.LineStyle): LineStyleBuilder {
+        const builder: LineStyleBuilder = Object.create(LineStyleBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.LineStyle {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("LineStyle", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    mode(mode: string): this {
+        this.internal.mode = mode;
+        return this;
+    }
+
+    fill(fill: string): this {
+        this.internal.fill = fill;
+        return this;
+    }
+}
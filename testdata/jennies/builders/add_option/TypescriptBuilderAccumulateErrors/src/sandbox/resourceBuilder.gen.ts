@@ -0,0 +1,87 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class ResourceBuilder implements cog.Builder<sandbox.Resource> {
+    protected readonly internal: sandbox.Resource;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = sandbox.defaultResource();
+    }
+
+    // Creates a builder wrapping an existing Resource.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: sandbox.Resource): ResourceBuilder {
+        const builder: ResourceBuilder = Object.create(ResourceBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.Resource {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("Resource", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    tags(tags: cog.Builder<sandbox.Tag>[]): this {
+        let tagsResources;
+        try {
+            tagsResources = tags.map(builder1 => builder1.build());
+        } catch (err) {
+            this.errors["tags"] = cog.makeBuildErrors("tags", err);
+            return this;
+        }
+        this.internal.tags = tagsResources;
+        return this;
+    }
+
+    lineStyle(lineStyle: cog.Builder<sandbox.LineStyle>): this {
+        let lineStyleResource;
+        try {
+            lineStyleResource = lineStyle.build();
+        } catch (err) {
+            this.errors["lineStyle"] = cog.makeBuildErrors("lineStyle", err);
+            return this;
+        }
+        this.internal.lineStyle = lineStyleResource;
+        return this;
+    }
+
+    // Adds a tag, using the default color.
+    tag(name: string): this {
+        if (!this.internal.tags) {
+            this.internal.tags = [];
+        }
+        this.internal.tags.push({
+        name: name,
+        color: "blue",
+    });
+        return this;
+    }
+
+    // Sets a dashed line style.
+    dashedLineStyle(fill: string): this {
+        this.internal.lineStyle = {
+        mode: "dashed",
+        fill: fill,
+    };
+        return this;
+    }
+}
@@ -0,0 +1,47 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class TagBuilder implements cog.Builder<sandbox.Tag> {
+    protected readonly internal: sandbox.Tag;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = sandbox.defaultTag();
+    }
+
+    // Creates a builder wrapping an existing Tag.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: sandbox.Tag): TagBuilder {
+        const builder: TagBuilder = Object.create(TagBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.Tag {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("Tag", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    name(name: string): this {
+        this.internal.name = name;
+        return this;
+    }
+
+    color(color: string): this {
+        this.internal.color = color;
+        return this;
+    }
+}
@@ -0,0 +1,17 @@
+package sandbox
+
+#Tag: {
+	name:   string
+	color?: string
+}
+
+#LineStyle: {
+	mode:  string
+	fill?: string
+}
+
+Resource: {
+	title: string
+	tags?: [...#Tag]
+	lineStyle?: #LineStyle
+}
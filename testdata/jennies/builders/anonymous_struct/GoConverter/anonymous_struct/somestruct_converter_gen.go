@@ -0,0 +1,25 @@
+package anonymous_struct
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructConverter(input SomeStruct) string {
+	calls := []string{
+		`anonymous_struct.NewSomeStructBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if input.Time != nil {
+		arg0 := *input.Time
+		buffer.WriteString(`Time(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
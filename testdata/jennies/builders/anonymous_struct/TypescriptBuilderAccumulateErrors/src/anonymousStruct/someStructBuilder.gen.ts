@@ -0,0 +1,45 @@
+import * as cog from '../cog';
+import * as anonymousStruct from '../anonymousStruct';
+
+export class SomeStructBuilder implements cog.Builder<anonymousStruct.SomeStruct> {
+    protected readonly internal: anonymousStruct.SomeStruct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = anonymousStruct.defaultSomeStruct();
+    }
+
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: anonymousStruct.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): anonymousStruct.SomeStruct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomeStruct", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    time(time: {
+	from: string;
+	to: string;
+}): this {
+        this.internal.time = time;
+        return this;
+    }
+}
@@ -0,0 +1,24 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructConverter(input SomeStruct) string {
+	calls := []string{
+		`sandbox.NewSomeStructBuilder()`,
+	}
+	var buffer strings.Builder
+
+	for _, item := range input.Tags {
+		buffer.WriteString(`Tags(`)
+		buffer.WriteString(cog.Dump(item))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
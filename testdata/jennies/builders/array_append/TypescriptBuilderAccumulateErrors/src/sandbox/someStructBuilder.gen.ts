@@ -0,0 +1,45 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class SomeStructBuilder implements cog.Builder<sandbox.SomeStruct> {
+    protected readonly internal: sandbox.SomeStruct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = sandbox.defaultSomeStruct();
+    }
+
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: sandbox.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.SomeStruct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomeStruct", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    tags(tags: string): this {
+        if (!this.internal.tags) {
+            this.internal.tags = [];
+        }
+        this.internal.tags.push(tags);
+        return this;
+    }
+}
@@ -0,0 +1,55 @@
+package basic_struct
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructConverter(input SomeStruct) string {
+	calls := []string{
+		`basic_struct.NewSomeStructBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Id) {
+		arg0 := input.Id
+		buffer.WriteString(`Id(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.Uid) {
+		arg0 := input.Uid
+		buffer.WriteString(`Uid(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if len(input.Tags) != 0 {
+		arg0 := input.Tags
+		buffer.WriteString(`Tags(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.LiveNow) {
+		arg0 := input.LiveNow
+		buffer.WriteString(`LiveNow(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
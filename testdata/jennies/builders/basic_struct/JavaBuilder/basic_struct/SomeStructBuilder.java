@@ -0,0 +1,43 @@
+package basic_struct;
+
+import java.util.List;
+
+// SomeStruct, to hold data.
+public class SomeStructBuilder implements cog.Builder<SomeStruct> {
+    protected final SomeStruct internal;
+
+    public SomeStructBuilder() {
+        this.internal = new SomeStruct();
+        this.applyDefaults();
+    }
+
+    // id identifies something. Weird, right?
+    public SomeStructBuilder id(Long id) {
+        this.internal.id = id;
+        return this;
+    }
+
+    public SomeStructBuilder uid(String uid) {
+        this.internal.uid = uid;
+        return this;
+    }
+
+    public SomeStructBuilder tags(List<String> tags) {
+        this.internal.tags = tags;
+        return this;
+    }
+
+    // This thing could be live.
+    // Or maybe not.
+    public SomeStructBuilder liveNow(Boolean liveNow) {
+        this.internal.liveNow = liveNow;
+        return this;
+    }
+
+    public SomeStruct build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -13,6 +13,19 @@ class SomeStruct(cogbuilder.Builder[basic_struct.SomeStruct]):
     def __init__(self):
         self._internal = basic_struct.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: basic_struct.SomeStruct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing basic_struct.SomeStruct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> basic_struct.SomeStruct:
         return self._internal    
     
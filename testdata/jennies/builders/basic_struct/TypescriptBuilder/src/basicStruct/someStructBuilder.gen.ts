@@ -9,6 +9,18 @@ export class SomeStructBuilder implements cog.Builder<basicStruct.SomeStruct> {
         this.internal = basicStruct.defaultSomeStruct();
     }
 
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: basicStruct.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): basicStruct.SomeStruct {
         return this.internal;
     }
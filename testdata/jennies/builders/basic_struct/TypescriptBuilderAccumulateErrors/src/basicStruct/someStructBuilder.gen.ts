@@ -0,0 +1,61 @@
+import * as cog from '../cog';
+import * as basicStruct from '../basicStruct';
+
+// SomeStruct, to hold data.
+export class SomeStructBuilder implements cog.Builder<basicStruct.SomeStruct> {
+    protected readonly internal: basicStruct.SomeStruct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = basicStruct.defaultSomeStruct();
+    }
+
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: basicStruct.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): basicStruct.SomeStruct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomeStruct", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    // id identifies something. Weird, right?
+    id(id: number): this {
+        this.internal.id = id;
+        return this;
+    }
+
+    uid(uid: string): this {
+        this.internal.uid = uid;
+        return this;
+    }
+
+    tags(tags: string[]): this {
+        this.internal.tags = tags;
+        return this;
+    }
+
+    // This thing could be live.
+    // Or maybe not.
+    liveNow(liveNow: boolean): this {
+        this.internal.liveNow = liveNow;
+        return this;
+    }
+}
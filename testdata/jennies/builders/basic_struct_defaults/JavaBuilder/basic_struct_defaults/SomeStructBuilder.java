@@ -0,0 +1,43 @@
+package basic_struct_defaults;
+
+import java.util.List;
+
+public class SomeStructBuilder implements cog.Builder<SomeStruct> {
+    protected final SomeStruct internal;
+
+    public SomeStructBuilder() {
+        this.internal = new SomeStruct();
+        this.applyDefaults();
+    }
+
+    public SomeStructBuilder id(Long id) {
+        this.internal.id = id;
+        return this;
+    }
+
+    public SomeStructBuilder uid(String uid) {
+        this.internal.uid = uid;
+        return this;
+    }
+
+    public SomeStructBuilder tags(List<String> tags) {
+        this.internal.tags = tags;
+        return this;
+    }
+
+    public SomeStructBuilder liveNow(Boolean liveNow) {
+        this.internal.liveNow = liveNow;
+        return this;
+    }
+
+    public SomeStruct build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+        this.id(42L);
+        this.uid("default-uid");
+        this.tags(List.of("generated", "cog"));
+        this.liveNow(true);
+    }
+}
@@ -9,6 +9,19 @@ class SomeStruct(cogbuilder.Builder[basic_struct_defaults.SomeStruct]):
     def __init__(self):
         self._internal = basic_struct_defaults.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: basic_struct_defaults.SomeStruct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing basic_struct_defaults.SomeStruct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> basic_struct_defaults.SomeStruct:
         return self._internal    
     
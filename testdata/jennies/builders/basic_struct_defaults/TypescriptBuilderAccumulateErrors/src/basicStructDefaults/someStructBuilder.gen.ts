@@ -0,0 +1,57 @@
+import * as cog from '../cog';
+import * as basicStructDefaults from '../basicStructDefaults';
+
+export class SomeStructBuilder implements cog.Builder<basicStructDefaults.SomeStruct> {
+    protected readonly internal: basicStructDefaults.SomeStruct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = basicStructDefaults.defaultSomeStruct();
+    }
+
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: basicStructDefaults.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): basicStructDefaults.SomeStruct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomeStruct", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    id(id: number): this {
+        this.internal.id = id;
+        return this;
+    }
+
+    uid(uid: string): this {
+        this.internal.uid = uid;
+        return this;
+    }
+
+    tags(tags: string[]): this {
+        this.internal.tags = tags;
+        return this;
+    }
+
+    liveNow(liveNow: boolean): this {
+        this.internal.liveNow = liveNow;
+        return this;
+    }
+}
@@ -23,6 +23,18 @@ func NewDashboardLinkBuilder() *DashboardLinkBuilder {
 	return builder
 }
 
+// NewDashboardLinkBuilderFrom creates a builder wrapping an existing DashboardLink.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewDashboardLinkBuilderFrom(resource DashboardLink) *DashboardLinkBuilder {
+	builder := &DashboardLinkBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *DashboardLinkBuilder) Build() (DashboardLink, error) {
 	var errs cog.BuildErrors
 
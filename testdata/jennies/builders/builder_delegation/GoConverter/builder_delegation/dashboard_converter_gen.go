@@ -0,0 +1,65 @@
+package builder_delegation
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// DashboardConverter accepts a `Dashboard` object and generates the Go code to build this object using builders.
+func DashboardConverter(input Dashboard) string {
+	calls := []string{
+		`builder_delegation.NewDashboardBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Id) {
+		arg0 := input.Id
+		buffer.WriteString(`Id(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.Title) {
+		arg0 := input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if len(input.Links) != 0 {
+		arg0 := input.Links
+		buffer.WriteString(`Links(`)
+		buffer.WriteString(cog.ConvertArray(arg0, "cog.Builder[builder_delegation.DashboardLink]", DashboardLinkConverter))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if len(input.LinksOfLinks) != 0 {
+		arg0 := input.LinksOfLinks
+		buffer.WriteString(`LinksOfLinks(`)
+		buffer.WriteString(cog.ConvertArray(arg0, "[]cog.Builder[builder_delegation.DashboardLink]", func(items []DashboardLink) string { return cog.ConvertArray(items, "cog.Builder[builder_delegation.DashboardLink]", DashboardLinkConverter) }))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.SingleLink) {
+		arg0 := input.SingleLink
+		buffer.WriteString(`SingleLink(`)
+		buffer.WriteString(DashboardLinkConverter(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
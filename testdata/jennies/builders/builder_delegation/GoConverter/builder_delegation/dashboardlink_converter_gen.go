@@ -0,0 +1,35 @@
+package builder_delegation
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// DashboardLinkConverter accepts a `DashboardLink` object and generates the Go code to build this object using builders.
+func DashboardLinkConverter(input DashboardLink) string {
+	calls := []string{
+		`builder_delegation.NewDashboardLinkBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Title) {
+		arg0 := input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.Url) {
+		arg0 := input.Url
+		buffer.WriteString(`Url(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -0,0 +1,61 @@
+package builder_delegation;
+
+import java.util.List;
+import java.util.LinkedList;
+
+public class DashboardBuilder implements cog.Builder<Dashboard> {
+    protected final Dashboard internal;
+
+    public DashboardBuilder() {
+        this.internal = new Dashboard();
+        this.applyDefaults();
+    }
+
+    public DashboardBuilder id(Long id) {
+        this.internal.id = id;
+        return this;
+    }
+
+    public DashboardBuilder title(String title) {
+        this.internal.title = title;
+        return this;
+    }
+
+    // will be expanded to []cog.Builder<DashboardLink>
+    public DashboardBuilder links(List<cog.Builder<DashboardLink>> links) {
+        List<DashboardLink> linksResources = new LinkedList<>();
+        for (cog.Builder<DashboardLink> r1 : links) {
+            linksResources.add(r1.build());
+        }
+        this.internal.links = linksResources;
+        return this;
+    }
+
+    // will be expanded to [][]cog.Builder<DashboardLink>
+    public DashboardBuilder linksOfLinks(List<List<cog.Builder<DashboardLink>>> linksOfLinks) {
+        List<List<DashboardLink>> linksOfLinksResources = new LinkedList<>();
+        for (List<cog.Builder<DashboardLink>> r1 : linksOfLinks) {
+            List<DashboardLink> linksOfLinksDepth1 = new LinkedList<>();
+            for (cog.Builder<DashboardLink> r2 : r1) {
+                linksOfLinksDepth1.add(r2.build());
+            }
+            linksOfLinksResources.add(linksOfLinksDepth1);
+        }
+        this.internal.linksOfLinks = linksOfLinksResources;
+        return this;
+    }
+
+    // will be expanded to cog.Builder<DashboardLink>
+    public DashboardBuilder singleLink(cog.Builder<DashboardLink> singleLink) {
+        DashboardLink singleLinkResource = singleLink.build();
+        this.internal.singleLink = singleLinkResource;
+        return this;
+    }
+
+    public Dashboard build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
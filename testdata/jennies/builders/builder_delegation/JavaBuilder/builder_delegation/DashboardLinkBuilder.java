@@ -0,0 +1,28 @@
+package builder_delegation;
+
+
+public class DashboardLinkBuilder implements cog.Builder<DashboardLink> {
+    protected final DashboardLink internal;
+
+    public DashboardLinkBuilder() {
+        this.internal = new DashboardLink();
+        this.applyDefaults();
+    }
+
+    public DashboardLinkBuilder title(String title) {
+        this.internal.title = title;
+        return this;
+    }
+
+    public DashboardLinkBuilder url(String url) {
+        this.internal.url = url;
+        return this;
+    }
+
+    public DashboardLink build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
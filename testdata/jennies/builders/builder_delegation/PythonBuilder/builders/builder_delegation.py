@@ -9,6 +9,19 @@ class DashboardLink(cogbuilder.Builder[builder_delegation.DashboardLink]):
     def __init__(self):
         self._internal = builder_delegation.DashboardLink()
 
+    @classmethod
+    def from_object(cls, resource: builder_delegation.DashboardLink) -> typing.Self:
+        """
+        Creates a builder wrapping an existing builder_delegation.DashboardLink.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> builder_delegation.DashboardLink:
         return self._internal    
     
@@ -29,6 +42,19 @@ class Dashboard(cogbuilder.Builder[builder_delegation.Dashboard]):
     def __init__(self):
         self._internal = builder_delegation.Dashboard()
 
+    @classmethod
+    def from_object(cls, resource: builder_delegation.Dashboard) -> typing.Self:
+        """
+        Creates a builder wrapping an existing builder_delegation.Dashboard.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> builder_delegation.Dashboard:
         return self._internal    
     
@@ -8,6 +8,18 @@ export class DashboardBuilder implements cog.Builder<builderDelegation.Dashboard
         this.internal = builderDelegation.defaultDashboard();
     }
 
+    // Creates a builder wrapping an existing Dashboard.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: builderDelegation.Dashboard): DashboardBuilder {
+        const builder: DashboardBuilder = Object.create(DashboardBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): builderDelegation.Dashboard {
         return this.internal;
     }
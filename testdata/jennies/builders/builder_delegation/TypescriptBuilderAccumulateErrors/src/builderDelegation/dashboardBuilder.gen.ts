@@ -0,0 +1,86 @@
+import * as cog from '../cog';
+import * as builderDelegation from '../builderDelegation';
+
+export class DashboardBuilder implements cog.Builder<builderDelegation.Dashboard> {
+    protected readonly internal: builderDelegation.Dashboard;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = builderDelegation.defaultDashboard();
+    }
+
+    // Creates a builder wrapping an existing Dashboard.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: builderDelegation.Dashboard): DashboardBuilder {
+        const builder: DashboardBuilder = Object.create(DashboardBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): builderDelegation.Dashboard {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("Dashboard", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    id(id: number): this {
+        this.internal.id = id;
+        return this;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    // will be expanded to []cog.Builder<DashboardLink>
+    links(links: cog.Builder<builderDelegation.DashboardLink>[]): this {
+        let linksResources;
+        try {
+            linksResources = links.map(builder1 => builder1.build());
+        } catch (err) {
+            this.errors["links"] = cog.makeBuildErrors("links", err);
+            return this;
+        }
+        this.internal.links = linksResources;
+        return this;
+    }
+
+    // will be expanded to [][]cog.Builder<DashboardLink>
+    linksOfLinks(linksOfLinks: cog.Builder<builderDelegation.DashboardLink>[][]): this {
+        let linksOfLinksResources;
+        try {
+            linksOfLinksResources = linksOfLinks.map(builder1 => builder1.map(builder2 => builder2.build()));
+        } catch (err) {
+            this.errors["linksOfLinks"] = cog.makeBuildErrors("linksOfLinks", err);
+            return this;
+        }
+        this.internal.linksOfLinks = linksOfLinksResources;
+        return this;
+    }
+
+    // will be expanded to cog.Builder<DashboardLink>
+    singleLink(singleLink: cog.Builder<builderDelegation.DashboardLink>): this {
+        let singleLinkResource;
+        try {
+            singleLinkResource = singleLink.build();
+        } catch (err) {
+            this.errors["singleLink"] = cog.makeBuildErrors("singleLink", err);
+            return this;
+        }
+        this.internal.singleLink = singleLinkResource;
+        return this;
+    }
+}
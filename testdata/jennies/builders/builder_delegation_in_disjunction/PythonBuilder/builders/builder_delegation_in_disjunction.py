@@ -9,6 +9,19 @@ class DashboardLink(cogbuilder.Builder[builder_delegation_in_disjunction.Dashboa
     def __init__(self):
         self._internal = builder_delegation_in_disjunction.DashboardLink()
 
+    @classmethod
+    def from_object(cls, resource: builder_delegation_in_disjunction.DashboardLink) -> typing.Self:
+        """
+        Creates a builder wrapping an existing builder_delegation_in_disjunction.DashboardLink.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> builder_delegation_in_disjunction.DashboardLink:
         return self._internal    
     
@@ -29,6 +42,19 @@ class ExternalLink(cogbuilder.Builder[builder_delegation_in_disjunction.External
     def __init__(self):
         self._internal = builder_delegation_in_disjunction.ExternalLink()
 
+    @classmethod
+    def from_object(cls, resource: builder_delegation_in_disjunction.ExternalLink) -> typing.Self:
+        """
+        Creates a builder wrapping an existing builder_delegation_in_disjunction.ExternalLink.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> builder_delegation_in_disjunction.ExternalLink:
         return self._internal    
     
@@ -44,6 +70,19 @@ class Dashboard(cogbuilder.Builder[builder_delegation_in_disjunction.Dashboard])
     def __init__(self):
         self._internal = builder_delegation_in_disjunction.Dashboard()
 
+    @classmethod
+    def from_object(cls, resource: builder_delegation_in_disjunction.Dashboard) -> typing.Self:
+        """
+        Creates a builder wrapping an existing builder_delegation_in_disjunction.Dashboard.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> builder_delegation_in_disjunction.Dashboard:
         return self._internal    
     
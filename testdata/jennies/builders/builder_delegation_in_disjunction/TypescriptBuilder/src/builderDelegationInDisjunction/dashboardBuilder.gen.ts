@@ -8,6 +8,18 @@ export class DashboardBuilder implements cog.Builder<builderDelegationInDisjunct
         this.internal = builderDelegationInDisjunction.defaultDashboard();
     }
 
+    // Creates a builder wrapping an existing Dashboard.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: builderDelegationInDisjunction.Dashboard): DashboardBuilder {
+        const builder: DashboardBuilder = Object.create(DashboardBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): builderDelegationInDisjunction.Dashboard {
         return this.internal;
     }
@@ -8,6 +8,18 @@ export class DashboardLinkBuilder implements cog.Builder<builderDelegationInDisj
         this.internal = builderDelegationInDisjunction.defaultDashboardLink();
     }
 
+    // Creates a builder wrapping an existing DashboardLink.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: builderDelegationInDisjunction.DashboardLink): DashboardLinkBuilder {
+        const builder: DashboardLinkBuilder = Object.create(DashboardLinkBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): builderDelegationInDisjunction.DashboardLink {
         return this.internal;
     }
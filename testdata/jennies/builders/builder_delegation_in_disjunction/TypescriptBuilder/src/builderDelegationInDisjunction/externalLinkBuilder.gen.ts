@@ -8,6 +8,18 @@ export class ExternalLinkBuilder implements cog.Builder<builderDelegationInDisju
         this.internal = builderDelegationInDisjunction.defaultExternalLink();
     }
 
+    // Creates a builder wrapping an existing ExternalLink.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: builderDelegationInDisjunction.ExternalLink): ExternalLinkBuilder {
+        const builder: ExternalLinkBuilder = Object.create(ExternalLinkBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): builderDelegationInDisjunction.ExternalLink {
         return this.internal;
     }
@@ -0,0 +1,75 @@
+import * as cog from '../cog';
+import * as builderDelegationInDisjunction from '../builderDelegationInDisjunction';
+
+export class DashboardBuilder implements cog.Builder<builderDelegationInDisjunction.Dashboard> {
+    protected readonly internal: builderDelegationInDisjunction.Dashboard;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = builderDelegationInDisjunction.defaultDashboard();
+    }
+
+    // Creates a builder wrapping an existing Dashboard.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: builderDelegationInDisjunction.Dashboard): DashboardBuilder {
+        const builder: DashboardBuilder = Object.create(DashboardBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): builderDelegationInDisjunction.Dashboard {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("Dashboard", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    // will be expanded to cog.Builder<DashboardLink> | string
+    singleLinkOrString(singleLinkOrString: cog.Builder<builderDelegationInDisjunction.DashboardLink> | string): this {
+        let singleLinkOrStringResource;
+        try {
+            singleLinkOrStringResource = cog.isBuilder(singleLinkOrString) ? singleLinkOrString.build() : singleLinkOrString;
+        } catch (err) {
+            this.errors["singleLinkOrString"] = cog.makeBuildErrors("singleLinkOrString", err);
+            return this;
+        }
+        this.internal.singleLinkOrString = singleLinkOrStringResource;
+        return this;
+    }
+
+    // will be expanded to [](cog.Builder<DashboardLink> | string)
+    linksOrStrings(linksOrStrings: (cog.Builder<builderDelegationInDisjunction.DashboardLink> | string)[]): this {
+        let linksOrStringsResources;
+        try {
+            linksOrStringsResources = linksOrStrings.map(builder1 => cog.isBuilder(builder1) ? builder1.build() : builder1);
+        } catch (err) {
+            this.errors["linksOrStrings"] = cog.makeBuildErrors("linksOrStrings", err);
+            return this;
+        }
+        this.internal.linksOrStrings = linksOrStringsResources;
+        return this;
+    }
+
+    disjunctionOfBuilders(disjunctionOfBuilders: cog.Builder<builderDelegationInDisjunction.DashboardLink> | cog.Builder<builderDelegationInDisjunction.ExternalLink>): this {
+        let disjunctionOfBuildersResource;
+        try {
+            disjunctionOfBuildersResource = disjunctionOfBuilders.build();
+        } catch (err) {
+            this.errors["disjunctionOfBuilders"] = cog.makeBuildErrors("disjunctionOfBuilders", err);
+            return this;
+        }
+        this.internal.disjunctionOfBuilders = disjunctionOfBuildersResource;
+        return this;
+    }
+}
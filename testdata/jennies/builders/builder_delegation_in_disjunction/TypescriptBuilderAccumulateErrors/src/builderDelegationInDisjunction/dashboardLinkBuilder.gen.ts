@@ -0,0 +1,47 @@
+import * as cog from '../cog';
+import * as builderDelegationInDisjunction from '../builderDelegationInDisjunction';
+
+export class DashboardLinkBuilder implements cog.Builder<builderDelegationInDisjunction.DashboardLink> {
+    protected readonly internal: builderDelegationInDisjunction.DashboardLink;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = builderDelegationInDisjunction.defaultDashboardLink();
+    }
+
+    // Creates a builder wrapping an existing DashboardLink.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: builderDelegationInDisjunction.DashboardLink): DashboardLinkBuilder {
+        const builder: DashboardLinkBuilder = Object.create(DashboardLinkBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): builderDelegationInDisjunction.DashboardLink {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("DashboardLink", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    url(url: string): this {
+        this.internal.url = url;
+        return this;
+    }
+}
@@ -0,0 +1,42 @@
+import * as cog from '../cog';
+import * as builderDelegationInDisjunction from '../builderDelegationInDisjunction';
+
+export class ExternalLinkBuilder implements cog.Builder<builderDelegationInDisjunction.ExternalLink> {
+    protected readonly internal: builderDelegationInDisjunction.ExternalLink;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = builderDelegationInDisjunction.defaultExternalLink();
+    }
+
+    // Creates a builder wrapping an existing ExternalLink.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: builderDelegationInDisjunction.ExternalLink): ExternalLinkBuilder {
+        const builder: ExternalLinkBuilder = Object.create(ExternalLinkBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): builderDelegationInDisjunction.ExternalLink {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("ExternalLink", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    url(url: string): this {
+        this.internal.url = url;
+        return this;
+    }
+}
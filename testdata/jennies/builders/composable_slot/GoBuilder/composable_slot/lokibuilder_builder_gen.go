@@ -24,6 +24,18 @@ func NewLokiBuilderBuilder() *LokiBuilderBuilder {
 	return builder
 }
 
+// NewLokiBuilderBuilderFrom creates a builder wrapping an existing Dashboard.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewLokiBuilderBuilderFrom(resource Dashboard) *LokiBuilderBuilder {
+	builder := &LokiBuilderBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *LokiBuilderBuilder) Build() (Dashboard, error) {
 	var errs cog.BuildErrors
 
@@ -0,0 +1,14 @@
+package composable_slot
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// LokiBuilderConverter accepts a `Dashboard` object and generates the Go code to build this object using builders.
+func LokiBuilderConverter(input Dashboard) string {
+	calls := []string{
+		`composable_slot.NewLokiBuilderBuilder()`,
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -10,6 +10,19 @@ class LokiBuilder(cogbuilder.Builder[composable_slot.Dashboard]):
     def __init__(self):
         self._internal = composable_slot.Dashboard()
 
+    @classmethod
+    def from_object(cls, resource: composable_slot.Dashboard) -> typing.Self:
+        """
+        Creates a builder wrapping an existing composable_slot.Dashboard.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> composable_slot.Dashboard:
         return self._internal    
     
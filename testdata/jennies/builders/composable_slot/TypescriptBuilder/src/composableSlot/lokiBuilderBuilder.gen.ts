@@ -8,6 +8,18 @@ export class LokiBuilderBuilder implements cog.Builder<composableSlot.Dashboard>
         this.internal = composableSlot.defaultDashboard();
     }
 
+    // Creates a builder wrapping an existing Dashboard.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: composableSlot.Dashboard): LokiBuilderBuilder {
+        const builder: LokiBuilderBuilder = Object.create(LokiBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): composableSlot.Dashboard {
         return this.internal;
     }
@@ -0,0 +1,61 @@
+import * as cog from '../cog';
+import * as composableSlot from '../composableSlot';
+
+export class LokiBuilderBuilder implements cog.Builder<composableSlot.Dashboard> {
+    protected readonly internal: composableSlot.Dashboard;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = composableSlot.defaultDashboard();
+    }
+
+    // Creates a builder wrapping an existing Dashboard.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: composableSlot.Dashboard): LokiBuilderBuilder {
+        const builder: LokiBuilderBuilder = Object.create(LokiBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): composableSlot.Dashboard {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("LokiBuilder", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    target(target: cog.Builder<cog.Dataquery>): this {
+        let targetResource;
+        try {
+            targetResource = target.build();
+        } catch (err) {
+            this.errors["target"] = cog.makeBuildErrors("target", err);
+            return this;
+        }
+        this.internal.target = targetResource;
+        return this;
+    }
+
+    targets(targets: cog.Builder<cog.Dataquery>[]): this {
+        let targetsResources;
+        try {
+            targetsResources = targets.map(builder1 => builder1.build());
+        } catch (err) {
+            this.errors["targets"] = cog.makeBuildErrors("targets", err);
+            return this;
+        }
+        this.internal.targets = targetsResources;
+        return this;
+    }
+}
@@ -0,0 +1,42 @@
+import typing
+from ..cog import builder as cogbuilder
+from ..models import composable_slot
+from ..cog import variants as cogvariants
+
+
+class LokiBuilder(cogbuilder.Builder[composable_slot.Dashboard]):    
+    _internal: composable_slot.Dashboard
+
+    def __init__(self, target: cogbuilder.Builder[cogvariants.Dataquery]):
+        self._internal = composable_slot.Dashboard()        
+        target_resource = target.build()
+        self._internal.target = target_resource
+
+    @classmethod
+    def from_object(cls, resource: composable_slot.Dashboard) -> typing.Self:
+        """
+        Creates a builder wrapping an existing composable_slot.Dashboard.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> composable_slot.Dashboard:
+        return self._internal    
+    
+    def target(self, target: cogbuilder.Builder[cogvariants.Dataquery]) -> typing.Self:        
+        target_resource = target.build()
+        self._internal.target = target_resource
+    
+        return self
+    
+    def targets(self, targets: list[cogbuilder.Builder[cogvariants.Dataquery]]) -> typing.Self:        
+        targets_resources = [r1.build() for r1 in targets]
+        self._internal.targets = targets_resources
+    
+        return self
+    
\ No newline at end of file
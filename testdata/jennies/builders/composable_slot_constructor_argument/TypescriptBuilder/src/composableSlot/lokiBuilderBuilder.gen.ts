@@ -0,0 +1,39 @@
+import * as cog from '../cog';
+import * as composableSlot from '../composableSlot';
+
+export class LokiBuilderBuilder implements cog.Builder<composableSlot.Dashboard> {
+    protected readonly internal: composableSlot.Dashboard;
+
+    constructor(target: cog.Builder<cog.Dataquery>) {
+        this.internal = composableSlot.defaultDashboard();
+        this.internal.target = targetResource;
+    }
+
+    // Creates a builder wrapping an existing Dashboard.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: composableSlot.Dashboard): LokiBuilderBuilder {
+        const builder: LokiBuilderBuilder = Object.create(LokiBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
+    build(): composableSlot.Dashboard {
+        return this.internal;
+    }
+
+    target(target: cog.Builder<cog.Dataquery>): this {
+        const targetResource = target.build();
+        this.internal.target = targetResource;
+        return this;
+    }
+
+    targets(targets: cog.Builder<cog.Dataquery>[]): this {
+        const targetsResources = targets.map(builder1 => builder1.build());
+        this.internal.targets = targetsResources;
+        return this;
+    }
+}
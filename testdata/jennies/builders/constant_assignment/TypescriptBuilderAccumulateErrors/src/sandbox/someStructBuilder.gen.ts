@@ -0,0 +1,57 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class SomeStructBuilder implements cog.Builder<sandbox.SomeStruct> {
+    protected readonly internal: sandbox.SomeStruct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = sandbox.defaultSomeStruct();
+    }
+
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: sandbox.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.SomeStruct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomeStruct", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    editable(): this {
+        this.internal.editable = true;
+        return this;
+    }
+
+    readonly(): this {
+        this.internal.editable = false;
+        return this;
+    }
+
+    autoRefresh(): this {
+        this.internal.autoRefresh = true;
+        return this;
+    }
+
+    noAutoRefresh(): this {
+        this.internal.autoRefresh = false;
+        return this;
+    }
+}
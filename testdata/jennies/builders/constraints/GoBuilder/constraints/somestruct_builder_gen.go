@@ -23,6 +23,18 @@ func NewSomeStructBuilder() *SomeStructBuilder {
 	return builder
 }
 
+// NewSomeStructBuilderFrom creates a builder wrapping an existing SomeStruct.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewSomeStructBuilderFrom(resource SomeStruct) *SomeStructBuilder {
+	builder := &SomeStructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *SomeStructBuilder) Build() (SomeStruct, error) {
 	var errs cog.BuildErrors
 
@@ -61,5 +73,63 @@ func (builder *SomeStructBuilder) Title(title string) *SomeStructBuilder {
     return builder
 }
 
+func (builder *SomeStructBuilder) Slug(slug string) *SomeStructBuilder {
+    if !(regexp.MustCompile("^[a-z0-9-]+$").MatchString(slug)) {
+        builder.errors["slug"] = cog.MakeBuildErrors("slug", errors.New("slug must match \"^[a-z0-9-]+$\""))
+        return builder
+    }
+    if !(!regexp.MustCompile("^_").MatchString(slug)) {
+        builder.errors["slug"] = cog.MakeBuildErrors("slug", errors.New("slug must not match \"^_\""))
+        return builder
+    }
+    builder.internal.Slug = slug
+
+    return builder
+}
+
+func (builder *SomeStructBuilder) Url(url string) *SomeStructBuilder {
+    if !(strings.HasPrefix(url, "https://")) {
+        builder.errors["url"] = cog.MakeBuildErrors("url", errors.New("url must start with \"https://\""))
+        return builder
+    }
+    builder.internal.Url = url
+
+    return builder
+}
+
+func (builder *SomeStructBuilder) Step(step int64) *SomeStructBuilder {
+    if !(math.Mod(float64(step), float64(5)) == 0) {
+        builder.errors["step"] = cog.MakeBuildErrors("step", errors.New("step must be a multiple of 5"))
+        return builder
+    }
+    builder.internal.Step = step
+
+    return builder
+}
+
+func (builder *SomeStructBuilder) Tags(tags []string) *SomeStructBuilder {
+    if !(len(tags) >= 1) {
+        builder.errors["tags"] = cog.MakeBuildErrors("tags", errors.New("len(tags) must be >= 1"))
+        return builder
+    }
+    if !(cog.UniqueItems(tags)) {
+        builder.errors["tags"] = cog.MakeBuildErrors("tags", errors.New("tags must contain unique items"))
+        return builder
+    }
+    builder.internal.Tags = tags
+
+    return builder
+}
+
+func (builder *SomeStructBuilder) Labels(labels map[string]string) *SomeStructBuilder {
+    if !(len(labels) <= 5) {
+        builder.errors["labels"] = cog.MakeBuildErrors("labels", errors.New("len(labels) must be <= 5"))
+        return builder
+    }
+    builder.internal.Labels = labels
+
+    return builder
+}
+
 func (builder *SomeStructBuilder) applyDefaults() {
 }
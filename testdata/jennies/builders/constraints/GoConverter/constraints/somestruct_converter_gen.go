@@ -0,0 +1,85 @@
+package constraints
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructConverter(input SomeStruct) string {
+	calls := []string{
+		`constraints.NewSomeStructBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Id) {
+		arg0 := input.Id
+		buffer.WriteString(`Id(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.Title) {
+		arg0 := input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.Slug) {
+		arg0 := input.Slug
+		buffer.WriteString(`Slug(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.Url) {
+		arg0 := input.Url
+		buffer.WriteString(`Url(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.Step) {
+		arg0 := input.Step
+		buffer.WriteString(`Step(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if len(input.Tags) != 0 {
+		arg0 := input.Tags
+		buffer.WriteString(`Tags(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if len(input.Labels) != 0 {
+		arg0 := input.Labels
+		buffer.WriteString(`Labels(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -0,0 +1,85 @@
+package constraints;
+
+import java.util.List;
+import java.util.Map;
+
+public class SomeStructBuilder implements cog.Builder<SomeStruct> {
+    protected final SomeStruct internal;
+
+    public SomeStructBuilder() {
+        this.internal = new SomeStruct();
+        this.applyDefaults();
+    }
+
+    public SomeStructBuilder id(Long id) {
+        if (!(id >= 5)) {
+            throw new IllegalArgumentException("id must be >= 5");
+        }
+        if (!(id < 10)) {
+            throw new IllegalArgumentException("id must be < 10");
+        }
+        this.internal.id = id;
+        return this;
+    }
+
+    public SomeStructBuilder title(String title) {
+        if (!(title.length() >= 1)) {
+            throw new IllegalArgumentException("title.length() must be >= 1");
+        }
+        this.internal.title = title;
+        return this;
+    }
+
+    public SomeStructBuilder slug(String slug) {
+        if (!(java.util.regex.Pattern.compile("^[a-z0-9-]+$").matcher(slug).find())) {
+            throw new IllegalArgumentException("slug must match \"^[a-z0-9-]+$\"");
+        }
+        if (!(!java.util.regex.Pattern.compile("^_").matcher(slug).find())) {
+            throw new IllegalArgumentException("slug must not match \"^_\"");
+        }
+        this.internal.slug = slug;
+        return this;
+    }
+
+    public SomeStructBuilder url(String url) {
+        if (!(url.startsWith("https://"))) {
+            throw new IllegalArgumentException("url must start with \"https://\"");
+        }
+        this.internal.url = url;
+        return this;
+    }
+
+    public SomeStructBuilder step(Long step) {
+        if (!(step % 5 == 0)) {
+            throw new IllegalArgumentException("step % 5 must be == 0");
+        }
+        this.internal.step = step;
+        return this;
+    }
+
+    public SomeStructBuilder tags(List<String> tags) {
+        if (!(tags.size() >= 1)) {
+            throw new IllegalArgumentException("tags.size() must be >= 1");
+        }
+        if (!(new java.util.HashSet<>(tags).size() == tags.size())) {
+            throw new IllegalArgumentException("tags must contain unique items");
+        }
+        this.internal.tags = tags;
+        return this;
+    }
+
+    public SomeStructBuilder labels(Map<String, String> labels) {
+        if (!(labels.size() <= 5)) {
+            throw new IllegalArgumentException("labels.size() must be <= 5");
+        }
+        this.internal.labels = labels;
+        return this;
+    }
+
+    public SomeStruct build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -1,6 +1,7 @@
 import typing
 from ..cog import builder as cogbuilder
 from ..models import constraints
+import re
 
 
 class SomeStruct(cogbuilder.Builder[constraints.SomeStruct]):    
@@ -9,22 +10,74 @@ class SomeStruct(cogbuilder.Builder[constraints.SomeStruct]):
     def __init__(self):
         self._internal = constraints.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: constraints.SomeStruct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing constraints.SomeStruct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> constraints.SomeStruct:
         return self._internal    
     
     def id_val(self, id_val: int) -> typing.Self:        
-        if not id_val >= 5:
+        if not (id_val >= 5):
             raise ValueError("id_val must be >= 5")
-        if not id_val < 10:
+        if not (id_val < 10):
             raise ValueError("id_val must be < 10")
         self._internal.id_val = id_val
     
         return self
     
     def title(self, title: str) -> typing.Self:        
-        if not len(title) >= 1:
+        if not (len(title) >= 1):
             raise ValueError("len(title) must be >= 1")
         self._internal.title = title
     
         return self
+    
+    def slug(self, slug: str) -> typing.Self:        
+        if not (re.search("^[a-z0-9-]+$", slug)):
+            raise ValueError("slug must match \"^[a-z0-9-]+$\"")
+        if not (not re.search("^_", slug)):
+            raise ValueError("slug must not match \"^_\"")
+        self._internal.slug = slug
+    
+        return self
+    
+    def url(self, url: str) -> typing.Self:        
+        if not (url.startswith("https://")):
+            raise ValueError("url must start with \"https://\"")
+        self._internal.url = url
+    
+        return self
+    
+    def step(self, step: int) -> typing.Self:        
+        if not (step % 5 == 0):
+            raise ValueError("step must be a multiple of 5")
+        self._internal.step = step
+    
+        return self
+    
+    def tags(self, tags: list[str]) -> typing.Self:        
+        if not (len(tags) >= 1):
+            raise ValueError("len(tags) must be >= 1")
+        if not (all(item not in tags[:i] for i, item in enumerate(tags))):
+            raise ValueError("tags must contain unique items")
+        self._internal.tags = tags
+    
+        return self
+    
+    def labels(self, labels: dict[str, str]) -> typing.Self:        
+        if not (len(labels) <= 5):
+            raise ValueError("len(labels) must be <= 5")
+        self._internal.labels = labels
+    
+        return self
     
\ No newline at end of file
@@ -8,6 +8,18 @@ export class SomeStructBuilder implements cog.Builder<constraints.SomeStruct> {
         this.internal = constraints.defaultSomeStruct();
     }
 
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: constraints.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): constraints.SomeStruct {
         return this.internal;
     }
@@ -30,4 +42,50 @@ export class SomeStructBuilder implements cog.Builder<constraints.SomeStruct> {
         this.internal.title = title;
         return this;
     }
+
+    slug(slug: string): this {
+        if (!(new RegExp("^[a-z0-9-]+$").test(slug))) {
+            throw new Error("slug must match \"^[a-z0-9-]+$\"");
+        }
+        if (!(!new RegExp("^_").test(slug))) {
+            throw new Error("slug must not match \"^_\"");
+        }
+        this.internal.slug = slug;
+        return this;
+    }
+
+    url(url: string): this {
+        if (!(url.startsWith("https://"))) {
+            throw new Error("url must start with \"https://\"");
+        }
+        this.internal.url = url;
+        return this;
+    }
+
+    step(step: number): this {
+        if (!(step % 5 === 0)) {
+            throw new Error("step must be a multiple of 5");
+        }
+        this.internal.step = step;
+        return this;
+    }
+
+    tags(tags: string[]): this {
+        if (!(tags.length >= 1)) {
+            throw new Error("tags.length must be >= 1");
+        }
+        if (!(new Set(tags.map(item => JSON.stringify(item))).size === tags.length)) {
+            throw new Error("tags must contain unique items");
+        }
+        this.internal.tags = tags;
+        return this;
+    }
+
+    labels(labels: Record<string, string>): this {
+        if (!(Object.keys(labels).length <= 5)) {
+            throw new Error("Object.keys(labels).length must be <= 5");
+        }
+        this.internal.labels = labels;
+        return this;
+    }
 }
@@ -0,0 +1,112 @@
+import * as cog from '../cog';
+import * as constraints from '../constraints';
+
+export class SomeStructBuilder implements cog.Builder<constraints.SomeStruct> {
+    protected readonly internal: constraints.SomeStruct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = constraints.defaultSomeStruct();
+    }
+
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: constraints.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): constraints.SomeStruct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomeStruct", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    id(id: number): this {
+        if (!(id >= 5)) {
+            this.errors["id"] = cog.makeBuildErrors("id", new Error("id must be >= 5"));
+            return this;
+        }
+        if (!(id < 10)) {
+            this.errors["id"] = cog.makeBuildErrors("id", new Error("id must be < 10"));
+            return this;
+        }
+        this.internal.id = id;
+        return this;
+    }
+
+    title(title: string): this {
+        if (!(title.length >= 1)) {
+            this.errors["title"] = cog.makeBuildErrors("title", new Error("title.length must be >= 1"));
+            return this;
+        }
+        this.internal.title = title;
+        return this;
+    }
+
+    slug(slug: string): this {
+        if (!(new RegExp("^[a-z0-9-]+$").test(slug))) {
+            this.errors["slug"] = cog.makeBuildErrors("slug", new Error("slug must match \"^[a-z0-9-]+$\""));
+            return this;
+        }
+        if (!(!new RegExp("^_").test(slug))) {
+            this.errors["slug"] = cog.makeBuildErrors("slug", new Error("slug must not match \"^_\""));
+            return this;
+        }
+        this.internal.slug = slug;
+        return this;
+    }
+
+    url(url: string): this {
+        if (!(url.startsWith("https://"))) {
+            this.errors["url"] = cog.makeBuildErrors("url", new Error("url must start with \"https://\""));
+            return this;
+        }
+        this.internal.url = url;
+        return this;
+    }
+
+    step(step: number): this {
+        if (!(step % 5 === 0)) {
+            this.errors["step"] = cog.makeBuildErrors("step", new Error("step must be a multiple of 5"));
+            return this;
+        }
+        this.internal.step = step;
+        return this;
+    }
+
+    tags(tags: string[]): this {
+        if (!(tags.length >= 1)) {
+            this.errors["tags"] = cog.makeBuildErrors("tags", new Error("tags.length must be >= 1"));
+            return this;
+        }
+        if (!(new Set(tags.map(item => JSON.stringify(item))).size === tags.length)) {
+            this.errors["tags"] = cog.makeBuildErrors("tags", new Error("tags must contain unique items"));
+            return this;
+        }
+        this.internal.tags = tags;
+        return this;
+    }
+
+    labels(labels: Record<string, string>): this {
+        if (!(Object.keys(labels).length <= 5)) {
+            this.errors["labels"] = cog.makeBuildErrors("labels", new Error("Object.keys(labels).length must be <= 5"));
+            return this;
+        }
+        this.internal.labels = labels;
+        return this;
+    }
+}
@@ -1,10 +1,20 @@
 package sandbox
 
-import "strings"
+import (
+	"list"
+	"math"
+	"strings"
+	"struct"
+)
 
 SomeStruct: {
 	id: int64 & >= 5 & <10
 	title: strings.MinRunes(1) & {
 		string
 	}
+	slug: string & =~"^[a-z0-9-]+$" & !~"^_"
+	url: string & strings.HasPrefix("https://")
+	step: int64 & math.MultipleOf(5)
+	tags: [...string] & list.MinItems(1) & list.UniqueItems()
+	labels: {[string]: string} & struct.MaxFields(5)
 }
@@ -0,0 +1,16 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructConverter(input SomeStruct) string {
+	var constructorArg0 string
+	constructorArg0 = input.Title
+	calls := []string{
+		`sandbox.NewSomeStructBuilder(` + cog.Dump(constructorArg0) + `)`,
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -0,0 +1,24 @@
+package sandbox;
+
+
+public class SomeStructBuilder implements cog.Builder<SomeStruct> {
+    protected final SomeStruct internal;
+
+    public SomeStructBuilder(String title) {
+        this.internal = new SomeStruct();
+        this.applyDefaults();
+        this.internal.title = title;
+    }
+
+    public SomeStructBuilder title(String title) {
+        this.internal.title = title;
+        return this;
+    }
+
+    public SomeStruct build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
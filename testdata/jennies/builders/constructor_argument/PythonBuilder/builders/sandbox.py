@@ -10,6 +10,19 @@ class SomeStruct(cogbuilder.Builder[sandbox.SomeStruct]):
         self._internal = sandbox.SomeStruct()        
         self._internal.title = title
 
+    @classmethod
+    def from_object(cls, resource: sandbox.SomeStruct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing sandbox.SomeStruct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> sandbox.SomeStruct:
         return self._internal    
     
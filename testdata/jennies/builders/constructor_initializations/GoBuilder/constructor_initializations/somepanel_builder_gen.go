@@ -20,7 +20,19 @@ func NewSomePanelBuilder() *SomePanelBuilder {
 
 	builder.applyDefaults()
     builder.internal.Type = "panel_type"
-    builder.internal.Cursor = "tooltip"
+    builder.internal.Cursor = Tooltip
+
+	return builder
+}
+
+// NewSomePanelBuilderFrom creates a builder wrapping an existing SomePanel.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewSomePanelBuilderFrom(resource SomePanel) *SomePanelBuilder {
+	builder := &SomePanelBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
 
 	return builder
 }
@@ -0,0 +1,25 @@
+package constructor_initializations
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomePanelConverter accepts a `SomePanel` object and generates the Go code to build this object using builders.
+func SomePanelConverter(input SomePanel) string {
+	calls := []string{
+		`constructor_initializations.NewSomePanelBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Title) {
+		arg0 := input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
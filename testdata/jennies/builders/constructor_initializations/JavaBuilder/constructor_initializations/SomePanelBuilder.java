@@ -0,0 +1,25 @@
+package constructor_initializations;
+
+
+public class SomePanelBuilder implements cog.Builder<SomePanel> {
+    protected final SomePanel internal;
+
+    public SomePanelBuilder() {
+        this.internal = new SomePanel();
+        this.applyDefaults();
+        this.internal.type = "panel_type";
+        this.internal.cursor = CursorMode.TOOLTIP;
+    }
+
+    public SomePanelBuilder title(String title) {
+        this.internal.title = title;
+        return this;
+    }
+
+    public SomePanel build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -11,6 +11,19 @@ class SomePanel(cogbuilder.Builder[constructor_initializations.SomePanel]):
         self._internal.type_val = "panel_type"        
         self._internal.cursor = constructor_initializations.CursorMode.TOOLTIP
 
+    @classmethod
+    def from_object(cls, resource: constructor_initializations.SomePanel) -> typing.Self:
+        """
+        Creates a builder wrapping an existing constructor_initializations.SomePanel.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> constructor_initializations.SomePanel:
         return self._internal    
     
@@ -10,6 +10,18 @@ export class SomePanelBuilder implements cog.Builder<constructorInitializations.
         this.internal.cursor = constructorInitializations.CursorMode.Tooltip;
     }
 
+    // Creates a builder wrapping an existing SomePanel.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: constructorInitializations.SomePanel): SomePanelBuilder {
+        const builder: SomePanelBuilder = Object.create(SomePanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): constructorInitializations.SomePanel {
         return this.internal;
     }
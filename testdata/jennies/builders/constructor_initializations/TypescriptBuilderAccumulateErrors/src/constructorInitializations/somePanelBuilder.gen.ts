@@ -0,0 +1,44 @@
+import * as cog from '../cog';
+import * as constructorInitializations from '../constructorInitializations';
+
+export class SomePanelBuilder implements cog.Builder<constructorInitializations.SomePanel> {
+    protected readonly internal: constructorInitializations.SomePanel;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = constructorInitializations.defaultSomePanel();
+        this.internal.type = "panel_type";
+        this.internal.cursor = constructorInitializations.CursorMode.Tooltip;
+    }
+
+    // Creates a builder wrapping an existing SomePanel.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: constructorInitializations.SomePanel): SomePanelBuilder {
+        const builder: SomePanelBuilder = Object.create(SomePanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): constructorInitializations.SomePanel {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomePanel", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+}
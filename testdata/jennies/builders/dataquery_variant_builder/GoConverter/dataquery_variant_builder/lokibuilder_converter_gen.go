@@ -0,0 +1,25 @@
+package dataquery_variant_builder
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// LokiBuilderConverter accepts a `Loki` object and generates the Go code to build this object using builders.
+func LokiBuilderConverter(input Loki) string {
+	calls := []string{
+		`dataquery_variant_builder.NewLokiBuilderBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Expr) {
+		arg0 := input.Expr
+		buffer.WriteString(`Expr(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
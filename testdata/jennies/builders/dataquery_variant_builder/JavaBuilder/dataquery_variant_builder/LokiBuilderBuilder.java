@@ -0,0 +1,24 @@
+package dataquery_variant_builder;
+
+import cog.variants.Dataquery;
+
+public class LokiBuilderBuilder implements cog.Builder<Dataquery> {
+    protected final Loki internal;
+
+    public LokiBuilderBuilder() {
+        this.internal = new Loki();
+        this.applyDefaults();
+    }
+
+    public LokiBuilderBuilder expr(String expr) {
+        this.internal.expr = expr;
+        return this;
+    }
+
+    public Dataquery build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -9,6 +9,19 @@ class LokiBuilder(cogbuilder.Builder[dataquery_variant_builder.Loki]):
     def __init__(self):
         self._internal = dataquery_variant_builder.Loki()
 
+    @classmethod
+    def from_object(cls, resource: dataquery_variant_builder.Loki) -> typing.Self:
+        """
+        Creates a builder wrapping an existing dataquery_variant_builder.Loki.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> dataquery_variant_builder.Loki:
         return self._internal    
     
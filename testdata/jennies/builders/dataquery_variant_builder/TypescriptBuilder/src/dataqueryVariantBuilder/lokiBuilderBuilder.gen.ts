@@ -8,6 +8,18 @@ export class LokiBuilderBuilder implements cog.Builder<cog.Dataquery> {
         this.internal = dataqueryVariantBuilder.defaultLoki();
     }
 
+    // Creates a builder wrapping an existing Loki.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: dataqueryVariantBuilder.Loki): LokiBuilderBuilder {
+        const builder: LokiBuilderBuilder = Object.create(LokiBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): dataqueryVariantBuilder.Loki {
         return this.internal;
     }
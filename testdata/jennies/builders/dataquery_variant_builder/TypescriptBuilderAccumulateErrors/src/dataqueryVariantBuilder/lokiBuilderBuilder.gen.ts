@@ -0,0 +1,42 @@
+import * as cog from '../cog';
+import * as dataqueryVariantBuilder from '../dataqueryVariantBuilder';
+
+export class LokiBuilderBuilder implements cog.Builder<cog.Dataquery> {
+    protected readonly internal: dataqueryVariantBuilder.Loki;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = dataqueryVariantBuilder.defaultLoki();
+    }
+
+    // Creates a builder wrapping an existing Loki.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: dataqueryVariantBuilder.Loki): LokiBuilderBuilder {
+        const builder: LokiBuilderBuilder = Object.create(LokiBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): dataqueryVariantBuilder.Loki {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("LokiBuilder", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    expr(expr: string): this {
+        this.internal.expr = expr;
+        return this;
+    }
+}
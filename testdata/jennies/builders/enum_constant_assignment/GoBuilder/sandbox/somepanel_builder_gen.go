@@ -0,0 +1,93 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[SomePanel] = (*SomePanelBuilder)(nil)
+
+type SomePanelBuilder struct {
+    internal *SomePanel
+    errors map[string]cog.BuildErrors
+}
+
+func NewSomePanelBuilder() *SomePanelBuilder {
+	resource := &SomePanel{}
+	builder := &SomePanelBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewSomePanelBuilderFrom creates a builder wrapping an existing SomePanel.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewSomePanelBuilderFrom(resource SomePanel) *SomePanelBuilder {
+	builder := &SomePanelBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *SomePanelBuilder) Build() (SomePanel, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("SomePanel", err)...)
+	}
+
+	if len(errs) != 0 {
+		return SomePanel{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *SomePanelBuilder) AsList() *SomePanelBuilder {
+            valMode := PanelModeList
+    builder.internal.Mode = &valMode
+
+    return builder
+}
+
+func (builder *SomePanelBuilder) AsTable() *SomePanelBuilder {
+            valMode := PanelModeTable
+    builder.internal.Mode = &valMode
+
+    return builder
+}
+
+func (builder *SomePanelBuilder) DisplayModeCompact() *SomePanelBuilder {
+            valDisplayMode := SomePanelDisplayModeCompact
+    builder.internal.DisplayMode = &valDisplayMode
+
+    return builder
+}
+
+func (builder *SomePanelBuilder) DisplayModeWide() *SomePanelBuilder {
+            valDisplayMode := SomePanelDisplayModeWide
+    builder.internal.DisplayMode = &valDisplayMode
+
+    return builder
+}
+
+func (builder *SomePanelBuilder) RequiredModeList() *SomePanelBuilder {
+    builder.internal.RequiredMode = PanelModeList
+
+    return builder
+}
+
+func (builder *SomePanelBuilder) RequiredModeTable() *SomePanelBuilder {
+    builder.internal.RequiredMode = PanelModeTable
+
+    return builder
+}
+
+func (builder *SomePanelBuilder) applyDefaults() {
+}
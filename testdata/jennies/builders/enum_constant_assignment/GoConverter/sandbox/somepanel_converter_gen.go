@@ -0,0 +1,63 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomePanelConverter accepts a `SomePanel` object and generates the Go code to build this object using builders.
+func SomePanelConverter(input SomePanel) string {
+	calls := []string{
+		`sandbox.NewSomePanelBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if input.Mode != nil && *input.Mode == "list" {
+		buffer.WriteString(`AsList(`)
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.Mode != nil && *input.Mode == "table" {
+		buffer.WriteString(`AsTable(`)
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.DisplayMode != nil && *input.DisplayMode == "compact" {
+		buffer.WriteString(`DisplayModeCompact(`)
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.DisplayMode != nil && *input.DisplayMode == "wide" {
+		buffer.WriteString(`DisplayModeWide(`)
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.RequiredMode == "list" {
+		buffer.WriteString(`RequiredModeList(`)
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.RequiredMode == "table" {
+		buffer.WriteString(`RequiredModeTable(`)
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
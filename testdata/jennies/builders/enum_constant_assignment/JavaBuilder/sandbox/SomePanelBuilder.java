@@ -0,0 +1,48 @@
+package sandbox;
+
+
+public class SomePanelBuilder implements cog.Builder<SomePanel> {
+    protected final SomePanel internal;
+
+    public SomePanelBuilder() {
+        this.internal = new SomePanel();
+        this.applyDefaults();
+    }
+
+    public SomePanelBuilder asList() {
+        this.internal.mode = PanelMode.PANEL_MODE_LIST;
+        return this;
+    }
+
+    public SomePanelBuilder asTable() {
+        this.internal.mode = PanelMode.PANEL_MODE_TABLE;
+        return this;
+    }
+
+    public SomePanelBuilder displayModeCompact() {
+        this.internal.displayMode = SomePanelDisplayMode.SOME_PANEL_DISPLAY_MODE_COMPACT;
+        return this;
+    }
+
+    public SomePanelBuilder displayModeWide() {
+        this.internal.displayMode = SomePanelDisplayMode.SOME_PANEL_DISPLAY_MODE_WIDE;
+        return this;
+    }
+
+    public SomePanelBuilder requiredModeList() {
+        this.internal.requiredMode = PanelMode.PANEL_MODE_LIST;
+        return this;
+    }
+
+    public SomePanelBuilder requiredModeTable() {
+        this.internal.requiredMode = PanelMode.PANEL_MODE_TABLE;
+        return this;
+    }
+
+    public SomePanel build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -0,0 +1,57 @@
+import typing
+from ..cog import builder as cogbuilder
+from ..models import sandbox
+
+
+class SomePanel(cogbuilder.Builder[sandbox.SomePanel]):    
+    _internal: sandbox.SomePanel
+
+    def __init__(self):
+        self._internal = sandbox.SomePanel()
+
+    @classmethod
+    def from_object(cls, resource: sandbox.SomePanel) -> typing.Self:
+        """
+        Creates a builder wrapping an existing sandbox.SomePanel.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> sandbox.SomePanel:
+        return self._internal    
+    
+    def as_list(self) -> typing.Self:        
+        self._internal.mode = sandbox.PanelMode.PANEL_MODE_LIST
+    
+        return self
+    
+    def as_table(self) -> typing.Self:        
+        self._internal.mode = sandbox.PanelMode.PANEL_MODE_TABLE
+    
+        return self
+    
+    def display_mode_compact(self) -> typing.Self:        
+        self._internal.display_mode = sandbox.SomePanelDisplayMode.SOME_PANEL_DISPLAY_MODE_COMPACT
+    
+        return self
+    
+    def display_mode_wide(self) -> typing.Self:        
+        self._internal.display_mode = sandbox.SomePanelDisplayMode.SOME_PANEL_DISPLAY_MODE_WIDE
+    
+        return self
+    
+    def required_mode_list(self) -> typing.Self:        
+        self._internal.required_mode = sandbox.PanelMode.PANEL_MODE_LIST
+    
+        return self
+    
+    def required_mode_table(self) -> typing.Self:        
+        self._internal.required_mode = sandbox.PanelMode.PANEL_MODE_TABLE
+    
+        return self
+    
\ No newline at end of file
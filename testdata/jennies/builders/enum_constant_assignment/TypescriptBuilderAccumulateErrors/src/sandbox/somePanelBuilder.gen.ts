@@ -0,0 +1,67 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class SomePanelBuilder implements cog.Builder<sandbox.SomePanel> {
+    protected readonly internal: sandbox.SomePanel;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = sandbox.defaultSomePanel();
+    }
+
+    // Creates a builder wrapping an existing SomePanel.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: sandbox.SomePanel): SomePanelBuilder {
+        const builder: SomePanelBuilder = Object.create(SomePanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.SomePanel {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomePanel", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    asList(): this {
+        this.internal.mode = sandbox.PanelMode.PanelModeList;
+        return this;
+    }
+
+    asTable(): this {
+        this.internal.mode = sandbox.PanelMode.PanelModeTable;
+        return this;
+    }
+
+    displayModeCompact(): this {
+        this.internal.displayMode = sandbox.SomePanelDisplayMode.SomePanelDisplayModeCompact;
+        return this;
+    }
+
+    displayModeWide(): this {
+        this.internal.displayMode = sandbox.SomePanelDisplayMode.SomePanelDisplayModeWide;
+        return this;
+    }
+
+    requiredModeList(): this {
+        this.internal.requiredMode = sandbox.PanelMode.PanelModeList;
+        return this;
+    }
+
+    requiredModeTable(): this {
+        this.internal.requiredMode = sandbox.PanelMode.PanelModeTable;
+        return this;
+    }
+}
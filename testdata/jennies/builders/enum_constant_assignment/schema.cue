@@ -0,0 +1,9 @@
+package sandbox
+
+#PanelMode: "list" | "table" @cog(kind="enum")
+
+SomePanel: {
+	mode?: #PanelMode
+	displayMode?: "compact" | "wide"
+	requiredMode: #PanelMode
+}
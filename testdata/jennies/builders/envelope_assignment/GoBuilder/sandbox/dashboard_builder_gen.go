@@ -23,6 +23,18 @@ func NewDashboardBuilder() *DashboardBuilder {
 	return builder
 }
 
+// NewDashboardBuilderFrom creates a builder wrapping an existing Dashboard.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewDashboardBuilderFrom(resource Dashboard) *DashboardBuilder {
+	builder := &DashboardBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *DashboardBuilder) Build() (Dashboard, error) {
 	var errs cog.BuildErrors
 
@@ -0,0 +1,26 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// DashboardConverter accepts a `Dashboard` object and generates the Go code to build this object using builders.
+func DashboardConverter(input Dashboard) string {
+	calls := []string{
+		`sandbox.NewDashboardBuilder()`,
+	}
+	var buffer strings.Builder
+
+	for _, item := range input.Variables {
+		buffer.WriteString(`WithVariable(`)
+		buffer.WriteString(cog.Dump(item.Name))
+		buffer.WriteString(", ")
+		buffer.WriteString(cog.Dump(item.Value))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -0,0 +1,30 @@
+package sandbox;
+
+import java.util.LinkedList;
+
+public class DashboardBuilder implements cog.Builder<Dashboard> {
+    protected final Dashboard internal;
+
+    public DashboardBuilder() {
+        this.internal = new Dashboard();
+        this.applyDefaults();
+    }
+
+    public DashboardBuilder withVariable(String name, String value) {
+        if (this.internal.variables == null) {
+            this.internal.variables = new LinkedList<>();
+        }
+        Variable variableEnvelope = new Variable();
+        variableEnvelope.name = name;
+        variableEnvelope.value = value;
+        this.internal.variables.add(variableEnvelope);
+        return this;
+    }
+
+    public Dashboard build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
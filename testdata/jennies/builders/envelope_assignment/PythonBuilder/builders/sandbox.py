@@ -9,6 +9,19 @@ class Dashboard(cogbuilder.Builder[sandbox.Dashboard]):
     def __init__(self):
         self._internal = sandbox.Dashboard()
 
+    @classmethod
+    def from_object(cls, resource: sandbox.Dashboard) -> typing.Self:
+        """
+        Creates a builder wrapping an existing sandbox.Dashboard.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> sandbox.Dashboard:
         return self._internal    
     
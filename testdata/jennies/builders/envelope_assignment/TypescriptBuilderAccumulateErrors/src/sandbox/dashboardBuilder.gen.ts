@@ -0,0 +1,48 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class DashboardBuilder implements cog.Builder<sandbox.Dashboard> {
+    protected readonly internal: sandbox.Dashboard;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = sandbox.defaultDashboard();
+    }
+
+    // Creates a builder wrapping an existing Dashboard.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: sandbox.Dashboard): DashboardBuilder {
+        const builder: DashboardBuilder = Object.create(DashboardBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.Dashboard {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("Dashboard", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    withVariable(name: string,value: string): this {
+        if (!this.internal.variables) {
+            this.internal.variables = [];
+        }
+        this.internal.variables.push({
+        name: name,
+        value: value,
+    });
+        return this;
+    }
+}
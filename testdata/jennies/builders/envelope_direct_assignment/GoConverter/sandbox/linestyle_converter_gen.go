@@ -0,0 +1,35 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// LineStyleConverter accepts a `LineStyle` object and generates the Go code to build this object using builders.
+func LineStyleConverter(input LineStyle) string {
+	calls := []string{
+		`sandbox.NewLineStyleBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Fill) {
+		arg0 := input.Fill
+		buffer.WriteString(`Fill(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if len(input.Dash) != 0 {
+		arg0 := input.Dash
+		buffer.WriteString(`Dash(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
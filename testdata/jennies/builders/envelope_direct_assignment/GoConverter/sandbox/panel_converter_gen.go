@@ -0,0 +1,34 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// PanelConverter accepts a `Panel` object and generates the Go code to build this object using builders.
+func PanelConverter(input Panel) string {
+	calls := []string{
+		`sandbox.NewPanelBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Title) {
+		arg0 := input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.LineStyle != nil {
+		buffer.WriteString(`Fill(`)
+		buffer.WriteString(cog.Dump((*input.LineStyle).Fill))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
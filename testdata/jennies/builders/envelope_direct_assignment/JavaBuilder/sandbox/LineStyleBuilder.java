@@ -0,0 +1,29 @@
+package sandbox;
+
+import java.util.List;
+
+public class LineStyleBuilder implements cog.Builder<LineStyle> {
+    protected final LineStyle internal;
+
+    public LineStyleBuilder() {
+        this.internal = new LineStyle();
+        this.applyDefaults();
+    }
+
+    public LineStyleBuilder fill(String fill) {
+        this.internal.fill = fill;
+        return this;
+    }
+
+    public LineStyleBuilder dash(List<Long> dash) {
+        this.internal.dash = dash;
+        return this;
+    }
+
+    public LineStyle build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -0,0 +1,30 @@
+package sandbox;
+
+
+public class PanelBuilder implements cog.Builder<Panel> {
+    protected final Panel internal;
+
+    public PanelBuilder() {
+        this.internal = new Panel();
+        this.applyDefaults();
+    }
+
+    public PanelBuilder title(String title) {
+        this.internal.title = title;
+        return this;
+    }
+
+    public PanelBuilder fill(String fill) {
+        LineStyle lineStyleEnvelope = new LineStyle();
+        lineStyleEnvelope.fill = fill;
+        this.internal.lineStyle = lineStyleEnvelope;
+        return this;
+    }
+
+    public Panel build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
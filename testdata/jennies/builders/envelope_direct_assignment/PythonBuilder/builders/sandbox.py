@@ -0,0 +1,72 @@
+import typing
+from ..cog import builder as cogbuilder
+from ..models import sandbox
+
+
+class LineStyle(cogbuilder.Builder[sandbox.LineStyle]):    
+    _internal: sandbox.LineStyle
+
+    def __init__(self):
+        self._internal = sandbox.LineStyle()
+
+    @classmethod
+    def from_object(cls, resource: sandbox.LineStyle) -> typing.Self:
+        """
+        Creates a builder wrapping an existing sandbox.LineStyle.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> sandbox.LineStyle:
+        return self._internal    
+    
+    def fill(self, fill: str) -> typing.Self:        
+        self._internal.fill = fill
+    
+        return self
+    
+    def dash(self, dash: list[int]) -> typing.Self:        
+        self._internal.dash = dash
+    
+        return self
+    
+
+class Panel(cogbuilder.Builder[sandbox.Panel]):    
+    _internal: sandbox.Panel
+
+    def __init__(self):
+        self._internal = sandbox.Panel()
+
+    @classmethod
+    def from_object(cls, resource: sandbox.Panel) -> typing.Self:
+        """
+        Creates a builder wrapping an existing sandbox.Panel.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> sandbox.Panel:
+        return self._internal    
+    
+    def title(self, title: str) -> typing.Self:        
+        self._internal.title = title
+    
+        return self
+    
+    def fill(self, fill: str) -> typing.Self:        
+        self._internal.line_style = sandbox.LineStyle(
+            fill=fill,
+        )
+    
+        return self
+    
\ No newline at end of file
@@ -0,0 +1,36 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class LineStyleBuilder implements cog.Builder<sandbox.LineStyle> {
+    protected readonly internal: sandbox.LineStyle;
+
+    constructor() {
+        this.internal = sandbox.defaultLineStyle();
+    }
+
+    // Creates a builder wrapping an existing LineStyle.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: sandbox.LineStyle): LineStyleBuilder {
+        const builder: LineStyleBuilder = Object.create(LineStyleBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.LineStyle {
+        return this.internal;
+    }
+
+    fill(fill: string): this {
+        this.internal.fill = fill;
+        return this;
+    }
+
+    dash(dash: number[]): this {
+        this.internal.dash = dash;
+        return this;
+    }
+}
@@ -0,0 +1,49 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class PanelBuilder implements cog.Builder<sandbox.Panel> {
+    protected readonly internal: sandbox.Panel;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = sandbox.defaultPanel();
+    }
+
+    // Creates a builder wrapping an existing Panel.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: sandbox.Panel): PanelBuilder {
+        const builder: PanelBuilder = Object.create(PanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.Panel {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("Panel", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    fill(fill: string): this {
+        this.internal.lineStyle = {
+        fill: fill,
+    };
+        return this;
+    }
+}
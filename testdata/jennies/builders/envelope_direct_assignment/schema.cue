@@ -0,0 +1,11 @@
+package sandbox
+
+#LineStyle: {
+	fill: string
+	dash?: [...int64]
+}
+
+Panel: {
+	title: string
+	lineStyle?: #LineStyle
+}
@@ -24,6 +24,18 @@ func NewSomeNiceBuilderBuilder() *SomeNiceBuilderBuilder {
 	return builder
 }
 
+// NewSomeNiceBuilderBuilderFrom creates a builder wrapping an existing some_pkg.SomeStruct.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewSomeNiceBuilderBuilderFrom(resource some_pkg.SomeStruct) *SomeNiceBuilderBuilder {
+	builder := &SomeNiceBuilderBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *SomeNiceBuilderBuilder) Build() (some_pkg.SomeStruct, error) {
 	var errs cog.BuildErrors
 
@@ -0,0 +1,26 @@
+package builder_pkg
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+	some_pkg "github.com/grafana/cog/generated/some_pkg"
+)
+
+// SomeNiceBuilderConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeNiceBuilderConverter(input some_pkg.SomeStruct) string {
+	calls := []string{
+		`builder_pkg.NewSomeNiceBuilderBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Title) {
+		arg0 := input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
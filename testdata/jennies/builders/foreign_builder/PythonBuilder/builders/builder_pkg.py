@@ -9,6 +9,19 @@ class SomeNiceBuilder(cogbuilder.Builder[some_pkg.SomeStruct]):
     def __init__(self):
         self._internal = some_pkg.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: some_pkg.SomeStruct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing some_pkg.SomeStruct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> some_pkg.SomeStruct:
         return self._internal    
     
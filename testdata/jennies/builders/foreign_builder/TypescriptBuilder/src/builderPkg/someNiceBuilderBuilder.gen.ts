@@ -8,6 +8,18 @@ export class SomeNiceBuilderBuilder implements cog.Builder<somePkg.SomeStruct> {
         this.internal = somePkg.defaultSomeStruct();
     }
 
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: somePkg.SomeStruct): SomeNiceBuilderBuilder {
+        const builder: SomeNiceBuilderBuilder = Object.create(SomeNiceBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): somePkg.SomeStruct {
         return this.internal;
     }
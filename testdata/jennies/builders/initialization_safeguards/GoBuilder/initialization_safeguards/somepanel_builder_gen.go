@@ -23,6 +23,18 @@ func NewSomePanelBuilder() *SomePanelBuilder {
 	return builder
 }
 
+// NewSomePanelBuilderFrom creates a builder wrapping an existing SomePanel.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewSomePanelBuilderFrom(resource SomePanel) *SomePanelBuilder {
+	builder := &SomePanelBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *SomePanelBuilder) Build() (SomePanel, error) {
 	var errs cog.BuildErrors
 
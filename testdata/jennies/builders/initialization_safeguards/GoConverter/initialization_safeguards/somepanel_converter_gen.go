@@ -0,0 +1,35 @@
+package initialization_safeguards
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomePanelConverter accepts a `SomePanel` object and generates the Go code to build this object using builders.
+func SomePanelConverter(input SomePanel) string {
+	calls := []string{
+		`initialization_safeguards.NewSomePanelBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Title) {
+		arg0 := input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.Options != nil && !cog.IsZero(input.Options.Legend.Show) {
+		arg0 := input.Options.Legend.Show
+		buffer.WriteString(`ShowLegend(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
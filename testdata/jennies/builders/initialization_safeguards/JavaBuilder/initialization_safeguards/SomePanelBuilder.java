@@ -0,0 +1,34 @@
+package initialization_safeguards;
+
+
+public class SomePanelBuilder implements cog.Builder<SomePanel> {
+    protected final SomePanel internal;
+
+    public SomePanelBuilder() {
+        this.internal = new SomePanel();
+        this.applyDefaults();
+    }
+
+    public SomePanelBuilder title(String title) {
+        this.internal.title = title;
+        return this;
+    }
+
+    public SomePanelBuilder showLegend(unknown show) {
+        if (this.internal.options == null) {
+            this.internal.options = new Options();
+        }
+        if (this.internal.options.legend == null) {
+            this.internal.options.legend = new LegendOptions();
+        }
+        this.internal.options.legend.show = show;
+        return this;
+    }
+
+    public SomePanel build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
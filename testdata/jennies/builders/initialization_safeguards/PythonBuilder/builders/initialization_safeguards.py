@@ -9,6 +9,19 @@ class SomePanel(cogbuilder.Builder[initialization_safeguards.SomePanel]):
     def __init__(self):
         self._internal = initialization_safeguards.SomePanel()
 
+    @classmethod
+    def from_object(cls, resource: initialization_safeguards.SomePanel) -> typing.Self:
+        """
+        Creates a builder wrapping an existing initialization_safeguards.SomePanel.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> initialization_safeguards.SomePanel:
         return self._internal    
     
@@ -8,6 +8,18 @@ export class SomePanelBuilder implements cog.Builder<initializationSafeguards.So
         this.internal = initializationSafeguards.defaultSomePanel();
     }
 
+    // Creates a builder wrapping an existing SomePanel.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: initializationSafeguards.SomePanel): SomePanelBuilder {
+        const builder: SomePanelBuilder = Object.create(SomePanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): initializationSafeguards.SomePanel {
         return this.internal;
     }
@@ -0,0 +1,55 @@
+import * as cog from '../cog';
+import * as initializationSafeguards from '../initializationSafeguards';
+
+export class SomePanelBuilder implements cog.Builder<initializationSafeguards.SomePanel> {
+    protected readonly internal: initializationSafeguards.SomePanel;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = initializationSafeguards.defaultSomePanel();
+    }
+
+    // Creates a builder wrapping an existing SomePanel.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: initializationSafeguards.SomePanel): SomePanelBuilder {
+        const builder: SomePanelBuilder = Object.create(SomePanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): initializationSafeguards.SomePanel {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomePanel", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    showLegend(show: boolean): this {
+        if (!this.internal.options) {
+            this.internal.options = initializationSafeguards.defaultOptions();
+        }
+        if (!this.internal.options.legend) {
+            this.internal.options.legend = {
+	show: true,
+};
+        }
+        this.internal.options.legend.show = show;
+        return this;
+    }
+}
@@ -0,0 +1,14 @@
+package known_any
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructConverter(input SomeStruct) string {
+	calls := []string{
+		`known_any.NewSomeStructBuilder()`,
+	}
+
+	return strings.Join(calls, ".\n")
+}
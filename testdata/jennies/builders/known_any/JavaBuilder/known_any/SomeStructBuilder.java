@@ -0,0 +1,26 @@
+package known_any;
+
+
+public class SomeStructBuilder implements cog.Builder<SomeStruct> {
+    protected final SomeStruct internal;
+
+    public SomeStructBuilder() {
+        this.internal = new SomeStruct();
+        this.applyDefaults();
+    }
+
+    public SomeStructBuilder title(String title) {
+        if (this.internal.config == null) {
+            this.internal.config = new Config();
+        }
+        ((Config) this.internal.config).title = title;
+        return this;
+    }
+
+    public SomeStruct build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -9,6 +9,19 @@ class SomeStruct(cogbuilder.Builder[known_any.SomeStruct]):
     def __init__(self):
         self._internal = known_any.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: known_any.SomeStruct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing known_any.SomeStruct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> known_any.SomeStruct:
         return self._internal    
     
@@ -0,0 +1,25 @@
+package nullable_map_assignment
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructConverter(input SomeStruct) string {
+	calls := []string{
+		`nullable_map_assignment.NewSomeStructBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if len(input.Config) != 0 {
+		arg0 := input.Config
+		buffer.WriteString(`Config(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -9,6 +9,19 @@ class SomeStruct(cogbuilder.Builder[nullable_map_assignment.SomeStruct]):
     def __init__(self):
         self._internal = nullable_map_assignment.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: nullable_map_assignment.SomeStruct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing nullable_map_assignment.SomeStruct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> nullable_map_assignment.SomeStruct:
         return self._internal    
     
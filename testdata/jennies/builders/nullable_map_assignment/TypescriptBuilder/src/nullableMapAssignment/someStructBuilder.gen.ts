@@ -8,6 +8,18 @@ export class SomeStructBuilder implements cog.Builder<nullableMapAssignment.Some
         this.internal = nullableMapAssignment.defaultSomeStruct();
     }
 
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: nullableMapAssignment.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): nullableMapAssignment.SomeStruct {
         return this.internal;
     }
@@ -0,0 +1,42 @@
+import * as cog from '../cog';
+import * as nullableMapAssignment from '../nullableMapAssignment';
+
+export class SomeStructBuilder implements cog.Builder<nullableMapAssignment.SomeStruct> {
+    protected readonly internal: nullableMapAssignment.SomeStruct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = nullableMapAssignment.defaultSomeStruct();
+    }
+
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: nullableMapAssignment.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): nullableMapAssignment.SomeStruct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomeStruct", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    config(config: Record<string, string>): this {
+        this.internal.config = config;
+        return this;
+    }
+}
@@ -2,7 +2,7 @@ package builderpkg
 
 import (
 	cog "github.com/grafana/cog/generated/cog"
-	withdashes "github.com/grafana/cog/generated/with-dashes"
+	withdashes "github.com/grafana/cog/generated/withdashes"
 )
 
 var _ cog.Builder[withdashes.SomeStruct] = (*SomeNiceBuilderBuilder)(nil)
@@ -24,6 +24,18 @@ func NewSomeNiceBuilderBuilder() *SomeNiceBuilderBuilder {
 	return builder
 }
 
+// NewSomeNiceBuilderBuilderFrom creates a builder wrapping an existing withdashes.SomeStruct.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewSomeNiceBuilderBuilderFrom(resource withdashes.SomeStruct) *SomeNiceBuilderBuilder {
+	builder := &SomeNiceBuilderBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *SomeNiceBuilderBuilder) Build() (withdashes.SomeStruct, error) {
 	var errs cog.BuildErrors
 
@@ -0,0 +1,26 @@
+package builderpkg
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+	withdashes "github.com/grafana/cog/generated/withdashes"
+)
+
+// SomeNiceBuilderConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeNiceBuilderConverter(input withdashes.SomeStruct) string {
+	calls := []string{
+		`builderpkg.NewSomeNiceBuilderBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Title) {
+		arg0 := input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -0,0 +1,24 @@
+package builder-pkg;
+
+import with-dashes.SomeStruct;
+
+public class SomeNiceBuilderBuilder implements cog.Builder<SomeStruct> {
+    protected final SomeStruct internal;
+
+    public SomeNiceBuilderBuilder() {
+        this.internal = new SomeStruct();
+        this.applyDefaults();
+    }
+
+    public SomeNiceBuilderBuilder title(String title) {
+        this.internal.title = title;
+        return this;
+    }
+
+    public SomeStruct build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -9,6 +9,19 @@ class SomeNiceBuilder(cogbuilder.Builder[with-dashes.SomeStruct]):
     def __init__(self):
         self._internal = with-dashes.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: with-dashes.SomeStruct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing with-dashes.SomeStruct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> with-dashes.SomeStruct:
         return self._internal    
     
@@ -8,6 +8,18 @@ export class SomeNiceBuilderBuilder implements cog.Builder<withDashes.SomeStruct
         this.internal = withDashes.defaultSomeStruct();
     }
 
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: withDashes.SomeStruct): SomeNiceBuilderBuilder {
+        const builder: SomeNiceBuilderBuilder = Object.create(SomeNiceBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): withDashes.SomeStruct {
         return this.internal;
     }
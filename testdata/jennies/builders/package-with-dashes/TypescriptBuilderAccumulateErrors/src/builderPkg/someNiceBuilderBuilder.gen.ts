@@ -0,0 +1,42 @@
+import * as cog from '../cog';
+import * as withDashes from '../withDashes';
+
+export class SomeNiceBuilderBuilder implements cog.Builder<withDashes.SomeStruct> {
+    protected readonly internal: withDashes.SomeStruct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = withDashes.defaultSomeStruct();
+    }
+
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: withDashes.SomeStruct): SomeNiceBuilderBuilder {
+        const builder: SomeNiceBuilderBuilder = Object.create(SomeNiceBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): withDashes.SomeStruct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomeNiceBuilder", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+}
@@ -24,6 +24,18 @@ func NewSomeStructBuilder() *SomeStructBuilder {
 	return builder
 }
 
+// NewSomeStructBuilderFrom creates a builder wrapping an existing SomeStruct.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewSomeStructBuilderFrom(resource SomeStruct) *SomeStructBuilder {
+	builder := &SomeStructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *SomeStructBuilder) Build() (SomeStruct, error) {
 	var errs cog.BuildErrors
 
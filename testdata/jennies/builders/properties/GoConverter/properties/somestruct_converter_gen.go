@@ -0,0 +1,25 @@
+package properties
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructConverter(input SomeStruct) string {
+	calls := []string{
+		`properties.NewSomeStructBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Id) {
+		arg0 := input.Id
+		buffer.WriteString(`Id(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -10,6 +10,21 @@ class SomeStruct(cogbuilder.Builder[properties.SomeStruct]):
     def __init__(self):
         self._internal = properties.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: properties.SomeStruct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing properties.SomeStruct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+        builder.__some_builder_property = ""
+        
+
+        return builder
+
     def build(self) -> properties.SomeStruct:
         return self._internal    
     
@@ -9,6 +9,19 @@ export class SomeStructBuilder implements cog.Builder<properties.SomeStruct> {
         this.internal = properties.defaultSomeStruct();
     }
 
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: properties.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            someBuilderProperty: "",
+        });
+
+        return builder;
+    }
+
     build(): properties.SomeStruct {
         return this.internal;
     }
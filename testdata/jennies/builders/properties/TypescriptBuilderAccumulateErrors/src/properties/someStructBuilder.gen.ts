@@ -0,0 +1,44 @@
+import * as cog from '../cog';
+import * as properties from '../properties';
+
+export class SomeStructBuilder implements cog.Builder<properties.SomeStruct> {
+    protected readonly internal: properties.SomeStruct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+    private someBuilderProperty: string = "";
+
+    constructor() {
+        this.internal = properties.defaultSomeStruct();
+    }
+
+    // Creates a builder wrapping an existing SomeStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: properties.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+            someBuilderProperty: "",
+        });
+
+        return builder;
+    }
+
+    build(): properties.SomeStruct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("SomeStruct", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    id(id: number): this {
+        this.internal.id = id;
+        return this;
+    }
+}
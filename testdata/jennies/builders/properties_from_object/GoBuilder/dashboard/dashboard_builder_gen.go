@@ -0,0 +1,119 @@
+package dashboard
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[Dashboard] = (*DashboardBuilder)(nil)
+
+type DashboardBuilder struct {
+    internal *Dashboard
+    errors map[string]cog.BuildErrors
+    currentY uint32
+    currentX uint32
+    lastPanelHeight uint32
+}
+
+func NewDashboardBuilder() *DashboardBuilder {
+	resource := &Dashboard{}
+	builder := &DashboardBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewDashboardBuilderFrom creates a builder wrapping an existing Dashboard.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewDashboardBuilderFrom(resource Dashboard) *DashboardBuilder {
+	builder := &DashboardBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	// Resume the panels layout after the existing ones
+	resumeAfter := func(gridPos *GridPos) {
+		if gridPos == nil {
+			return
+		}
+
+		// A panel starting a new line resets the height of the current one
+		if gridPos.Y != builder.currentY {
+			builder.lastPanelHeight = 0
+		}
+
+		builder.currentX = gridPos.X + gridPos.W
+		builder.currentY = gridPos.Y
+		builder.lastPanelHeight = max(builder.lastPanelHeight, gridPos.H)
+
+		// Check for grid width overflow?
+		if builder.currentX >= 24 {
+			builder.currentX = 0
+			builder.currentY += builder.lastPanelHeight
+			builder.lastPanelHeight = 0
+		}
+	}
+
+	for _, panelOrRow := range resource.Panels {
+		if panelOrRow.RowPanel != nil {
+			builder.currentX = 0
+			builder.lastPanelHeight = 0
+			if panelOrRow.RowPanel.GridPos != nil {
+				builder.currentY = panelOrRow.RowPanel.GridPos.Y + 1
+			}
+
+			for _, panel := range panelOrRow.RowPanel.Panels {
+				resumeAfter(panel.GridPos)
+			}
+			continue
+		}
+
+		if panelOrRow.Panel != nil {
+			resumeAfter(panelOrRow.Panel.GridPos)
+		}
+	}
+
+	return builder
+}
+
+func (builder *DashboardBuilder) Build() (Dashboard, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("Dashboard", err)...)
+	}
+
+	if len(errs) != 0 {
+		return Dashboard{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *DashboardBuilder) Title(title string) *DashboardBuilder {
+    builder.internal.Title = title
+
+    return builder
+}
+
+func (builder *DashboardBuilder) Panels(panels []cog.Builder[PanelOrRowPanel]) *DashboardBuilder {
+        panelsResources := make([]PanelOrRowPanel, 0, len(panels))
+        for _, r1 := range panels {
+                panelsDepth1, err := r1.Build()
+                if err != nil {
+                    builder.errors["panels"] = err.(cog.BuildErrors)
+                    return builder
+                }
+                panelsResources = append(panelsResources, panelsDepth1)
+        }
+    builder.internal.Panels = panelsResources
+
+    return builder
+}
+
+func (builder *DashboardBuilder) applyDefaults() {
+}
@@ -0,0 +1,71 @@
+package dashboard
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[Panel] = (*PanelBuilder)(nil)
+
+type PanelBuilder struct {
+    internal *Panel
+    errors map[string]cog.BuildErrors
+}
+
+func NewPanelBuilder() *PanelBuilder {
+	resource := &Panel{}
+	builder := &PanelBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewPanelBuilderFrom creates a builder wrapping an existing Panel.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewPanelBuilderFrom(resource Panel) *PanelBuilder {
+	builder := &PanelBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *PanelBuilder) Build() (Panel, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("Panel", err)...)
+	}
+
+	if len(errs) != 0 {
+		return Panel{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *PanelBuilder) Type(typeArg string) *PanelBuilder {
+    builder.internal.Type = typeArg
+
+    return builder
+}
+
+func (builder *PanelBuilder) Title(title string) *PanelBuilder {
+    builder.internal.Title = &title
+
+    return builder
+}
+
+func (builder *PanelBuilder) GridPos(gridPos GridPos) *PanelBuilder {
+    builder.internal.GridPos = &gridPos
+
+    return builder
+}
+
+func (builder *PanelBuilder) applyDefaults() {
+}
@@ -0,0 +1,75 @@
+package dashboard
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[PanelOrRowPanel] = (*PanelOrRowPanelBuilder)(nil)
+
+type PanelOrRowPanelBuilder struct {
+    internal *PanelOrRowPanel
+    errors map[string]cog.BuildErrors
+}
+
+func NewPanelOrRowPanelBuilder() *PanelOrRowPanelBuilder {
+	resource := &PanelOrRowPanel{}
+	builder := &PanelOrRowPanelBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewPanelOrRowPanelBuilderFrom creates a builder wrapping an existing PanelOrRowPanel.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewPanelOrRowPanelBuilderFrom(resource PanelOrRowPanel) *PanelOrRowPanelBuilder {
+	builder := &PanelOrRowPanelBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *PanelOrRowPanelBuilder) Build() (PanelOrRowPanel, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("PanelOrRowPanel", err)...)
+	}
+
+	if len(errs) != 0 {
+		return PanelOrRowPanel{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *PanelOrRowPanelBuilder) Panel(panel cog.Builder[Panel]) *PanelOrRowPanelBuilder {
+    panelResource, err := panel.Build()
+    if err != nil {
+        builder.errors["Panel"] = err.(cog.BuildErrors)
+        return builder
+    }
+    builder.internal.Panel = &panelResource
+
+    return builder
+}
+
+func (builder *PanelOrRowPanelBuilder) RowPanel(rowPanel cog.Builder[RowPanel]) *PanelOrRowPanelBuilder {
+    rowPanelResource, err := rowPanel.Build()
+    if err != nil {
+        builder.errors["RowPanel"] = err.(cog.BuildErrors)
+        return builder
+    }
+    builder.internal.RowPanel = &rowPanelResource
+
+    return builder
+}
+
+func (builder *PanelOrRowPanelBuilder) applyDefaults() {
+}
@@ -0,0 +1,82 @@
+package dashboard
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[RowPanel] = (*RowPanelBuilder)(nil)
+
+type RowPanelBuilder struct {
+    internal *RowPanel
+    errors map[string]cog.BuildErrors
+}
+
+func NewRowPanelBuilder() *RowPanelBuilder {
+	resource := &RowPanel{}
+	builder := &RowPanelBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+    builder.internal.Type = "row"
+
+	return builder
+}
+
+// NewRowPanelBuilderFrom creates a builder wrapping an existing RowPanel.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewRowPanelBuilderFrom(resource RowPanel) *RowPanelBuilder {
+	builder := &RowPanelBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *RowPanelBuilder) Build() (RowPanel, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("RowPanel", err)...)
+	}
+
+	if len(errs) != 0 {
+		return RowPanel{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *RowPanelBuilder) Collapsed(collapsed bool) *RowPanelBuilder {
+    builder.internal.Collapsed = collapsed
+
+    return builder
+}
+
+func (builder *RowPanelBuilder) GridPos(gridPos GridPos) *RowPanelBuilder {
+    builder.internal.GridPos = &gridPos
+
+    return builder
+}
+
+func (builder *RowPanelBuilder) Panels(panels []cog.Builder[Panel]) *RowPanelBuilder {
+        panelsResources := make([]Panel, 0, len(panels))
+        for _, r1 := range panels {
+                panelsDepth1, err := r1.Build()
+                if err != nil {
+                    builder.errors["panels"] = err.(cog.BuildErrors)
+                    return builder
+                }
+                panelsResources = append(panelsResources, panelsDepth1)
+        }
+    builder.internal.Panels = panelsResources
+
+    return builder
+}
+
+func (builder *RowPanelBuilder) applyDefaults() {
+    builder.Collapsed(false)
+}
@@ -0,0 +1,35 @@
+package dashboard
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// DashboardConverter accepts a `Dashboard` object and generates the Go code to build this object using builders.
+func DashboardConverter(input Dashboard) string {
+	calls := []string{
+		`dashboard.NewDashboardBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Title) {
+		arg0 := input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if len(input.Panels) != 0 {
+		arg0 := input.Panels
+		buffer.WriteString(`Panels(`)
+		buffer.WriteString(cog.ConvertArray(arg0, "cog.Builder[dashboard.PanelOrRowPanel]", PanelOrRowPanelConverter))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
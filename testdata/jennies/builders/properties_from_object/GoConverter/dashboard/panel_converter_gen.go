@@ -0,0 +1,45 @@
+package dashboard
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// PanelConverter accepts a `Panel` object and generates the Go code to build this object using builders.
+func PanelConverter(input Panel) string {
+	calls := []string{
+		`dashboard.NewPanelBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Type) {
+		arg0 := input.Type
+		buffer.WriteString(`Type(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.Title != nil {
+		arg0 := *input.Title
+		buffer.WriteString(`Title(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.GridPos != nil {
+		arg0 := *input.GridPos
+		buffer.WriteString(`GridPos(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
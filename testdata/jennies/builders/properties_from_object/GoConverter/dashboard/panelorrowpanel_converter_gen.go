@@ -0,0 +1,35 @@
+package dashboard
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// PanelOrRowPanelConverter accepts a `PanelOrRowPanel` object and generates the Go code to build this object using builders.
+func PanelOrRowPanelConverter(input PanelOrRowPanel) string {
+	calls := []string{
+		`dashboard.NewPanelOrRowPanelBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if input.Panel != nil {
+		arg0 := *input.Panel
+		buffer.WriteString(`Panel(`)
+		buffer.WriteString(PanelConverter(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.RowPanel != nil {
+		arg0 := *input.RowPanel
+		buffer.WriteString(`RowPanel(`)
+		buffer.WriteString(RowPanelConverter(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
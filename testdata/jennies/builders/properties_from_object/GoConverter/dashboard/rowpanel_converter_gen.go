@@ -0,0 +1,45 @@
+package dashboard
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// RowPanelConverter accepts a `RowPanel` object and generates the Go code to build this object using builders.
+func RowPanelConverter(input RowPanel) string {
+	calls := []string{
+		`dashboard.NewRowPanelBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Collapsed) {
+		arg0 := input.Collapsed
+		buffer.WriteString(`Collapsed(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if input.GridPos != nil {
+		arg0 := *input.GridPos
+		buffer.WriteString(`GridPos(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if len(input.Panels) != 0 {
+		arg0 := input.Panels
+		buffer.WriteString(`Panels(`)
+		buffer.WriteString(cog.ConvertArray(arg0, "cog.Builder[dashboard.Panel]", PanelConverter))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
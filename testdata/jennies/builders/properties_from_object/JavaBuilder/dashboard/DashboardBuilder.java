@@ -0,0 +1,37 @@
+package dashboard;
+
+import java.util.List;
+import java.util.LinkedList;
+
+public class DashboardBuilder implements cog.Builder<Dashboard> {
+    protected final Dashboard internal;
+    private Integer currentY = 0;
+    private Integer currentX = 0;
+    private Integer lastPanelHeight = 0;
+
+    public DashboardBuilder() {
+        this.internal = new Dashboard();
+        this.applyDefaults();
+    }
+
+    public DashboardBuilder title(String title) {
+        this.internal.title = title;
+        return this;
+    }
+
+    public DashboardBuilder panels(List<cog.Builder<PanelOrRowPanel>> panels) {
+        List<PanelOrRowPanel> panelsResources = new LinkedList<>();
+        for (cog.Builder<PanelOrRowPanel> r1 : panels) {
+            panelsResources.add(r1.build());
+        }
+        this.internal.panels = panelsResources;
+        return this;
+    }
+
+    public Dashboard build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -0,0 +1,33 @@
+package dashboard;
+
+
+public class PanelBuilder implements cog.Builder<Panel> {
+    protected final Panel internal;
+
+    public PanelBuilder() {
+        this.internal = new Panel();
+        this.applyDefaults();
+    }
+
+    public PanelBuilder type(String type) {
+        this.internal.type = type;
+        return this;
+    }
+
+    public PanelBuilder title(String title) {
+        this.internal.title = title;
+        return this;
+    }
+
+    public PanelBuilder gridPos(GridPos gridPos) {
+        this.internal.gridPos = gridPos;
+        return this;
+    }
+
+    public Panel build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
@@ -0,0 +1,30 @@
+package dashboard;
+
+
+public class PanelOrRowPanelBuilder implements cog.Builder<PanelOrRowPanel> {
+    protected final PanelOrRowPanel internal;
+
+    public PanelOrRowPanelBuilder() {
+        this.internal = new PanelOrRowPanel();
+        this.applyDefaults();
+    }
+
+    public PanelOrRowPanelBuilder panel(cog.Builder<Panel> panel) {
+        Panel panelResource = panel.build();
+        this.internal.Panel = panelResource;
+        return this;
+    }
+
+    public PanelOrRowPanelBuilder rowPanel(cog.Builder<RowPanel> rowPanel) {
+        RowPanel rowPanelResource = rowPanel.build();
+        this.internal.RowPanel = rowPanelResource;
+        return this;
+    }
+
+    public PanelOrRowPanel build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+    }
+}
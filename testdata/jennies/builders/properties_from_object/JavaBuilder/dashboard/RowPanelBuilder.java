@@ -0,0 +1,41 @@
+package dashboard;
+
+import java.util.List;
+import java.util.LinkedList;
+
+public class RowPanelBuilder implements cog.Builder<RowPanel> {
+    protected final RowPanel internal;
+
+    public RowPanelBuilder() {
+        this.internal = new RowPanel();
+        this.applyDefaults();
+        this.internal.type = "row";
+    }
+
+    public RowPanelBuilder collapsed(Boolean collapsed) {
+        this.internal.collapsed = collapsed;
+        return this;
+    }
+
+    public RowPanelBuilder gridPos(GridPos gridPos) {
+        this.internal.gridPos = gridPos;
+        return this;
+    }
+
+    public RowPanelBuilder panels(List<cog.Builder<Panel>> panels) {
+        List<Panel> panelsResources = new LinkedList<>();
+        for (cog.Builder<Panel> r1 : panels) {
+            panelsResources.add(r1.build());
+        }
+        this.internal.panels = panelsResources;
+        return this;
+    }
+
+    public RowPanel build() {
+        return this.internal;
+    }
+
+    private void applyDefaults() {
+        this.collapsed(false);
+    }
+}
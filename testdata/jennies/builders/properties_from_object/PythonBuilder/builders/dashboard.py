@@ -0,0 +1,189 @@
+import typing
+from ..cog import builder as cogbuilder
+from ..models import dashboard
+
+
+class Panel(cogbuilder.Builder[dashboard.Panel]):    
+    _internal: dashboard.Panel
+
+    def __init__(self):
+        self._internal = dashboard.Panel()
+
+    @classmethod
+    def from_object(cls, resource: dashboard.Panel) -> typing.Self:
+        """
+        Creates a builder wrapping an existing dashboard.Panel.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> dashboard.Panel:
+        return self._internal    
+    
+    def type_val(self, type_val: str) -> typing.Self:        
+        self._internal.type_val = type_val
+    
+        return self
+    
+    def title(self, title: str) -> typing.Self:        
+        self._internal.title = title
+    
+        return self
+    
+    def grid_pos(self, grid_pos: dashboard.GridPos) -> typing.Self:        
+        self._internal.grid_pos = grid_pos
+    
+        return self
+    
+
+class RowPanel(cogbuilder.Builder[dashboard.RowPanel]):    
+    _internal: dashboard.RowPanel
+
+    def __init__(self):
+        self._internal = dashboard.RowPanel()        
+        self._internal.type_val = "row"
+
+    @classmethod
+    def from_object(cls, resource: dashboard.RowPanel) -> typing.Self:
+        """
+        Creates a builder wrapping an existing dashboard.RowPanel.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> dashboard.RowPanel:
+        return self._internal    
+    
+    def collapsed(self, collapsed: bool) -> typing.Self:        
+        self._internal.collapsed = collapsed
+    
+        return self
+    
+    def grid_pos(self, grid_pos: dashboard.GridPos) -> typing.Self:        
+        self._internal.grid_pos = grid_pos
+    
+        return self
+    
+    def panels(self, panels: list[cogbuilder.Builder[dashboard.Panel]]) -> typing.Self:        
+        panels_resources = [r1.build() for r1 in panels]
+        self._internal.panels = panels_resources
+    
+        return self
+    
+
+class Dashboard(cogbuilder.Builder[dashboard.Dashboard]):    
+    _internal: dashboard.Dashboard
+    __current_y: int = 0
+    __current_x: int = 0
+    __last_panel_height: int = 0
+
+    def __init__(self):
+        self._internal = dashboard.Dashboard()
+
+    @classmethod
+    def from_object(cls, resource: dashboard.Dashboard) -> typing.Self:
+        """
+        Creates a builder wrapping an existing dashboard.Dashboard.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+        builder.__current_y = 0
+        builder.__current_x = 0
+        builder.__last_panel_height = 0
+        
+        # Resume the panels layout after the existing ones
+        def resume_after(grid_pos: typing.Optional[dashboard.GridPos]):
+            if grid_pos is None:
+                return
+        
+            # A panel starting a new line resets the height of the current one
+            if grid_pos.y != builder.__current_y:
+                builder.__last_panel_height = 0
+        
+            builder.__current_x = grid_pos.x + grid_pos.w
+            builder.__current_y = grid_pos.y
+            builder.__last_panel_height = max(builder.__last_panel_height, grid_pos.h)
+        
+            # Check for grid width overflow?
+            if builder.__current_x >= 24:
+                builder.__current_x = 0
+                builder.__current_y += builder.__last_panel_height
+                builder.__last_panel_height = 0
+        
+        for panel_or_row in resource.panels or []:
+            if isinstance(panel_or_row, dashboard.RowPanel):
+                builder.__current_x = 0
+                builder.__last_panel_height = 0
+                if panel_or_row.grid_pos is not None:
+                    builder.__current_y = panel_or_row.grid_pos.y + 1
+        
+                for panel in panel_or_row.panels:
+                    resume_after(panel.grid_pos)
+                continue
+        
+            resume_after(panel_or_row.grid_pos)
+
+        return builder
+
+    def build(self) -> dashboard.Dashboard:
+        return self._internal    
+    
+    def title(self, title: str) -> typing.Self:        
+        self._internal.title = title
+    
+        return self
+    
+    def panels(self, panels: list[cogbuilder.Builder[dashboard.PanelOrRowPanel]]) -> typing.Self:        
+        panels_resources = [r1.build() for r1 in panels]
+        self._internal.panels = panels_resources
+    
+        return self
+    
+
+class PanelOrRowPanel(cogbuilder.Builder[dashboard.PanelOrRowPanel]):    
+    _internal: dashboard.PanelOrRowPanel
+
+    def __init__(self):
+        self._internal = dashboard.PanelOrRowPanel()
+
+    @classmethod
+    def from_object(cls, resource: dashboard.PanelOrRowPanel) -> typing.Self:
+        """
+        Creates a builder wrapping an existing dashboard.PanelOrRowPanel.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> dashboard.PanelOrRowPanel:
+        return self._internal    
+    
+    def panel(self, panel: cogbuilder.Builder[dashboard.Panel]) -> typing.Self:        
+        panel_resource = panel.build()
+        self._internal.panel = panel_resource
+    
+        return self
+    
+    def row_panel(self, row_panel: cogbuilder.Builder[dashboard.RowPanel]) -> typing.Self:        
+        row_panel_resource = row_panel.build()
+        self._internal.row_panel = row_panel_resource
+    
+        return self
+    
\ No newline at end of file
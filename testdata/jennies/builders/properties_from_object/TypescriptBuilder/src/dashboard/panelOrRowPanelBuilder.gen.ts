@@ -0,0 +1,38 @@
+import * as cog from '../cog';
+import * as dashboard from '../dashboard';
+
+export class PanelOrRowPanelBuilder implements cog.Builder<dashboard.PanelOrRowPanel> {
+    protected readonly internal: dashboard.PanelOrRowPanel;
+
+    constructor() {
+        this.internal = dashboard.defaultPanelOrRowPanel();
+    }
+
+    // Creates a builder wrapping an existing PanelOrRowPanel.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: dashboard.PanelOrRowPanel): PanelOrRowPanelBuilder {
+        const builder: PanelOrRowPanelBuilder = Object.create(PanelOrRowPanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
+    build(): dashboard.PanelOrRowPanel {
+        return this.internal;
+    }
+
+    Panel(Panel: cog.Builder<dashboard.Panel>): this {
+        const PanelResource = Panel.build();
+        this.internal.Panel = PanelResource;
+        return this;
+    }
+
+    RowPanel(RowPanel: cog.Builder<dashboard.RowPanel>): this {
+        const RowPanelResource = RowPanel.build();
+        this.internal.RowPanel = RowPanelResource;
+        return this;
+    }
+}
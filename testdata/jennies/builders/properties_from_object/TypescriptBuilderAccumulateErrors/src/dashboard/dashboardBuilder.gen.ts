@@ -0,0 +1,100 @@
+import * as cog from '../cog';
+import * as dashboard from '../dashboard';
+
+export class DashboardBuilder implements cog.Builder<dashboard.Dashboard> {
+    protected readonly internal: dashboard.Dashboard;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+    private currentY: number = 0;
+    private currentX: number = 0;
+    private lastPanelHeight: number = 0;
+
+    constructor() {
+        this.internal = dashboard.defaultDashboard();
+    }
+
+    // Creates a builder wrapping an existing Dashboard.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: dashboard.Dashboard): DashboardBuilder {
+        const builder: DashboardBuilder = Object.create(DashboardBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+            currentY: 0,
+            currentX: 0,
+            lastPanelHeight: 0,
+        });
+
+        // Resume the panels layout after the existing ones
+        const resumeAfter = (gridPos?: dashboard.GridPos) => {
+            if (!gridPos) {
+                return;
+            }
+
+            // A panel starting a new line resets the height of the current one
+            if (gridPos.y !== builder.currentY) {
+                builder.lastPanelHeight = 0;
+            }
+
+            builder.currentX = gridPos.x + gridPos.w;
+            builder.currentY = gridPos.y;
+            builder.lastPanelHeight = Math.max(builder.lastPanelHeight, gridPos.h);
+
+            // Check for grid width overflow?
+            if (builder.currentX >= 24) {
+                builder.currentX = 0;
+                builder.currentY += builder.lastPanelHeight;
+                builder.lastPanelHeight = 0;
+            }
+        };
+
+        (resource.panels ?? []).forEach(panelOrRow => {
+            if (panelOrRow.type === "row") {
+                const rowPanel = panelOrRow as dashboard.RowPanel;
+
+                builder.currentX = 0;
+                builder.lastPanelHeight = 0;
+                if (rowPanel.gridPos) {
+                    builder.currentY = rowPanel.gridPos.y + 1;
+                }
+
+                rowPanel.panels.forEach(panel => resumeAfter(panel.gridPos));
+                return;
+            }
+
+            resumeAfter((panelOrRow as dashboard.Panel).gridPos);
+        });
+
+        return builder;
+    }
+
+    build(): dashboard.Dashboard {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("Dashboard", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    panels(panels: cog.Builder<dashboard.PanelOrRowPanel>[]): this {
+        let panelsResources;
+        try {
+            panelsResources = panels.map(builder1 => builder1.build());
+        } catch (err) {
+            this.errors["panels"] = cog.makeBuildErrors("panels", err);
+            return this;
+        }
+        this.internal.panels = panelsResources;
+        return this;
+    }
+}
@@ -0,0 +1,52 @@
+import * as cog from '../cog';
+import * as dashboard from '../dashboard';
+
+export class PanelBuilder implements cog.Builder<dashboard.Panel> {
+    protected readonly internal: dashboard.Panel;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = dashboard.defaultPanel();
+    }
+
+    // Creates a builder wrapping an existing Panel.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: dashboard.Panel): PanelBuilder {
+        const builder: PanelBuilder = Object.create(PanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): dashboard.Panel {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("Panel", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    type(type: string): this {
+        this.internal.type = type;
+        return this;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    gridPos(gridPos: dashboard.GridPos): this {
+        this.internal.gridPos = gridPos;
+        return this;
+    }
+}
@@ -0,0 +1,61 @@
+import * as cog from '../cog';
+import * as dashboard from '../dashboard';
+
+export class PanelOrRowPanelBuilder implements cog.Builder<dashboard.PanelOrRowPanel> {
+    protected readonly internal: dashboard.PanelOrRowPanel;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = dashboard.defaultPanelOrRowPanel();
+    }
+
+    // Creates a builder wrapping an existing PanelOrRowPanel.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: dashboard.PanelOrRowPanel): PanelOrRowPanelBuilder {
+        const builder: PanelOrRowPanelBuilder = Object.create(PanelOrRowPanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): dashboard.PanelOrRowPanel {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("PanelOrRowPanel", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    Panel(Panel: cog.Builder<dashboard.Panel>): this {
+        let PanelResource;
+        try {
+            PanelResource = Panel.build();
+        } catch (err) {
+            this.errors["Panel"] = cog.makeBuildErrors("Panel", err);
+            return this;
+        }
+        this.internal.Panel = PanelResource;
+        return this;
+    }
+
+    RowPanel(RowPanel: cog.Builder<dashboard.RowPanel>): this {
+        let RowPanelResource;
+        try {
+            RowPanelResource = RowPanel.build();
+        } catch (err) {
+            this.errors["RowPanel"] = cog.makeBuildErrors("RowPanel", err);
+            return this;
+        }
+        this.internal.RowPanel = RowPanelResource;
+        return this;
+    }
+}
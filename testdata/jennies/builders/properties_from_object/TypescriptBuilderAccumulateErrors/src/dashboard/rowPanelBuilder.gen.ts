@@ -0,0 +1,60 @@
+import * as cog from '../cog';
+import * as dashboard from '../dashboard';
+
+export class RowPanelBuilder implements cog.Builder<dashboard.RowPanel> {
+    protected readonly internal: dashboard.RowPanel;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = dashboard.defaultRowPanel();
+        this.internal.type = "row";
+    }
+
+    // Creates a builder wrapping an existing RowPanel.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: dashboard.RowPanel): RowPanelBuilder {
+        const builder: RowPanelBuilder = Object.create(RowPanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): dashboard.RowPanel {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("RowPanel", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    collapsed(collapsed: boolean): this {
+        this.internal.collapsed = collapsed;
+        return this;
+    }
+
+    gridPos(gridPos: dashboard.GridPos): this {
+        this.internal.gridPos = gridPos;
+        return this;
+    }
+
+    panels(panels: cog.Builder<dashboard.Panel>[]): this {
+        let panelsResources;
+        try {
+            panelsResources = panels.map(builder1 => builder1.build());
+        } catch (err) {
+            this.errors["panels"] = cog.makeBuildErrors("panels", err);
+            return this;
+        }
+        this.internal.panels = panelsResources;
+        return this;
+    }
+}
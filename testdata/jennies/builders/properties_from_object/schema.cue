@@ -0,0 +1,26 @@
+package dashboard
+
+#GridPos: {
+	h: uint32 | *9
+	w: uint32 | *12
+	x: uint32 | *0
+	y: uint32 | *0
+}
+
+#Panel: {
+	type:     string
+	title?:   string
+	gridPos?: #GridPos
+}
+
+#RowPanel: {
+	type:      "row"
+	collapsed: bool | *false
+	gridPos?:  #GridPos
+	panels: [...#Panel]
+}
+
+Dashboard: {
+	title: string
+	panels?: [...(#Panel | #RowPanel)]
+}
@@ -24,6 +24,18 @@ func NewPersonBuilder() *PersonBuilder {
 	return builder
 }
 
+// NewPersonBuilderFrom creates a builder wrapping an existing Person.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewPersonBuilderFrom(resource Person) *PersonBuilder {
+	builder := &PersonBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *PersonBuilder) Build() (Person, error) {
 	var errs cog.BuildErrors
 
@@ -0,0 +1,25 @@
+package some_pkg
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// PersonConverter accepts a `Person` object and generates the Go code to build this object using builders.
+func PersonConverter(input Person) string {
+	calls := []string{
+		`some_pkg.NewPersonBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.Name) {
+		arg0 := input.Name
+		buffer.WriteString(`Name(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
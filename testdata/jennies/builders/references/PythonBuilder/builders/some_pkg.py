@@ -10,6 +10,19 @@ class Person(cogbuilder.Builder[some_pkg.Person]):
     def __init__(self):
         self._internal = some_pkg.Person()
 
+    @classmethod
+    def from_object(cls, resource: some_pkg.Person) -> typing.Self:
+        """
+        Creates a builder wrapping an existing some_pkg.Person.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> some_pkg.Person:
         return self._internal    
     
@@ -9,6 +9,18 @@ export class PersonBuilder implements cog.Builder<somePkg.Person> {
         this.internal = somePkg.defaultPerson();
     }
 
+    // Creates a builder wrapping an existing Person.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: somePkg.Person): PersonBuilder {
+        const builder: PersonBuilder = Object.create(PersonBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): somePkg.Person {
         return this.internal;
     }
@@ -0,0 +1,43 @@
+import * as cog from '../cog';
+import * as somePkg from '../somePkg';
+import * as otherPkg from '../otherPkg';
+
+export class PersonBuilder implements cog.Builder<somePkg.Person> {
+    protected readonly internal: somePkg.Person;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = somePkg.defaultPerson();
+    }
+
+    // Creates a builder wrapping an existing Person.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: somePkg.Person): PersonBuilder {
+        const builder: PersonBuilder = Object.create(PersonBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): somePkg.Person {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("Person", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    name(name: otherPkg.Name): this {
+        this.internal.name = name;
+        return this;
+    }
+}
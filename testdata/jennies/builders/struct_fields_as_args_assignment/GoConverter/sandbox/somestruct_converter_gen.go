@@ -0,0 +1,34 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructConverter accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructConverter(input SomeStruct) string {
+	calls := []string{
+		`sandbox.NewSomeStructBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if (input.Time != nil && !cog.IsZero(input.Time.From) || input.Time != nil && !cog.IsZero(input.Time.To)) {
+		var arg0 string
+		if input.Time != nil && !cog.IsZero(input.Time.From) {
+			arg0 = input.Time.From
+		}
+		var arg1 string
+		if input.Time != nil && !cog.IsZero(input.Time.To) {
+			arg1 = input.Time.To
+		}
+		buffer.WriteString(`Time(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(", ")
+		buffer.WriteString(cog.Dump(arg1))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
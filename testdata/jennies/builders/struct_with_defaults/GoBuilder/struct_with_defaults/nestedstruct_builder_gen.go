@@ -23,6 +23,18 @@ func NewNestedStructBuilder() *NestedStructBuilder {
 	return builder
 }
 
+// NewNestedStructBuilderFrom creates a builder wrapping an existing NestedStruct.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewNestedStructBuilderFrom(resource NestedStruct) *NestedStructBuilder {
+	builder := &NestedStructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *NestedStructBuilder) Build() (NestedStruct, error) {
 	var errs cog.BuildErrors
 
@@ -23,6 +23,18 @@ func NewStructBuilder() *StructBuilder {
 	return builder
 }
 
+// NewStructBuilderFrom creates a builder wrapping an existing Struct.
+// Defaults, constructor arguments and initializations are not applied.
+// Builder properties start at their zero value unless a veneer derives them from the resource.
+func NewStructBuilderFrom(resource Struct) *StructBuilder {
+	builder := &StructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *StructBuilder) Build() (Struct, error) {
 	var errs cog.BuildErrors
 
@@ -0,0 +1,35 @@
+package struct_with_defaults
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// NestedStructConverter accepts a `NestedStruct` object and generates the Go code to build this object using builders.
+func NestedStructConverter(input NestedStruct) string {
+	calls := []string{
+		`struct_with_defaults.NewNestedStructBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.StringVal) {
+		arg0 := input.StringVal
+		buffer.WriteString(`StringVal(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.IntVal) {
+		arg0 := input.IntVal
+		buffer.WriteString(`IntVal(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -0,0 +1,65 @@
+package struct_with_defaults
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// StructConverter accepts a `Struct` object and generates the Go code to build this object using builders.
+func StructConverter(input Struct) string {
+	calls := []string{
+		`struct_with_defaults.NewStructBuilder()`,
+	}
+	var buffer strings.Builder
+
+	if !cog.IsZero(input.AllFields) {
+		arg0 := input.AllFields
+		buffer.WriteString(`AllFields(`)
+		buffer.WriteString(NestedStructConverter(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.PartialFields) {
+		arg0 := input.PartialFields
+		buffer.WriteString(`PartialFields(`)
+		buffer.WriteString(NestedStructConverter(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.EmptyFields) {
+		arg0 := input.EmptyFields
+		buffer.WriteString(`EmptyFields(`)
+		buffer.WriteString(NestedStructConverter(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.ComplexField) {
+		arg0 := input.ComplexField
+		buffer.WriteString(`ComplexField(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	if !cog.IsZero(input.PartialComplexField) {
+		arg0 := input.PartialComplexField
+		buffer.WriteString(`PartialComplexField(`)
+		buffer.WriteString(cog.Dump(arg0))
+		buffer.WriteString(")")
+
+		calls = append(calls, buffer.String())
+		buffer.Reset()
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -9,6 +9,19 @@ class NestedStruct(cogbuilder.Builder[struct_with_defaults.NestedStruct]):
     def __init__(self):
         self._internal = struct_with_defaults.NestedStruct()
 
+    @classmethod
+    def from_object(cls, resource: struct_with_defaults.NestedStruct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing struct_with_defaults.NestedStruct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> struct_with_defaults.NestedStruct:
         return self._internal    
     
@@ -29,6 +42,19 @@ class Struct(cogbuilder.Builder[struct_with_defaults.Struct]):
     def __init__(self):
         self._internal = struct_with_defaults.Struct()
 
+    @classmethod
+    def from_object(cls, resource: struct_with_defaults.Struct) -> typing.Self:
+        """
+        Creates a builder wrapping an existing struct_with_defaults.Struct.
+        Defaults, constructor arguments and initializations are not applied.
+        Builder properties start at their default value unless a veneer derives them from the resource.
+        """
+
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> struct_with_defaults.Struct:
         return self._internal    
     
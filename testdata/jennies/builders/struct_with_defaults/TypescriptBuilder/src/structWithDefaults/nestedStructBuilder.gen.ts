@@ -8,6 +8,18 @@ export class NestedStructBuilder implements cog.Builder<structWithDefaults.Neste
         this.internal = structWithDefaults.defaultNestedStruct();
     }
 
+    // Creates a builder wrapping an existing NestedStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: structWithDefaults.NestedStruct): NestedStructBuilder {
+        const builder: NestedStructBuilder = Object.create(NestedStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): structWithDefaults.NestedStruct {
         return this.internal;
     }
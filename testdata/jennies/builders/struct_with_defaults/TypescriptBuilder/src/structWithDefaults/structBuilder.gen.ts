@@ -8,6 +8,18 @@ export class StructBuilder implements cog.Builder<structWithDefaults.Struct> {
         this.internal = structWithDefaults.defaultStruct();
     }
 
+    // Creates a builder wrapping an existing Struct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: structWithDefaults.Struct): StructBuilder {
+        const builder: StructBuilder = Object.create(StructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): structWithDefaults.Struct {
         return this.internal;
     }
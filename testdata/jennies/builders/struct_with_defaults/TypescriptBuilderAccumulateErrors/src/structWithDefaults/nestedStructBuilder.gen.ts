@@ -0,0 +1,47 @@
+import * as cog from '../cog';
+import * as structWithDefaults from '../structWithDefaults';
+
+export class NestedStructBuilder implements cog.Builder<structWithDefaults.NestedStruct> {
+    protected readonly internal: structWithDefaults.NestedStruct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = structWithDefaults.defaultNestedStruct();
+    }
+
+    // Creates a builder wrapping an existing NestedStruct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: structWithDefaults.NestedStruct): NestedStructBuilder {
+        const builder: NestedStructBuilder = Object.create(NestedStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): structWithDefaults.NestedStruct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("NestedStruct", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    stringVal(stringVal: string): this {
+        this.internal.stringVal = stringVal;
+        return this;
+    }
+
+    intVal(intVal: number): this {
+        this.internal.intVal = intVal;
+        return this;
+    }
+}
@@ -0,0 +1,92 @@
+import * as cog from '../cog';
+import * as structWithDefaults from '../structWithDefaults';
+
+export class StructBuilder implements cog.Builder<structWithDefaults.Struct> {
+    protected readonly internal: structWithDefaults.Struct;
+    private readonly errors: { [key: string]: cog.BuildErrors } = {};
+
+    constructor() {
+        this.internal = structWithDefaults.defaultStruct();
+    }
+
+    // Creates a builder wrapping an existing Struct.
+    // Defaults, constructor arguments and initializations are not applied.
+    // Builder properties start at their default value unless a veneer derives them from the resource.
+    static fromObject(resource: structWithDefaults.Struct): StructBuilder {
+        const builder: StructBuilder = Object.create(StructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            errors: {},
+        });
+
+        return builder;
+    }
+
+    build(): structWithDefaults.Struct {
+        const errors: cog.BuildError[] = [];
+        for (const error of Object.values(this.errors)) {
+            errors.push(...cog.makeBuildErrors("Struct", error).errors);
+        }
+
+        if (errors.length !== 0) {
+            throw new cog.BuildErrors(errors);
+        }
+
+        return this.internal;
+    }
+
+    allFields(allFields: cog.Builder<structWithDefaults.NestedStruct>): this {
+        let allFieldsResource;
+        try {
+            allFieldsResource = allFields.build();
+        } catch (err) {
+            this.errors["allFields"] = cog.makeBuildErrors("allFields", err);
+            return this;
+        }
+        this.internal.allFields = allFieldsResource;
+        return this;
+    }
+
+    partialFields(partialFields: cog.Builder<structWithDefaults.NestedStruct>): this {
+        let partialFieldsResource;
+        try {
+            partialFieldsResource = partialFields.build();
+        } catch (err) {
+            this.errors["partialFields"] = cog.makeBuildErrors("partialFields", err);
+            return this;
+        }
+        this.internal.partialFields = partialFieldsResource;
+        return this;
+    }
+
+    emptyFields(emptyFields: cog.Builder<structWithDefaults.NestedStruct>): this {
+        let emptyFieldsResource;
+        try {
+            emptyFieldsResource = emptyFields.build();
+        } catch (err) {
+            this.errors["emptyFields"] = cog.makeBuildErrors("emptyFields", err);
+            return this;
+        }
+        this.internal.emptyFields = emptyFieldsResource;
+        return this;
+    }
+
+    complexField(complexField: {
+	uid: string;
+	nested: {
+		nestedVal: string;
+	};
+	array: string[];
+}): this {
+        this.internal.complexField = complexField;
+        return this;
+    }
+
+    partialComplexField(partialComplexField: {
+	uid: string;
+	intVal: number;
+}): this {
+        this.internal.partialComplexField = partialComplexField;
+        return this;
+    }
+}
@@ -0,0 +1,160 @@
+package grafanatest
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// Client calls the operations exposed by the grafanatest API.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a client sending requests to the given base URL.
+// If httpClient is nil, http.DefaultClient is used.
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		baseURL:    baseURL,
+		httpClient: httpClient,
+	}
+}
+
+// Derived from the `DELETE /dashboards/{uid}` operation.
+func (client *Client) DeleteDashboard(ctx context.Context, params DeleteDashboardParams) error {
+	request := cog.Request{
+		Method: "DELETE",
+		Path:   "/dashboards/" + url.PathEscape(fmt.Sprint(params.Uid)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+
+	response, err := cog.DoRequest(ctx, client.httpClient, client.baseURL, request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == 204:
+		return nil
+	case response.StatusCode == 404:
+		var result DeleteDashboardResponse404
+		if err := cog.DecodeJSON(response, &result); err != nil {
+			return err
+		}
+		return &cog.APIError{StatusCode: response.StatusCode, Body: result}
+	}
+
+	return cog.NewAPIError(response)
+}
+
+// Fetches a dashboard.
+// Derived from the `GET /dashboards/{uid}` operation.
+func (client *Client) GetDashboard(ctx context.Context, params GetDashboardParams) (*GetDashboardResponse, error) {
+	request := cog.Request{
+		Method: "GET",
+		Path:   "/dashboards/" + url.PathEscape(fmt.Sprint(params.Uid)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	if params.Version != nil {
+		request.Query.Add("version", fmt.Sprint(*params.Version))
+	}
+
+	response, err := cog.DoRequest(ctx, client.httpClient, client.baseURL, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == 200:
+		var result GetDashboardResponse
+		if err := cog.DecodeJSON(response, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	case response.StatusCode == 404:
+		var result GetDashboardResponse404
+		if err := cog.DecodeJSON(response, &result); err != nil {
+			return nil, err
+		}
+		return nil, &cog.APIError{StatusCode: response.StatusCode, Body: result}
+	}
+
+	return nil, cog.NewAPIError(response)
+}
+
+// Derived from the `PUT /dashboards/{uid}` operation.
+func (client *Client) PutDashboardsUid(ctx context.Context, params PutDashboardsUidParams, body PutDashboardsUidRequest) (*PutDashboardsUidResponse, error) {
+	request := cog.Request{
+		Method: "PUT",
+		Path:   "/dashboards/" + url.PathEscape(fmt.Sprint(params.Uid)),
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	encodedBody, err := cog.JSONBody(body)
+	if err != nil {
+		return nil, err
+	}
+	request.Body = encodedBody
+	request.ContentType = "application/json"
+
+	response, err := cog.DoRequest(ctx, client.httpClient, client.baseURL, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode >= 200 && response.StatusCode < 300:
+		raw, err := io.ReadAll(response.Body)
+		if err != nil {
+			return nil, err
+		}
+		result := PutDashboardsUidResponse(raw)
+		return &result, nil
+	}
+
+	return nil, cog.NewAPIError(response)
+}
+
+// Searches dashboards.
+// Derived from the `GET /search` operation.
+func (client *Client) SearchDashboards(ctx context.Context, params SearchDashboardsParams) (*SearchDashboardsResponse, error) {
+	request := cog.Request{
+		Method: "GET",
+		Path:   "/search",
+		Query:  url.Values{},
+		Header: http.Header{},
+	}
+	for _, item := range params.Tag {
+		request.Query.Add("tag", fmt.Sprint(item))
+	}
+	request.Header.Add("X-Org-Id", fmt.Sprint(params.XOrgId))
+
+	response, err := cog.DoRequest(ctx, client.httpClient, client.baseURL, request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == 200:
+		var result SearchDashboardsResponse
+		if err := cog.DecodeJSON(response, &result); err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+
+	var result SearchDashboardsResponseDefault
+	if err := cog.DecodeJSON(response, &result); err != nil {
+		return nil, err
+	}
+	return nil, &cog.APIError{StatusCode: response.StatusCode, Body: result}
+}
@@ -0,0 +1,94 @@
+import * as cog from '../cog';
+import * as types from './types.gen';
+
+// Client calls the operations exposed by the grafanatest API.
+export class Client {
+	private readonly baseURL: string;
+	private readonly fetchFn: typeof fetch;
+
+	constructor(baseURL: string, fetchFn: typeof fetch = fetch) {
+		this.baseURL = baseURL;
+		this.fetchFn = fetchFn;
+	}
+
+	// Derived from the `DELETE /dashboards/{uid}` operation.
+	async deleteDashboard(params: types.DeleteDashboardParams): Promise<void> {
+		const request: cog.Request = {
+			method: "DELETE",
+			path: "/dashboards/" + encodeURIComponent(String(params.uid)),
+			query: new URLSearchParams(),
+			headers: new Headers(),
+		};
+
+		const response = await cog.doRequest(this.fetchFn, this.baseURL, request);
+		if (response.status === 204) {
+			return;
+		}
+		if (response.status === 404) {
+			throw new cog.APIError(response.status, await response.json() as types.DeleteDashboardResponse404);
+		}
+		throw await cog.makeAPIError(response);
+	}
+
+	// Fetches a dashboard.
+	// Derived from the `GET /dashboards/{uid}` operation.
+	async getDashboard(params: types.GetDashboardParams): Promise<types.GetDashboardResponse> {
+		const request: cog.Request = {
+			method: "GET",
+			path: "/dashboards/" + encodeURIComponent(String(params.uid)),
+			query: new URLSearchParams(),
+			headers: new Headers(),
+		};
+		if (params.version !== undefined) {
+			request.query.append("version", String(params.version));
+		}
+
+		const response = await cog.doRequest(this.fetchFn, this.baseURL, request);
+		if (response.status === 200) {
+			return await response.json() as types.GetDashboardResponse;
+		}
+		if (response.status === 404) {
+			throw new cog.APIError(response.status, await response.json() as types.GetDashboardResponse404);
+		}
+		throw await cog.makeAPIError(response);
+	}
+
+	// Derived from the `PUT /dashboards/{uid}` operation.
+	async putDashboardsUid(params: types.PutDashboardsUidParams, body: types.PutDashboardsUidRequest): Promise<types.PutDashboardsUidResponse> {
+		const request: cog.Request = {
+			method: "PUT",
+			path: "/dashboards/" + encodeURIComponent(String(params.uid)),
+			query: new URLSearchParams(),
+			headers: new Headers(),
+		};
+		request.body = JSON.stringify(body);
+		request.contentType = "application/json";
+
+		const response = await cog.doRequest(this.fetchFn, this.baseURL, request);
+		if (response.ok) {
+			return await response.text();
+		}
+		throw await cog.makeAPIError(response);
+	}
+
+	// Searches dashboards.
+	// Derived from the `GET /search` operation.
+	async searchDashboards(params: types.SearchDashboardsParams): Promise<types.SearchDashboardsResponse> {
+		const request: cog.Request = {
+			method: "GET",
+			path: "/search",
+			query: new URLSearchParams(),
+			headers: new Headers(),
+		};
+		for (const item of params.tag ?? []) {
+			request.query.append("tag", String(item));
+		}
+		request.headers.append("X-Org-Id", String(params["X-Org-Id"]));
+
+		const response = await cog.doRequest(this.fetchFn, this.baseURL, request);
+		if (response.status === 200) {
+			return await response.json() as types.SearchDashboardsResponse;
+		}
+		throw new cog.APIError(response.status, await response.json() as types.SearchDashboardsResponseDefault);
+	}
+}
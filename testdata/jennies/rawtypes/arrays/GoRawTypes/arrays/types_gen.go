@@ -7,6 +7,10 @@ type SomeStruct struct {
 	FieldAny any `json:"FieldAny"`
 }
 
+func (resource SomeStruct) Validate() error {
+	return nil
+}
+
 type ArrayOfRefs []SomeStruct
 
 type ArrayOfArrayOfNumbers [][]int64
@@ -0,0 +1,148 @@
+package constraints
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+type SomeStruct struct {
+	Id uint64 `json:"id"`
+	MaybeId *uint64 `json:"maybeId,omitempty"`
+	Title string `json:"title"`
+	Slug string `json:"slug"`
+	RefStruct *RefStruct `json:"refStruct,omitempty"`
+	Tags []string `json:"tags"`
+	Labels map[string]string `json:"labels"`
+	Nested struct {
+	Step int64 `json:"step"`
+} `json:"nested"`
+	Unconstrained UnconstrainedStruct `json:"unconstrained"`
+}
+
+func (resource SomeStruct) Validate() error {
+	var errs cog.BuildErrors
+	if !(resource.Id >= 5) {
+		errs = append(errs, cog.MakeBuildErrors("id", errors.New("must be >= 5"))...)
+	}
+	if !(resource.Id < 10) {
+		errs = append(errs, cog.MakeBuildErrors("id", errors.New("must be < 10"))...)
+	}
+	if resource.MaybeId != nil {
+		if !(*resource.MaybeId >= 5) {
+			errs = append(errs, cog.MakeBuildErrors("maybeId", errors.New("must be >= 5"))...)
+		}
+		if !(*resource.MaybeId < 10) {
+			errs = append(errs, cog.MakeBuildErrors("maybeId", errors.New("must be < 10"))...)
+		}
+	}
+	if !(len([]rune(resource.Title)) >= 1) {
+		errs = append(errs, cog.MakeBuildErrors("title", errors.New("length must be >= 1"))...)
+	}
+	if !(strings.HasPrefix(resource.Slug, "cog.")) {
+		errs = append(errs, cog.MakeBuildErrors("slug", errors.New("must start with \"cog.\""))...)
+	}
+	if !(regexp.MustCompile("^[a-z.]+$").MatchString(resource.Slug)) {
+		errs = append(errs, cog.MakeBuildErrors("slug", errors.New("must match \"^[a-z.]+$\""))...)
+	}
+	if !(!regexp.MustCompile("\\.\\.").MatchString(resource.Slug)) {
+		errs = append(errs, cog.MakeBuildErrors("slug", errors.New("must not match \"\\\\.\\\\.\""))...)
+	}
+	if resource.RefStruct != nil {
+		if err := resource.RefStruct.Validate(); err != nil {
+			errs = append(errs, cog.MakeBuildErrors("refStruct", err)...)
+		}
+	}
+	if !(len(resource.Tags) >= 1) {
+		errs = append(errs, cog.MakeBuildErrors("tags", errors.New("must have at least 1 items"))...)
+	}
+	if !(len(resource.Tags) <= 10) {
+		errs = append(errs, cog.MakeBuildErrors("tags", errors.New("must have at most 10 items"))...)
+	}
+	if !(cog.UniqueItems(resource.Tags)) {
+		errs = append(errs, cog.MakeBuildErrors("tags", errors.New("must contain unique items"))...)
+	}
+	for i1 := range resource.Tags {
+		if !(len([]rune(resource.Tags[i1])) >= 1) {
+			errs = append(errs, cog.MakeBuildErrors("tags[" + strconv.Itoa(i1) + "]", errors.New("length must be >= 1"))...)
+		}
+		if !(len([]rune(resource.Tags[i1])) <= 10) {
+			errs = append(errs, cog.MakeBuildErrors("tags[" + strconv.Itoa(i1) + "]", errors.New("length must be <= 10"))...)
+		}
+	}
+	if !(len(resource.Labels) <= 5) {
+		errs = append(errs, cog.MakeBuildErrors("labels", errors.New("must have at most 5 properties"))...)
+	}
+	for key1 := range resource.Labels {
+		if !(len([]rune(resource.Labels[key1])) >= 1) {
+			errs = append(errs, cog.MakeBuildErrors("labels[" + key1 + "]", errors.New("length must be >= 1"))...)
+		}
+	}
+	if !(math.Mod(float64(resource.Nested.Step), float64(2)) == 0) {
+		errs = append(errs, cog.MakeBuildErrors("nested.step", errors.New("must be a multiple of 2"))...)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+type RefStruct struct {
+	Labels map[string]Label `json:"labels"`
+	Items []RefStruct `json:"items"`
+	LowerBound BoundedFloat `json:"lowerBound"`
+}
+
+func (resource RefStruct) Validate() error {
+	var errs cog.BuildErrors
+	for key1 := range resource.Labels {
+		if err := resource.Labels[key1].Validate(); err != nil {
+			errs = append(errs, cog.MakeBuildErrors("labels[" + key1 + "]", err)...)
+		}
+	}
+	for i1 := range resource.Items {
+		if err := resource.Items[i1].Validate(); err != nil {
+			errs = append(errs, cog.MakeBuildErrors("items[" + strconv.Itoa(i1) + "]", err)...)
+		}
+	}
+	if !(resource.LowerBound > 0) {
+		errs = append(errs, cog.MakeBuildErrors("lowerBound", errors.New("must be > 0"))...)
+	}
+	if !(resource.LowerBound <= 1) {
+		errs = append(errs, cog.MakeBuildErrors("lowerBound", errors.New("must be <= 1"))...)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+type Label struct {
+	Value string `json:"value"`
+}
+
+func (resource Label) Validate() error {
+	var errs cog.BuildErrors
+	if !(len([]rune(resource.Value)) <= 20) {
+		errs = append(errs, cog.MakeBuildErrors("value", errors.New("length must be <= 20"))...)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+type BoundedFloat float64
+
+type UnconstrainedStruct struct {
+	Name string `json:"name"`
+}
+
+func (resource UnconstrainedStruct) Validate() error {
+	return nil
+}
+
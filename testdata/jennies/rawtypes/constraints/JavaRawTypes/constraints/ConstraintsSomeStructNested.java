@@ -0,0 +1,7 @@
+package constraints;
+
+
+public class ConstraintsSomeStructNested {
+    public Long step;
+    
+}
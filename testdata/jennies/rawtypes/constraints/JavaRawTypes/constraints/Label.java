@@ -0,0 +1,7 @@
+package constraints;
+
+
+public class Label {
+    public String value;
+    
+}
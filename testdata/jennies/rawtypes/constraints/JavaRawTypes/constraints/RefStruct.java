@@ -0,0 +1,11 @@
+package constraints;
+
+import java.util.Map;
+import java.util.List;
+
+public class RefStruct {
+    public Map<String, Label> labels;
+    public List<RefStruct> items;
+    public Double lowerBound;
+    
+}
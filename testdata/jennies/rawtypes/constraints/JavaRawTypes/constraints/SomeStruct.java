@@ -0,0 +1,17 @@
+package constraints;
+
+import java.util.List;
+import java.util.Map;
+
+public class SomeStruct {
+    public Long id;
+    public Long maybeId;
+    public String title;
+    public String slug;
+    public RefStruct refStruct;
+    public List<String> tags;
+    public Map<String, String> labels;
+    public ConstraintsSomeStructNested nested;
+    public UnconstrainedStruct unconstrained;
+    
+}
@@ -0,0 +1,7 @@
+package constraints;
+
+
+public class UnconstrainedStruct {
+    public String name;
+    
+}
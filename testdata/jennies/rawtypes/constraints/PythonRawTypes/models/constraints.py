@@ -0,0 +1,169 @@
+import typing
+
+
+class SomeStruct:
+    id_val: int
+    maybe_id: typing.Optional[int]
+    title: str
+    slug: str
+    ref_struct: typing.Optional['RefStruct']
+    tags: list[str]
+    labels: dict[str, str]
+    nested: 'ConstraintsSomeStructNested'
+    unconstrained: 'UnconstrainedStruct'
+
+    def __init__(self, id_val: int = 0, maybe_id: typing.Optional[int] = None, title: str = "", slug: str = "", ref_struct: typing.Optional['RefStruct'] = None, tags: typing.Optional[list[str]] = None, labels: typing.Optional[dict[str, str]] = None, nested: typing.Optional['ConstraintsSomeStructNested'] = None, unconstrained: typing.Optional['UnconstrainedStruct'] = None):
+        self.id_val = id_val
+        self.maybe_id = maybe_id
+        self.title = title
+        self.slug = slug
+        self.ref_struct = ref_struct
+        self.tags = tags if tags is not None else []
+        self.labels = labels if labels is not None else {}
+        self.nested = nested if nested is not None else ConstraintsSomeStructNested()
+        self.unconstrained = unconstrained if unconstrained is not None else UnconstrainedStruct()
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "id": self.id_val,
+            "title": self.title,
+            "slug": self.slug,
+            "tags": self.tags,
+            "labels": self.labels,
+            "nested": self.nested,
+            "unconstrained": self.unconstrained,
+        }
+        if self.maybe_id is not None:
+            payload["maybeId"] = self.maybe_id
+        if self.ref_struct is not None:
+            payload["refStruct"] = self.ref_struct
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "id" in data:
+            args["id_val"] = data["id"]
+        if "maybeId" in data:
+            args["maybe_id"] = data["maybeId"]
+        if "title" in data:
+            args["title"] = data["title"]
+        if "slug" in data:
+            args["slug"] = data["slug"]
+        if "refStruct" in data:
+            args["ref_struct"] = RefStruct.from_json(data["refStruct"])
+        if "tags" in data:
+            args["tags"] = data["tags"]
+        if "labels" in data:
+            args["labels"] = data["labels"]
+        if "nested" in data:
+            args["nested"] = ConstraintsSomeStructNested.from_json(data["nested"])
+        if "unconstrained" in data:
+            args["unconstrained"] = UnconstrainedStruct.from_json(data["unconstrained"])        
+
+        return cls(**args)
+
+
+class RefStruct:
+    labels: dict[str, 'Label']
+    items: list['RefStruct']
+    lower_bound: 'BoundedFloat'
+
+    def __init__(self, labels: typing.Optional[dict[str, 'Label']] = None, items: typing.Optional[list['RefStruct']] = None, lower_bound: typing.Optional['BoundedFloat'] = None):
+        self.labels = labels if labels is not None else {}
+        self.items = items if items is not None else []
+        self.lower_bound = lower_bound if lower_bound is not None else BoundedFloat()
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "labels": self.labels,
+            "items": self.items,
+            "lowerBound": self.lower_bound,
+        }
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "labels" in data:
+            args["labels"] = data["labels"]
+        if "items" in data:
+            args["items"] = data["items"]
+        if "lowerBound" in data:
+            args["lower_bound"] = data["lowerBound"]        
+
+        return cls(**args)
+
+
+class Label:
+    value: str
+
+    def __init__(self, value: str = ""):
+        self.value = value
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "value": self.value,
+        }
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "value" in data:
+            args["value"] = data["value"]        
+
+        return cls(**args)
+
+
+BoundedFloat = float
+
+
+class UnconstrainedStruct:
+    name: str
+
+    def __init__(self, name: str = ""):
+        self.name = name
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "name": self.name,
+        }
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "name" in data:
+            args["name"] = data["name"]        
+
+        return cls(**args)
+
+
+class ConstraintsSomeStructNested:
+    step: int
+
+    def __init__(self, step: int = 0):
+        self.step = step
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "step": self.step,
+        }
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "step" in data:
+            args["step"] = data["step"]        
+
+        return cls(**args)
+
+
+
@@ -0,0 +1,43 @@
+use serde::{Deserialize, Serialize};
+use std::collections::HashMap;
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct SomeStruct {
+    pub id: u64,
+    #[serde(rename = "maybeId", default, skip_serializing_if = "Option::is_none")]
+    pub maybe_id: Option<u64>,
+    pub title: String,
+    pub slug: String,
+    #[serde(rename = "refStruct", default, skip_serializing_if = "Option::is_none")]
+    pub ref_struct: Option<RefStruct>,
+    pub tags: Vec<String>,
+    pub labels: HashMap<String, String>,
+    pub nested: ConstraintsSomeStructNested,
+    pub unconstrained: UnconstrainedStruct,
+}
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct RefStruct {
+    pub labels: HashMap<String, Label>,
+    pub items: Vec<RefStruct>,
+    #[serde(rename = "lowerBound")]
+    pub lower_bound: BoundedFloat,
+}
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct Label {
+    pub value: String,
+}
+
+pub type BoundedFloat = f64;
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct UnconstrainedStruct {
+    pub name: String,
+}
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct ConstraintsSomeStructNested {
+    pub step: i64,
+}
+
@@ -0,0 +1,58 @@
+export interface SomeStruct {
+	id: number;
+	maybeId?: number;
+	title: string;
+	slug: string;
+	refStruct?: RefStruct;
+	tags: string[];
+	labels: Record<string, string>;
+	nested: {
+		step: number;
+	};
+	unconstrained: UnconstrainedStruct;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	id: 0,
+	title: "",
+	slug: "",
+	tags: [],
+	labels: {},
+	nested: {
+	step: 0,
+},
+	unconstrained: defaultUnconstrainedStruct(),
+});
+
+export interface RefStruct {
+	labels: Record<string, Label>;
+	items: RefStruct[];
+	lowerBound: BoundedFloat;
+}
+
+export const defaultRefStruct = (): RefStruct => ({
+	labels: {},
+	items: [],
+	lowerBound: defaultBoundedFloat(),
+});
+
+export interface Label {
+	value: string;
+}
+
+export const defaultLabel = (): Label => ({
+	value: "",
+});
+
+export type BoundedFloat = number;
+
+export const defaultBoundedFloat = (): BoundedFloat => (0);
+
+export interface UnconstrainedStruct {
+	name: string;
+}
+
+export const defaultUnconstrainedStruct = (): UnconstrainedStruct => ({
+	name: "",
+});
+
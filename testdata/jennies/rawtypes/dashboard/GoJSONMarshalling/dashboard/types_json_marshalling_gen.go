@@ -72,6 +72,7 @@ func (resource *Panel) UnmarshalJSON(raw []byte) error {
 		}
 	}
 
+	{
 	dataqueryTypeHint := ""
 if resource.Datasource != nil && resource.Datasource.Type != nil {
 dataqueryTypeHint = *resource.Datasource.Type
@@ -82,6 +83,53 @@ dataqueryTypeHint = *resource.Datasource.Type
 		return err
 	}
 	resource.Targets = targets
+	}
 
 	return nil
 }
+func (resource PanelOrRowPanel) MarshalJSON() ([]byte, error) {
+	if resource.Panel != nil {
+		return json.Marshal(resource.Panel)
+	}
+	if resource.RowPanel != nil {
+		return json.Marshal(resource.RowPanel)
+	}
+
+	return nil, fmt.Errorf("no value for disjunction of refs")
+}
+func (resource *PanelOrRowPanel) UnmarshalJSON(raw []byte) error {
+	if raw == nil {
+		return nil
+	}
+
+	// FIXME: this is wasteful, we need to find a more efficient way to unmarshal this.
+	parsedAsMap := make(map[string]any)
+	if err := json.Unmarshal(raw, &parsedAsMap); err != nil {
+		return err
+	}
+
+	discriminator, found := parsedAsMap["type"]
+	if !found {
+		return errors.New("discriminator field 'type' not found in payload")
+	}
+
+	switch discriminator {
+    default:
+		var panel Panel
+		if err := json.Unmarshal(raw, &panel); err != nil {
+			return err
+		}
+
+		resource.Panel = &panel
+		return nil
+	case "row":
+		var rowPanel RowPanel
+		if err := json.Unmarshal(raw, &rowPanel); err != nil {
+			return err
+		}
+
+		resource.RowPanel = &rowPanel
+		return nil
+	}
+}
+
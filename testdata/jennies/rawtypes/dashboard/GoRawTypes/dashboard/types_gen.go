@@ -6,7 +6,11 @@ import (
 
 type Dashboard struct {
 	Title string `json:"title"`
-	Panels []Panel `json:"panels,omitempty"`
+	Panels []PanelOrRowPanel `json:"panels,omitempty"`
+}
+
+func (resource Dashboard) Validate() error {
+	return nil
 }
 
 type DataSourceRef struct {
@@ -14,15 +18,27 @@ type DataSourceRef struct {
 	Uid *string `json:"uid,omitempty"`
 }
 
+func (resource DataSourceRef) Validate() error {
+	return nil
+}
+
 type FieldConfigSource struct {
 	Defaults *FieldConfig `json:"defaults,omitempty"`
 }
 
+func (resource FieldConfigSource) Validate() error {
+	return nil
+}
+
 type FieldConfig struct {
 	Unit *string `json:"unit,omitempty"`
 	Custom any `json:"custom,omitempty"`
 }
 
+func (resource FieldConfig) Validate() error {
+	return nil
+}
+
 type Panel struct {
 	Title string `json:"title"`
 	Type string `json:"type"`
@@ -32,3 +48,26 @@ type Panel struct {
 	FieldConfig *FieldConfigSource `json:"fieldConfig,omitempty"`
 }
 
+func (resource Panel) Validate() error {
+	return nil
+}
+
+type RowPanel struct {
+	Type string `json:"type"`
+	Title *string `json:"title,omitempty"`
+	Panels []Panel `json:"panels"`
+}
+
+func (resource RowPanel) Validate() error {
+	return nil
+}
+
+type PanelOrRowPanel struct {
+	Panel *Panel `json:"Panel,omitempty"`
+	RowPanel *RowPanel `json:"RowPanel,omitempty"`
+}
+
+func (resource PanelOrRowPanel) Validate() error {
+	return nil
+}
+
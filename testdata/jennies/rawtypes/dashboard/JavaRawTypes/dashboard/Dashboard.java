@@ -4,6 +4,6 @@ import java.util.List;
 
 public class Dashboard {
     public String title;
-    public List<Panel> panels;
+    public List<PanelOrRowPanel> panels;
     
 }
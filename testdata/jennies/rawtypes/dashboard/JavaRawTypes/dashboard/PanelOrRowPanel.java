@@ -0,0 +1,8 @@
+package dashboard;
+
+
+public class PanelOrRowPanel {
+    public Panel Panel;
+    public RowPanel RowPanel;
+    
+}
@@ -0,0 +1,10 @@
+package dashboard;
+
+import java.util.List;
+
+public class RowPanel {
+    public String type;
+    public String title;
+    public List<Panel> panels;
+    
+}
@@ -5,9 +5,9 @@ from ..cog import runtime as cogruntime
 
 class Dashboard:
     title: str
-    panels: typing.Optional[list['Panel']]
+    panels: typing.Optional[list[typing.Union['Panel', 'RowPanel']]]
 
-    def __init__(self, title: str = "", panels: typing.Optional[list['Panel']] = None):
+    def __init__(self, title: str = "", panels: typing.Optional[list[typing.Union['Panel', 'RowPanel']]] = None):
         self.title = title
         self.panels = panels
 
@@ -26,7 +26,8 @@ class Dashboard:
         if "title" in data:
             args["title"] = data["title"]
         if "panels" in data:
-            args["panels"] = data["panels"]        
+            decoding_map: dict[str, typing.Union[typing.Type[RowPanel]]] = {"row": RowPanel}
+            args["panels"] = [decoding_map.get(item["type"], Panel).from_json(item) for item in data["panels"]]        
 
         return cls(**args)
 
@@ -174,4 +175,35 @@ class Panel:
         return cls(**args)
 
 
+class RowPanel:
+    type_val: typing.Literal["row"]
+    title: typing.Optional[str]
+    panels: list['Panel']
+
+    def __init__(self, title: typing.Optional[str] = None, panels: typing.Optional[list['Panel']] = None):
+        self.type_val = "row"
+        self.title = title
+        self.panels = panels if panels is not None else []
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "type": self.type_val,
+            "panels": self.panels,
+        }
+        if self.title is not None:
+            payload["title"] = self.title
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "title" in data:
+            args["title"] = data["title"]
+        if "panels" in data:
+            args["panels"] = data["panels"]        
+
+        return cls(**args)
+
+
 
@@ -3,13 +3,23 @@ import * as cog from '../cog';
 
 export interface Dashboard {
 	title: string;
-	panels?: Panel[];
+	panels?: (Panel | RowPanel)[];
 }
 
 export const defaultDashboard = (): Dashboard => ({
 	title: "",
 });
 
+export const dashboardFromJSON = (input: any): Dashboard => {
+	const resource: Dashboard = { ...input };
+
+	if (input.panels) {
+		resource.panels = input.panels.map((item: any) => item.type === "row" ? rowPanelFromJSON(item) : panelFromJSON(item));
+	}
+
+	return resource;
+};
+
 export interface DataSourceRef {
 	type?: string;
 	uid?: string;
@@ -47,3 +57,50 @@ export const defaultPanel = (): Panel => ({
 	type: "",
 });
 
+export const panelFromJSON = (input: any): Panel => {
+	const resource: Panel = { ...input };
+	const dataqueryTypeHint = input.datasource?.type ?? "";
+	const panelcfgConfig = cog.panelcfgConfig(input.type ?? "");
+
+	if (input.options && panelcfgConfig?.optionsFromJSON) {
+		resource.options = panelcfgConfig.optionsFromJSON(input.options);
+	}
+
+	if (input.targets) {
+		resource.targets = cog.dataqueriesFromJSON(input.targets, dataqueryTypeHint);
+	}
+
+	if (input.fieldConfig?.defaults?.custom && panelcfgConfig?.fieldConfigFromJSON) {
+		resource.fieldConfig = {
+			...input.fieldConfig,
+			defaults: {
+				...input.fieldConfig.defaults,
+				custom: panelcfgConfig.fieldConfigFromJSON(input.fieldConfig.defaults.custom),
+			},
+		};
+	}
+
+	return resource;
+};
+
+export interface RowPanel {
+	type: "row";
+	title?: string;
+	panels: Panel[];
+}
+
+export const defaultRowPanel = (): RowPanel => ({
+	type: "row",
+	panels: [],
+});
+
+export const rowPanelFromJSON = (input: any): RowPanel => {
+	const resource: RowPanel = { ...input };
+
+	if (input.panels) {
+		resource.panels = input.panels.map((item: any) => panelFromJSON(item));
+	}
+
+	return resource;
+};
+
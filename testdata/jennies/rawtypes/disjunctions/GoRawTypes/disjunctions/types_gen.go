@@ -10,6 +10,10 @@ type SomeStruct struct {
 	FieldAny any `json:"FieldAny"`
 }
 
+func (resource SomeStruct) Validate() error {
+	return nil
+}
+
 type BoolOrRef = BoolOrSomeStruct
 
 type SomeOtherStruct struct {
@@ -17,11 +21,19 @@ type SomeOtherStruct struct {
 	Foo bytes `json:"Foo"`
 }
 
+func (resource SomeOtherStruct) Validate() error {
+	return nil
+}
+
 type YetAnotherStruct struct {
 	Type string `json:"Type"`
 	Bar uint8 `json:"Bar"`
 }
 
+func (resource YetAnotherStruct) Validate() error {
+	return nil
+}
+
 type SeveralRefs = SomeStructOrSomeOtherStructOrYetAnotherStruct
 
 type StringOrBool struct {
@@ -29,14 +41,26 @@ type StringOrBool struct {
 	Bool *bool `json:"Bool,omitempty"`
 }
 
+func (resource StringOrBool) Validate() error {
+	return nil
+}
+
 type BoolOrSomeStruct struct {
 	Bool *bool `json:"Bool,omitempty"`
 	SomeStruct *SomeStruct `json:"SomeStruct,omitempty"`
 }
 
+func (resource BoolOrSomeStruct) Validate() error {
+	return nil
+}
+
 type SomeStructOrSomeOtherStructOrYetAnotherStruct struct {
 	SomeStruct *SomeStruct `json:"SomeStruct,omitempty"`
 	SomeOtherStruct *SomeOtherStruct `json:"SomeOtherStruct,omitempty"`
 	YetAnotherStruct *YetAnotherStruct `json:"YetAnotherStruct,omitempty"`
 }
 
+func (resource SomeStructOrSomeOtherStructOrYetAnotherStruct) Validate() error {
+	return nil
+}
+
@@ -0,0 +1,63 @@
+use serde::{Deserialize, Serialize};
+
+/// Refresh rate or disabled.
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+#[serde(untagged)]
+pub enum RefreshRate {
+    String(String),
+    Bool(bool),
+}
+
+pub type StringOrNull = Option<String>;
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct SomeStruct {
+    #[serde(rename = "Type")]
+    pub r#type: String,
+    #[serde(rename = "FieldAny")]
+    pub field_any: serde_json::Value,
+}
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+#[serde(untagged)]
+pub enum BoolOrRef {
+    Bool(bool),
+    SomeStruct(SomeStruct),
+}
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct SomeOtherStruct {
+    #[serde(rename = "Type")]
+    pub r#type: String,
+    #[serde(rename = "Foo")]
+    pub foo: Vec<u8>,
+}
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct YetAnotherStruct {
+    #[serde(rename = "Type")]
+    pub r#type: String,
+    #[serde(rename = "Bar")]
+    pub bar: u8,
+}
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+#[serde(tag = "Type")]
+pub enum SeveralRefs {
+    #[serde(rename = "some-struct")]
+    SomeStruct {
+        #[serde(rename = "FieldAny")]
+        field_any: serde_json::Value,
+    },
+    #[serde(rename = "some-other-struct")]
+    SomeOtherStruct {
+        #[serde(rename = "Foo")]
+        foo: Vec<u8>,
+    },
+    #[serde(rename = "yet-another-struct")]
+    YetAnotherStruct {
+        #[serde(rename = "Bar")]
+        bar: u8,
+    },
+}
+
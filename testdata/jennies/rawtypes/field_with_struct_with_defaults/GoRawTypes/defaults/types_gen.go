@@ -5,6 +5,10 @@ type NestedStruct struct {
 	IntVal int64 `json:"intVal"`
 }
 
+func (resource NestedStruct) Validate() error {
+	return nil
+}
+
 type Struct struct {
 	AllFields NestedStruct `json:"allFields"`
 	PartialFields NestedStruct `json:"partialFields"`
@@ -22,3 +26,7 @@ type Struct struct {
 } `json:"partialComplexField"`
 }
 
+func (resource Struct) Validate() error {
+	return nil
+}
+
@@ -16,3 +16,7 @@ type SomeStruct struct {
 	FieldBool bool `json:"fieldBool"`
 }
 
+func (resource SomeStruct) Validate() error {
+	return nil
+}
+
@@ -0,0 +1,61 @@
+import typing
+from ..models import externalPkg
+
+
+class SomeStruct:
+    field_bool: bool
+
+    def __init__(self, field_bool: bool = True):
+        self.field_bool = field_bool
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "fieldBool": self.field_bool,
+        }
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "fieldBool" in data:
+            args["field_bool"] = data["fieldBool"]        
+
+        return cls(**args)
+
+
+class Intersections(SomeStruct, externalPkg.AnotherStruct):
+    field_string: str
+    field_integer: int
+
+    def __init__(self, field_string: str = "hello", field_integer: int = 32):
+        SomeStruct.__init__(self)
+        externalPkg.AnotherStruct.__init__(self)
+        self.field_string = field_string
+        self.field_integer = field_integer
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "fieldString": self.field_string,
+            "fieldInteger": self.field_integer,
+        }
+        payload.update(SomeStruct.to_json(self))
+        payload.update(externalPkg.AnotherStruct.to_json(self))
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "fieldString" in data:
+            args["field_string"] = data["fieldString"]
+        if "fieldInteger" in data:
+            args["field_integer"] = data["fieldInteger"]        
+
+        instance = cls(**args)
+        instance.__dict__.update(vars(SomeStruct.from_json(data)))
+        instance.__dict__.update(vars(externalPkg.AnotherStruct.from_json(data)))
+        return instance
+
+
+
@@ -0,0 +1,20 @@
+use serde::{Deserialize, Serialize};
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct Intersections {
+    #[serde(flatten)]
+    pub some_struct: SomeStruct,
+    #[serde(flatten)]
+    pub another_struct: crate::externalpkg::AnotherStruct,
+    #[serde(rename = "fieldString")]
+    pub field_string: String,
+    #[serde(rename = "fieldInteger")]
+    pub field_integer: i32,
+}
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct SomeStruct {
+    #[serde(rename = "fieldBool")]
+    pub field_bool: bool,
+}
+
@@ -4,6 +4,10 @@ type SomeStruct struct {
 	FieldAny any `json:"FieldAny"`
 }
 
+func (resource SomeStruct) Validate() error {
+	return nil
+}
+
 // Refresh rate or disabled.
 type RefreshRate = StringOrBool
 
@@ -12,3 +16,7 @@ type StringOrBool struct {
 	Bool *bool `json:"Bool,omitempty"`
 }
 
+func (resource StringOrBool) Validate() error {
+	return nil
+}
+
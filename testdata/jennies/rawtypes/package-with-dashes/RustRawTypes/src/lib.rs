@@ -0,0 +1 @@
+pub mod with_dashes;
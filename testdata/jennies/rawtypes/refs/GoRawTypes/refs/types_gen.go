@@ -8,6 +8,10 @@ type SomeStruct struct {
 	FieldAny any `json:"FieldAny"`
 }
 
+func (resource SomeStruct) Validate() error {
+	return nil
+}
+
 type RefToSomeStruct = SomeStruct
 
 type RefToSomeStructFromOtherPackage = otherpkg.SomeDistantStruct
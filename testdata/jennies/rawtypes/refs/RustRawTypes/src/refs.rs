@@ -0,0 +1,12 @@
+use serde::{Deserialize, Serialize};
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct SomeStruct {
+    #[serde(rename = "FieldAny")]
+    pub field_any: serde_json::Value,
+}
+
+pub type RefToSomeStruct = SomeStruct;
+
+pub type RefToSomeStructFromOtherPackage = crate::otherpkg::SomeDistantStruct;
+
@@ -0,0 +1,30 @@
+pub const CONST_TYPE_STRING: &str = "foo";
+
+pub type ScalarTypeAny = serde_json::Value;
+
+pub type ScalarTypeBool = bool;
+
+pub type ScalarTypeBytes = Vec<u8>;
+
+pub type ScalarTypeString = String;
+
+pub type ScalarTypeFloat32 = f32;
+
+pub type ScalarTypeFloat64 = f64;
+
+pub type ScalarTypeUint8 = u8;
+
+pub type ScalarTypeUint16 = u16;
+
+pub type ScalarTypeUint32 = u32;
+
+pub type ScalarTypeUint64 = u64;
+
+pub type ScalarTypeInt8 = i8;
+
+pub type ScalarTypeInt16 = i16;
+
+pub type ScalarTypeInt32 = i32;
+
+pub type ScalarTypeInt64 = i64;
+
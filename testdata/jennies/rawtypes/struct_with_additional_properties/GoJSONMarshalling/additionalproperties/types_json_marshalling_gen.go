@@ -0,0 +1,130 @@
+package additionalproperties
+func (resource Labels) MarshalJSON() ([]byte, error) {
+	type original Labels
+
+	raw, err := json.Marshal(original(resource))
+	if err != nil {
+		return nil, err
+	}
+	if len(resource.AdditionalProperties) == 0 {
+		return raw, nil
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	for key, value := range resource.AdditionalProperties {
+		if _, declared := fields[key]; declared {
+			continue
+		}
+
+		fields[key], err = json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+func (resource *Labels) UnmarshalJSON(raw []byte) error {
+	if raw == nil {
+		return nil
+	}
+
+	type original Labels
+	if err := json.Unmarshal(raw, (*original)(resource)); err != nil {
+		return err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return err
+	}
+
+	delete(fields, "team")
+	delete(fields, "priority")
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	resource.AdditionalProperties = make(map[string]string, len(fields))
+	for key, value := range fields {
+		var property string
+		if err := json.Unmarshal(value, &property); err != nil {
+			return err
+		}
+
+		resource.AdditionalProperties[key] = property
+	}
+
+	return nil
+}
+
+func (resource Extensions) MarshalJSON() ([]byte, error) {
+	type original Extensions
+
+	raw, err := json.Marshal(original(resource))
+	if err != nil {
+		return nil, err
+	}
+	if len(resource.AdditionalProperties) == 0 {
+		return raw, nil
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	for key, value := range resource.AdditionalProperties {
+		if _, declared := fields[key]; declared {
+			continue
+		}
+
+		fields[key], err = json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+func (resource *Extensions) UnmarshalJSON(raw []byte) error {
+	if raw == nil {
+		return nil
+	}
+
+	type original Extensions
+	if err := json.Unmarshal(raw, (*original)(resource)); err != nil {
+		return err
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return err
+	}
+
+	delete(fields, "version")
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	resource.AdditionalProperties = make(map[string]any, len(fields))
+	for key, value := range fields {
+		var property any
+		if err := json.Unmarshal(value, &property); err != nil {
+			return err
+		}
+
+		resource.AdditionalProperties[key] = property
+	}
+
+	return nil
+}
+
@@ -0,0 +1,24 @@
+package additionalproperties
+
+// Known labels, and any other label.
+type Labels struct {
+	Team string `json:"team"`
+	Priority *int64 `json:"priority,omitempty"`
+	// AdditionalProperties holds the properties that are not declared as fields.
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+func (resource Labels) Validate() error {
+	return nil
+}
+
+type Extensions struct {
+	Version string `json:"version"`
+	// AdditionalProperties holds the properties that are not declared as fields.
+	AdditionalProperties map[string]any `json:"-"`
+}
+
+func (resource Extensions) Validate() error {
+	return nil
+}
+
@@ -0,0 +1,26 @@
+package additionalproperties;
+
+import com.fasterxml.jackson.annotation.JsonAnyGetter;
+import com.fasterxml.jackson.annotation.JsonAnySetter;
+import java.util.HashMap;
+import java.util.Map;
+
+// Known labels, and any other label.
+public class Labels {
+    public String team;
+    public Long priority;
+    
+    // Properties that are not declared as fields.
+    private Map<String, String> additionalProperties = new HashMap<>();
+
+    @JsonAnyGetter
+    public Map<String, String> getAdditionalProperties() {
+        return additionalProperties;
+    }
+
+    @JsonAnySetter
+    public void setAdditionalProperty(String name, String value) {
+        additionalProperties.put(name, value);
+    }
+    
+}
@@ -0,0 +1,70 @@
+import typing
+
+
+class Labels:
+    """
+    Known labels, and any other label.
+    """
+
+    team: str
+    priority: typing.Optional[int]
+    # Properties that are not declared as fields.
+    additional_properties: dict[str, str]
+
+    def __init__(self, team: str = "", priority: typing.Optional[int] = None, additional_properties: typing.Optional[dict[str, str]] = None):
+        self.team = team
+        self.priority = priority
+        self.additional_properties = additional_properties if additional_properties is not None else {}
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "team": self.team,
+        }
+        if self.priority is not None:
+            payload["priority"] = self.priority
+        for key, value in self.additional_properties.items():
+            payload.setdefault(key, value)
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "team" in data:
+            args["team"] = data["team"]
+        if "priority" in data:
+            args["priority"] = data["priority"]
+        args["additional_properties"] = {key: value for key, value in data.items() if key not in ["team", "priority"]}        
+
+        return cls(**args)
+
+
+class Extensions:
+    version: str
+    # Properties that are not declared as fields.
+    additional_properties: dict[str, object]
+
+    def __init__(self, version: str = "", additional_properties: typing.Optional[dict[str, object]] = None):
+        self.version = version
+        self.additional_properties = additional_properties if additional_properties is not None else {}
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "version": self.version,
+        }
+        for key, value in self.additional_properties.items():
+            payload.setdefault(key, value)
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "version" in data:
+            args["version"] = data["version"]
+        args["additional_properties"] = {key: value for key, value in data.items() if key not in ["version"]}        
+
+        return cls(**args)
+
+
+
@@ -0,0 +1,22 @@
+use serde::{Deserialize, Serialize};
+use std::collections::HashMap;
+
+/// Known labels, and any other label.
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct Labels {
+    pub team: String,
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub priority: Option<i64>,
+    /// Properties that are not declared as fields.
+    #[serde(flatten)]
+    pub additional_properties: HashMap<String, String>,
+}
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct Extensions {
+    pub version: String,
+    /// Properties that are not declared as fields.
+    #[serde(flatten)]
+    pub additional_properties: HashMap<String, serde_json::Value>,
+}
+
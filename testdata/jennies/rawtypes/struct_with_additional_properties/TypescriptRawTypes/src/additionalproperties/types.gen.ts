@@ -0,0 +1,20 @@
+// Known labels, and any other label.
+export interface Labels {
+	team: string;
+	priority?: number;
+	[key: string]: string | number;
+}
+
+export const defaultLabels = (): Labels => ({
+	team: "",
+});
+
+export interface Extensions {
+	version: string;
+	[key: string]: any;
+}
+
+export const defaultExtensions = (): Extensions => ({
+	version: "",
+});
+
@@ -15,12 +15,20 @@ type SomeStruct struct {
 	FieldRefToConstant string `json:"fieldRefToConstant"`
 }
 
+func (resource SomeStruct) Validate() error {
+	return nil
+}
+
 const ConnectionPath = "straight"
 
 type SomeOtherStruct struct {
 	FieldAny any `json:"FieldAny"`
 }
 
+func (resource SomeOtherStruct) Validate() error {
+	return nil
+}
+
 type SomeStructOperator string
 const (
 	SomeStructOperatorGreaterThan SomeStructOperator = ">"
@@ -33,8 +41,16 @@ type StringOrBool struct {
 	Bool *bool `json:"Bool,omitempty"`
 }
 
+func (resource StringOrBool) Validate() error {
+	return nil
+}
+
 type StringOrSomeOtherStruct struct {
 	String *string `json:"String,omitempty"`
 	SomeOtherStruct *SomeOtherStruct `json:"SomeOtherStruct,omitempty"`
 }
 
+func (resource StringOrSomeOtherStruct) Validate() error {
+	return nil
+}
+
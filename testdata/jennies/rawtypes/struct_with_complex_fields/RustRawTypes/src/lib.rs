@@ -0,0 +1 @@
+pub mod struct_complex_fields;
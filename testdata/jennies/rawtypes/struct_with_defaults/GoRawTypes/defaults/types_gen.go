@@ -8,3 +8,7 @@ type SomeStruct struct {
 	FieldInt32 int32 `json:"FieldInt32"`
 }
 
+func (resource SomeStruct) Validate() error {
+	return nil
+}
+
@@ -0,0 +1,16 @@
+use serde::{Deserialize, Serialize};
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct SomeStruct {
+    #[serde(rename = "fieldBool")]
+    pub field_bool: bool,
+    #[serde(rename = "fieldString")]
+    pub field_string: String,
+    #[serde(rename = "FieldStringWithConstantValue")]
+    pub field_string_with_constant_value: String,
+    #[serde(rename = "FieldFloat32")]
+    pub field_float32: f32,
+    #[serde(rename = "FieldInt32")]
+    pub field_int32: i32,
+}
+
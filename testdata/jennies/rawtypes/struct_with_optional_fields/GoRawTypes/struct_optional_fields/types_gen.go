@@ -10,10 +10,18 @@ type SomeStruct struct {
 } `json:"FieldAnonymousStruct,omitempty"`
 }
 
+func (resource SomeStruct) Validate() error {
+	return nil
+}
+
 type SomeOtherStruct struct {
 	FieldAny any `json:"FieldAny"`
 }
 
+func (resource SomeOtherStruct) Validate() error {
+	return nil
+}
+
 type SomeStructOperator string
 const (
 	SomeStructOperatorGreaterThan SomeStructOperator = ">"
@@ -0,0 +1 @@
+pub mod struct_optional_fields;
@@ -24,3 +24,7 @@ FieldAny any `json:"FieldAny"`
 	FieldInt64 int64 `json:"FieldInt64"`
 }
 
+func (resource SomeStruct) Validate() error {
+	return nil
+}
+
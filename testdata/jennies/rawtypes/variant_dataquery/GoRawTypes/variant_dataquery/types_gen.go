@@ -7,3 +7,7 @@ type Query struct {
 func (resource Query) ImplementsDataqueryVariant() {}
 
 
+func (resource Query) Validate() error {
+	return nil
+}
+
@@ -0,0 +1 @@
+pub mod variant_dataquery;
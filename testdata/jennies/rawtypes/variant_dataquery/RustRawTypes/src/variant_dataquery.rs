@@ -0,0 +1,9 @@
+use serde::{Deserialize, Serialize};
+
+#[derive(Serialize, Deserialize, Debug, Clone, PartialEq)]
+pub struct Query {
+    pub expr: String,
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub instant: Option<bool>,
+}
+
@@ -1,3 +1,6 @@
+import * as cog from '../cog';
+
+
 export interface Query {
 	expr: string;
 	instant?: boolean;
@@ -9,3 +12,10 @@ export const defaultQuery = (): Query => ({
 	_implementsDataqueryVariant: () => {},
 });
 
+export const variantConfig = (): cog.DataqueryConfig => {
+	return {
+		identifier: "prometheus",
+		fromJSON: (input: any): Query => ({ ...defaultQuery(), ...input }),
+	};
+};
+
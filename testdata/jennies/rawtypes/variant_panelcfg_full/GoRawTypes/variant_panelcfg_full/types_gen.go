@@ -4,7 +4,15 @@ type Options struct {
 	TimeseriesOption string `json:"timeseries_option"`
 }
 
+func (resource Options) Validate() error {
+	return nil
+}
+
 type FieldConfig struct {
 	TimeseriesFieldConfigOption string `json:"timeseries_field_config_option"`
 }
 
+func (resource FieldConfig) Validate() error {
+	return nil
+}
+
@@ -1,3 +1,6 @@
+import * as cog from '../cog';
+
+
 export interface Options {
 	timeseries_option: string;
 }
@@ -14,3 +17,11 @@ export const defaultFieldConfig = (): FieldConfig => ({
 	timeseries_field_config_option: "",
 });
 
+export const variantConfig = (): cog.PanelcfgConfig => {
+	return {
+		identifier: "timeseries",
+		optionsFromJSON: (input: any): Options => ({ ...defaultOptions(), ...input }),
+		fieldConfigFromJSON: (input: any): FieldConfig => ({ ...defaultFieldConfig(), ...input }),
+	};
+};
+
@@ -4,3 +4,7 @@ type Options struct {
 	Content string `json:"content"`
 }
 
+func (resource Options) Validate() error {
+	return nil
+}
+
@@ -0,0 +1 @@
+pub mod variant_panelcfg_only_options;
@@ -1,3 +1,6 @@
+import * as cog from '../cog';
+
+
 export interface Options {
 	content: string;
 }
@@ -6,3 +9,10 @@ export const defaultOptions = (): Options => ({
 	content: "",
 });
 
+export const variantConfig = (): cog.PanelcfgConfig => {
+	return {
+		identifier: "text",
+		optionsFromJSON: (input: any): Options => ({ ...defaultOptions(), ...input }),
+	};
+};
+
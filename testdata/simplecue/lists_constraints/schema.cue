@@ -0,0 +1,7 @@
+import "list"
+
+container: {
+    tags: [...string] & list.UniqueItems()
+    thresholds: [...number] & list.MaxItems(10)
+    targets: [...string] & list.MinItems(1) & list.MaxItems(5)
+}
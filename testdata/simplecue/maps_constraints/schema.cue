@@ -0,0 +1,6 @@
+import "struct"
+
+container: {
+    labels: {[string]: string} & struct.MinFields(1) & struct.MaxFields(5)
+    annotations: {[string]: string} & struct.MaxFields(10)
+}
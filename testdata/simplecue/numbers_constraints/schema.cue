@@ -1,7 +1,11 @@
+import "math"
+
 container: {
     constantInt: 42
     constantFloat: 42.24
     rowHeight: float & >=0 & <=1
     colWidth: float64 & <=1
     fiscalYearStartMonth: uint8 & <12 | *0
+    interval: int & >0 & math.MultipleOf(5)
+    step: float & math.MultipleOf(0.5) | *1.5
 }
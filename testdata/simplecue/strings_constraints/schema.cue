@@ -0,0 +1,8 @@
+import "strings"
+
+container: {
+    name: string & strings.MinRunes(1) & strings.MaxRunes(50)
+    slug: string & =~"^[a-z0-9-]+$"
+    notInternal: string & !~"^_"
+    uri: string & strings.HasPrefix("https://")
+}